@@ -7,6 +7,8 @@ import (
 	"io/ioutil"
 	"math"
 	"os"
+	"path"
+	"path/filepath"
 	"testing"
 
 	"github.com/brimsec/zq/pkg/nano"
@@ -86,7 +88,7 @@ func TestOpenOptions(t *testing.T) {
 #zfile=string
 #0:record[key:int64,_log:zfile]
 0:[336;20200422/1587517412.06741443.zng;]
-0:[336;20200421/1587508871.06471174.zng;]
+0:[336;20200421/1587508863.06912934.zng;]
 `
 	out := indexQuery(t, ark1, query, AddPath(DefaultAddPathField, false))
 	require.Equal(t, test.Trim(exp), out)
@@ -111,10 +113,13 @@ func TestImportWhileOpen(t *testing.T) {
 	require.NoError(t, err)
 	defer os.RemoveAll(datapath)
 
-	// A large threshold ensures every import will result in a separate log.
+	// A large threshold and partition ensure every import will result
+	// in a separate log.
 	thresh := int64(math.MaxInt64)
+	partition := PartitionMonth
 	ark1, err := CreateOrOpenArchive(datapath, &CreateOptions{
 		LogSizeThreshold: &thresh,
+		Partition:        &partition,
 	}, nil)
 	require.NoError(t, err)
 
@@ -144,7 +149,7 @@ func TestImportWhileOpen(t *testing.T) {
 	assert.Equal(t, 3, ark1.mdUpdateCount)
 	exp := []SpanInfo{SpanInfo{
 		Span:  nano.Span{Ts: 1587509776063858170, Dur: 4287004687211},
-		LogID: "20200422/1587514063.06854538.zng"}}
+		LogID: "202004/1587514063.06854538.zng"}}
 	assert.Equal(t, exp, initialSpans)
 
 	// With a separate handle, open & import more data to the archive
@@ -163,10 +168,10 @@ func TestImportWhileOpen(t *testing.T) {
 
 	exp = []SpanInfo{{
 		Span:  nano.Span{Ts: 1587514075061481960, Dur: 4545000755341},
-		LogID: "20200422/1587518620.0622373.zng",
+		LogID: "202004/1587518620.0622373.zng",
 	}, {
 		Span:  nano.Span{Ts: 1587509776063858170, Dur: 4287004687211},
-		LogID: "20200422/1587514063.06854538.zng",
+		LogID: "202004/1587514063.06854538.zng",
 	}}
 	assert.Equal(t, exp, postSpans)
 
@@ -176,3 +181,179 @@ func TestImportWhileOpen(t *testing.T) {
 		}
 	}
 }
+
+func TestImportMerge(t *testing.T) {
+	datapath, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer os.RemoveAll(datapath)
+
+	thresh := int64(2500)
+	ark, err := CreateOrOpenArchive(datapath, &CreateOptions{
+		LogSizeThreshold: &thresh,
+	}, nil)
+	require.NoError(t, err)
+
+	// Importing the same records twice merges every log of the second
+	// import with those of the first.
+	importTestFile(t, ark, "../tests/suite/zdx/babble.tzng")
+	// Files in the zar directories of the first import's logs describe
+	// their old contents and must not survive the merge.
+	err = Walk(ark, func(zardir string) error {
+		require.NoError(t, os.MkdirAll(zardir, 0700))
+		return ioutil.WriteFile(filepath.Join(zardir, "stale"), nil, 0644)
+	})
+	require.NoError(t, err)
+	importTestFile(t, ark, "../tests/suite/zdx/babble.tzng")
+
+	var spans []SpanInfo
+	var n int
+	err = SpanWalk(ark, func(si SpanInfo, zardir string) error {
+		spans = append(spans, si)
+		dir := path.Dir(string(si.LogID))
+		assert.Equal(t, ark.Partition.Dir(si.Span.Ts), dir)
+		assert.Equal(t, ark.Partition.Dir(si.Span.End()-1), dir)
+		r, err := detector.OpenFile(resolver.NewContext(), ZarDirToLog(zardir), detector.OpenConfig{})
+		require.NoError(t, err)
+		defer r.Close()
+		for {
+			rec, err := r.Read()
+			require.NoError(t, err)
+			if rec == nil {
+				return nil
+			}
+			assert.True(t, si.Span.Contains(rec.Ts))
+			n++
+		}
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2000, n)
+	for i := range spans {
+		for j := i + 1; j < len(spans); j++ {
+			assert.False(t, spans[i].Span.Overlaps(spans[j].Span), "%s overlaps %s", spans[i].LogID, spans[j].LogID)
+		}
+	}
+
+	// Nothing but the logs, their summaries, and metadata remain in the
+	// archive.
+	var paths []string
+	err = filepath.Walk(datapath, func(p string, info os.FileInfo, err error) error {
+		if err == nil && !info.IsDir() {
+			paths = append(paths, p)
+		}
+		return err
+	})
+	require.NoError(t, err)
+	assert.Len(t, paths, 2*len(spans)+1)
+}
+
+func readLogs(t *testing.T, ark *Archive) ([]SpanInfo, int) {
+	var spans []SpanInfo
+	var n int
+	err := SpanWalk(ark, func(si SpanInfo, zardir string) error {
+		spans = append(spans, si)
+		r, err := detector.OpenFile(resolver.NewContext(), ZarDirToLog(zardir), detector.OpenConfig{})
+		require.NoError(t, err)
+		defer r.Close()
+		for {
+			rec, err := r.Read()
+			require.NoError(t, err)
+			if rec == nil {
+				return nil
+			}
+			assert.True(t, si.Span.Contains(rec.Ts))
+			n++
+		}
+	})
+	require.NoError(t, err)
+	return spans, n
+}
+
+func TestCompact(t *testing.T) {
+	datapath, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer os.RemoveAll(datapath)
+
+	thresh := int64(1000)
+	createArchiveSpace(t, datapath, "../tests/suite/zdx/babble.tzng", &CreateOptions{
+		LogSizeThreshold: &thresh,
+	})
+	indexArchiveSpace(t, datapath, "v")
+
+	ark, err := OpenArchive(datapath, nil)
+	require.NoError(t, err)
+	spans, n := readLogs(t, ark)
+	require.Len(t, spans, 34)
+	require.Equal(t, 1000, n)
+
+	// Compacting with a larger threshold merges the logs of each day.
+	ark.LogSizeThreshold = 1024 * 1024
+	require.NoError(t, Compact(context.Background(), ark))
+
+	spans, n = readLogs(t, ark)
+	assert.Equal(t, 1000, n)
+	require.Len(t, spans, 2)
+	assert.EqualValues(t, "20200422/1587518620.0622373.zng", spans[0].LogID)
+	assert.EqualValues(t, "20200421/1587513592.0625444.zng", spans[1].LogID)
+	for _, si := range spans {
+		zardir := LogToZarDir(si.LogID.Path(ark))
+		assert.FileExists(t, filepath.Join(zardir, "zdx-field-v.zng"))
+	}
+
+	// Compacting again leaves the archive unchanged.
+	require.NoError(t, Compact(context.Background(), ark))
+	again, _ := readLogs(t, ark)
+	assert.Equal(t, spans, again)
+
+	var paths []string
+	err = filepath.Walk(datapath, func(p string, info os.FileInfo, err error) error {
+		if err == nil && !info.IsDir() {
+			paths = append(paths, p)
+		}
+		return err
+	})
+	require.NoError(t, err)
+	assert.Len(t, paths, 3*len(spans)+1)
+}
+
+func TestRetain(t *testing.T) {
+	datapath, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer os.RemoveAll(datapath)
+
+	thresh := int64(10000)
+	createArchiveSpace(t, datapath, "../tests/suite/zdx/babble.tzng", &CreateOptions{
+		LogSizeThreshold: &thresh,
+	})
+	indexArchiveSpace(t, datapath, "v")
+
+	ark, err := OpenArchive(datapath, nil)
+	require.NoError(t, err)
+	before, _ := readLogs(t, ark)
+
+	// Midnight divides the logs of the two days in the archive.
+	cutoff := nano.Ts(1587513600 * 1_000_000_000)
+	removed, err := Retain(context.Background(), ark, cutoff)
+	require.NoError(t, err)
+	require.NotEmpty(t, removed)
+
+	after, _ := readLogs(t, ark)
+	assert.Equal(t, len(before), len(removed)+len(after))
+	for _, si := range after {
+		assert.True(t, si.Span.End() > cutoff)
+	}
+	for _, si := range removed {
+		assert.True(t, si.Span.End() <= cutoff)
+		_, err := os.Stat(si.LogID.Path(ark))
+		assert.True(t, os.IsNotExist(err))
+		_, err = os.Stat(LogToZarDir(si.LogID.Path(ark)))
+		assert.True(t, os.IsNotExist(err))
+	}
+	_, err = os.Stat(filepath.Join(datapath, "20200421"))
+	assert.True(t, os.IsNotExist(err))
+
+	// The removal is recorded in the archive's metadata.
+	ark, err = OpenArchive(datapath, nil)
+	require.NoError(t, err)
+	reopened, _ := readLogs(t, ark)
+	assert.Equal(t, after, reopened)
+}
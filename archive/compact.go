@@ -0,0 +1,102 @@
+package archive
+
+import (
+	"context"
+	"os"
+	"path"
+
+	"github.com/brimsec/zq/pkg/fs"
+	"github.com/brimsec/zq/zio/zngio"
+	"github.com/brimsec/zq/zng/resolver"
+)
+
+// Compact merges runs of adjacent logs within the same partition whose
+// combined size is no more than ark.LogSizeThreshold into single logs,
+// rebuilding the indexes of the merged logs.  Custom indexes built from
+// an input other than the log can't be rebuilt and are dropped.
+func Compact(ctx context.Context, ark *Archive) error {
+	if _, err := ark.UpdateCheck(); err != nil {
+		return err
+	}
+	ark.mu.RLock()
+	spans := append([]SpanInfo(nil), ark.spans...)
+	ark.mu.RUnlock()
+
+	stage, err := newStaging(ark)
+	if err != nil {
+		return err
+	}
+	defer stage.remove()
+
+	var run []SpanInfo
+	var runSize int64
+	flush := func() error {
+		defer func() {
+			run = nil
+			runSize = 0
+		}()
+		if len(run) < 2 {
+			return nil
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		merged, err := ark.mergeCluster(stage, &cluster{old: run})
+		if err != nil {
+			return err
+		}
+		return ark.replaceLogs(merged, run)
+	}
+	for _, si := range spans {
+		size, err := ark.logSize(si.LogID)
+		if err != nil {
+			return err
+		}
+		if len(run) > 0 {
+			samePartition := path.Dir(string(run[0].LogID)) == path.Dir(string(si.LogID))
+			if !samePartition || runSize+size > ark.LogSizeThreshold {
+				if err := flush(); err != nil {
+					return err
+				}
+			}
+		}
+		if size >= ark.LogSizeThreshold {
+			continue
+		}
+		run = append(run, si)
+		runSize += size
+	}
+	return flush()
+}
+
+// logSize returns the uncompressed size of the records in a log as
+// measured against ark.LogSizeThreshold when the log was written.
+func (ark *Archive) logSize(id LogID) (int64, error) {
+	p := id.Path(ark)
+	info, err := os.Stat(p)
+	if err != nil {
+		return 0, err
+	}
+	// Compression only makes a log's file smaller than its records, so
+	// there's no need to read logs whose files are already full.
+	if info.Size() >= ark.LogSizeThreshold {
+		return info.Size(), nil
+	}
+	f, err := fs.Open(p)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	r := zngio.NewReader(f, resolver.NewContext())
+	var size int64
+	for {
+		rec, err := r.Read()
+		if err != nil {
+			return 0, err
+		}
+		if rec == nil {
+			return size, nil
+		}
+		size += int64(len(rec.Raw))
+	}
+}
@@ -3,9 +3,12 @@ package archive
 import (
 	"context"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"path"
 	"path/filepath"
+	"sort"
+	"strconv"
 
 	"github.com/brimsec/zq/driver"
 	"github.com/brimsec/zq/pkg/bufwriter"
@@ -13,89 +16,140 @@ import (
 	"github.com/brimsec/zq/pkg/nano"
 	"github.com/brimsec/zq/zbuf"
 	"github.com/brimsec/zq/zio"
+	"github.com/brimsec/zq/zio/detector"
 	"github.com/brimsec/zq/zio/zngio"
 	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
 	"github.com/brimsec/zq/zqd/api"
 	"github.com/brimsec/zq/zql"
 	"go.uber.org/zap"
 )
 
-func tsDir(ts nano.Ts) string {
-	return ts.Time().Format("20060102")
+// A chunk is a log written to the staging directory of an import that
+// has yet to be moved into place in the archive, along with its summary.
+type chunk struct {
+	SpanInfo
+	path    string
+	summary string
 }
 
-type importDriver struct {
-	ark *Archive
-	bw  *bufwriter.Writer
-	zw  zbuf.Writer
-	n   int64
+// staging is a directory holding the chunks written during an import.
+type staging struct {
+	dir string
+	n   int
+}
 
-	span  nano.Span
-	logID LogID
-	spans []SpanInfo
+func newStaging(ark *Archive) (*staging, error) {
+	dir, err := ioutil.TempDir(ark.Root, ".stage-")
+	if err != nil {
+		return nil, err
+	}
+	return &staging{dir: dir}, nil
 }
 
-func (d *importDriver) writeOne(rec *zng.Record) error {
-	recspan := nano.Span{rec.Ts, 1}
-	if d.zw == nil {
-		dname := tsDir(rec.Ts)
-		fname := rec.Ts.StringFloat() + ".zng"
-		d.span = recspan
-		// Create LogID with path.Join so that it always uses forward
-		// slashes (dir1/foo.zng), regardless of platform.
-		d.logID = LogID(path.Join(dname, fname))
+func (s *staging) remove() error {
+	return os.RemoveAll(s.dir)
+}
 
-		dpath := filepath.Join(d.ark.Root, dname)
-		if err := os.MkdirAll(dpath, 0755); err != nil {
-			return err
-		}
+func (s *staging) next() string {
+	s.n++
+	return filepath.Join(s.dir, strconv.Itoa(s.n)+".zng")
+}
+
+// chunkWriter cuts a stream of records sorted by time into chunks of about
+// ark.LogSizeThreshold bytes.  Each chunk lies within a single partition
+// of the archive, and records with the same timestamp are never split
+// across chunks so that the spans of the chunks don't overlap.
+type chunkWriter struct {
+	ark     *Archive
+	staging *staging
+	bw      *bufwriter.Writer
+	zw      *zngio.Writer
+	sum     *summarizer
+	n       int64
 
-		//XXX for now just truncate any existing file.
-		// a future PR will do a split/merge.
-		fpath := filepath.Join(dpath, fname)
-		out, err := fs.OpenFile(fpath, os.O_TRUNC|os.O_WRONLY|os.O_CREATE, 0644)
+	dir    string
+	path   string
+	first  nano.Ts
+	last   nano.Ts
+	span   nano.Span
+	chunks []chunk
+}
+
+func (w *chunkWriter) Write(rec *zng.Record) error {
+	if w.zw != nil {
+		full := w.n >= w.ark.LogSizeThreshold && rec.Ts != w.last
+		if full || w.ark.Partition.Dir(rec.Ts) != w.dir {
+			if err := w.close(); err != nil {
+				return err
+			}
+		}
+	}
+	recspan := nano.Span{rec.Ts, 1}
+	if w.zw == nil {
+		w.path = w.staging.next()
+		out, err := fs.OpenFile(w.path, os.O_EXCL|os.O_WRONLY|os.O_CREATE, 0644)
 		if err != nil {
 			return err
 		}
-		d.bw = bufwriter.New(out)
-		d.zw = zngio.NewWriter(d.bw, zio.WriterFlags{})
+		w.bw = bufwriter.New(out)
+		w.zw = zngio.NewCompressedWriter(w.bw, zio.WriterFlags{}, w.ark.ZngLZ4BlockSize)
+		w.sum = newSummarizer()
+		w.dir = w.ark.Partition.Dir(rec.Ts)
+		w.first = rec.Ts
+		w.span = recspan
 	} else {
-		d.span = d.span.Union(recspan)
+		w.span = w.span.Union(recspan)
 	}
-	if err := d.zw.Write(rec); err != nil {
+	if err := w.zw.Write(rec); err != nil {
 		return err
 	}
-	d.n += int64(len(rec.Raw))
-	if d.n >= d.ark.LogSizeThreshold {
-		if err := d.close(); err != nil {
-			return err
-		}
+	if err := w.sum.add(rec); err != nil {
+		return err
 	}
+	w.last = rec.Ts
+	w.n += int64(len(rec.Raw))
 	return nil
 }
 
-func (d *importDriver) close() error {
-	if d.bw != nil {
-		if err := d.bw.Close(); err != nil {
+func (w *chunkWriter) close() error {
+	if w.bw != nil {
+		if err := w.zw.Flush(); err != nil {
+			return err
+		}
+		if err := w.bw.Close(); err != nil {
+			return err
+		}
+		summaryPath := w.path + ".summary"
+		if err := w.sum.write(summaryPath); err != nil {
 			return err
 		}
-		d.spans = append(d.spans, SpanInfo{
-			Span:  d.span,
-			LogID: d.logID,
+		// Create LogID with path.Join so that it always uses forward
+		// slashes (dir1/foo.zng), regardless of platform.
+		logID := LogID(path.Join(w.dir, w.first.StringFloat()+".zng"))
+		w.chunks = append(w.chunks, chunk{
+			SpanInfo: SpanInfo{Span: w.span, LogID: logID},
+			path:     w.path,
+			summary:  summaryPath,
 		})
-		d.bw = nil
+		w.bw = nil
+		w.sum = nil
 	}
-	d.zw = nil
-	d.n = 0
+	w.zw = nil
+	w.n = 0
 	return nil
 }
 
+type importDriver struct {
+	w *chunkWriter
+}
+
 func (d *importDriver) Write(cid int, batch zbuf.Batch) error {
 	if cid != 0 {
 		panic("importDriver write to non-zero channel")
 	}
 	for i := 0; i < batch.Length(); i++ {
-		if err := d.writeOne(batch.Index(i)); err != nil {
+		if err := d.w.Write(batch.Index(i)); err != nil {
 			return err
 		}
 	}
@@ -107,7 +161,7 @@ func (d *importDriver) ChannelEnd(cid int) error {
 	if cid != 0 {
 		panic("importDriver ChannelEnd to non-zero channel")
 	}
-	return d.close()
+	return d.w.close()
 }
 
 func (d *importDriver) Warn(warning string) error          { return nil }
@@ -120,6 +174,9 @@ func importProc(ark *Archive) string {
 	return "sort -r ts"
 }
 
+// Import writes the records read from r into new logs in the archive.  New
+// logs whose spans overlap those of existing logs are merged with them so
+// that the spans of the archive's logs never overlap.
 func Import(ctx context.Context, ark *Archive, r zbuf.Reader) error {
 	proc, err := zql.ParseProc(importProc(ark))
 	if err != nil {
@@ -131,10 +188,204 @@ func Import(ctx context.Context, ark *Archive, r zbuf.Reader) error {
 		return err
 	}
 
-	id := &importDriver{ark: ark}
-	if err := driver.Run(fg, id, nil); err != nil {
+	stage, err := newStaging(ark)
+	if err != nil {
+		return err
+	}
+	defer stage.remove()
+
+	w := &chunkWriter{ark: ark, staging: stage}
+	if err := driver.Run(fg, &importDriver{w}, nil); err != nil {
+		w.close()
 		return fmt.Errorf("archive.Import: run failed: %w", err)
 	}
+	return ark.merge(stage, w.chunks)
+}
+
+// A cluster is a set of logs, old and new, whose spans overlap.
+type cluster struct {
+	span nano.Span
+	old  []SpanInfo
+	new  []chunk
+}
+
+// clusters groups the logs in old and new into clusters of logs with
+// overlapping spans, returning only those clusters containing new logs.
+func clusters(old []SpanInfo, new []chunk) []*cluster {
+	type item struct {
+		span nano.Span
+		old  *SpanInfo
+		new  *chunk
+	}
+	var items []item
+	for i := range old {
+		items = append(items, item{span: old[i].Span, old: &old[i]})
+	}
+	for i := range new {
+		items = append(items, item{span: new[i].Span, new: &new[i]})
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].span.Ts < items[j].span.Ts
+	})
+	var out []*cluster
+	var c *cluster
+	hasNew := false
+	for _, it := range items {
+		if c == nil || !c.span.Overlaps(it.span) {
+			if c != nil && hasNew {
+				out = append(out, c)
+			}
+			c = &cluster{span: it.span}
+			hasNew = false
+		} else {
+			c.span = c.span.Union(it.span)
+		}
+		if it.old != nil {
+			c.old = append(c.old, *it.old)
+		} else {
+			c.new = append(c.new, *it.new)
+			hasNew = true
+		}
+	}
+	if c != nil && hasNew {
+		out = append(out, c)
+	}
+	return out
+}
+
+// merge moves the chunks written by an import into place, first merging
+// any that overlap existing logs with those logs.
+func (ark *Archive) merge(stage *staging, chunks []chunk) error {
+	if _, err := ark.UpdateCheck(); err != nil {
+		return err
+	}
+	ark.mu.RLock()
+	old := append([]SpanInfo(nil), ark.spans...)
+	ark.mu.RUnlock()
 
-	return ark.AppendSpans(id.spans)
+	var add []chunk
+	var remove []SpanInfo
+	for _, c := range clusters(old, chunks) {
+		if len(c.old) == 0 && len(c.new) == 1 {
+			add = append(add, c.new[0])
+			continue
+		}
+		merged, err := ark.mergeCluster(stage, c)
+		if err != nil {
+			return err
+		}
+		add = append(add, merged...)
+		remove = append(remove, c.old...)
+	}
+
+	return ark.replaceLogs(add, remove)
+}
+
+// replaceLogs moves the staged logs in add and their summaries into place
+// in the archive and removes the logs in remove.  The indexes of the
+// removed logs are rebuilt for the added logs.
+func (ark *Archive) replaceLogs(add []chunk, remove []SpanInfo) error {
+	var rules []Rule
+	for _, si := range remove {
+		rules = appendRules(rules, rulesFromZarDir(LogToZarDir(si.LogID.Path(ark))))
+	}
+	// The added logs replace the files of any removed logs with the
+	// same name before the metadata is updated, so an interruption here
+	// may leave records duplicated in the archive but never lost.
+	var spans []SpanInfo
+	replaced := make(map[LogID]bool)
+	for _, c := range add {
+		dst := c.LogID.Path(ark)
+		if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+			return err
+		}
+		if err := os.Rename(c.path, dst); err != nil {
+			return err
+		}
+		spans = append(spans, c.SpanInfo)
+		replaced[c.LogID] = true
+		// Any indexes of a removed log with the same name describe
+		// its old contents.
+		zardir := LogToZarDir(dst)
+		if err := os.RemoveAll(zardir); err != nil {
+			return err
+		}
+		if err := os.MkdirAll(zardir, 0700); err != nil {
+			return err
+		}
+		if err := os.Rename(c.summary, filepath.Join(zardir, summaryFile)); err != nil {
+			return err
+		}
+		if err := run(zardir, rules, dst, nil); err != nil {
+			return err
+		}
+	}
+	var removeIDs []LogID
+	for _, si := range remove {
+		removeIDs = append(removeIDs, si.LogID)
+	}
+	if err := ark.updateSpans(removeIDs, spans); err != nil {
+		return err
+	}
+	for _, si := range remove {
+		if replaced[si.LogID] {
+			continue
+		}
+		if err := os.RemoveAll(LogToZarDir(si.LogID.Path(ark))); err != nil {
+			return err
+		}
+		if err := os.Remove(si.LogID.Path(ark)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// mergeCluster writes the records of the logs in c, in time order, into
+// new chunks.
+func (ark *Archive) mergeCluster(stage *staging, c *cluster) ([]chunk, error) {
+	var paths []string
+	for _, si := range c.old {
+		paths = append(paths, si.LogID.Path(ark))
+	}
+	for _, ch := range c.new {
+		paths = append(paths, ch.path)
+	}
+	zctx := resolver.NewContext()
+	var readers []zbuf.Reader
+	for _, p := range paths {
+		f, err := detector.OpenFile(zctx, p, detector.OpenConfig{Format: "zng"})
+		if err != nil {
+			zbuf.NewCombiner(readers, nil).Close()
+			return nil, err
+		}
+		readers = append(readers, f)
+	}
+	combiner := zbuf.NewCombiner(readers, zbuf.RecordCompare(ark.DataSortDirection))
+	defer combiner.Close()
+
+	w := &chunkWriter{ark: ark, staging: stage}
+	for {
+		rec, err := combiner.Read()
+		if err != nil {
+			w.close()
+			return nil, err
+		}
+		if rec == nil {
+			break
+		}
+		if err := w.Write(rec); err != nil {
+			w.close()
+			return nil, err
+		}
+	}
+	if err := w.close(); err != nil {
+		return nil, err
+	}
+	// The staged chunks of the cluster are no longer needed.
+	for _, ch := range c.new {
+		os.Remove(ch.path)
+		os.Remove(ch.summary)
+	}
+	return w.chunks, nil
 }
@@ -3,6 +3,10 @@ package archive
 import (
 	"context"
 	"fmt"
+	"io/ioutil"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/brimsec/zq/driver"
 	"github.com/brimsec/zq/pkg/fs"
@@ -29,6 +33,73 @@ func fieldZdxName(fieldname string) string {
 	return "zdx-field-" + fieldname
 }
 
+// rulesFromZarDir returns the rules for the indexes found in zardir.  The
+// rules of standard type and field indexes are recovered from their names
+// and those of custom indexes from their saved definitions.  Custom indexes
+// built from an input other than the log have no saved definition and are
+// ignored.
+func rulesFromZarDir(zardir string) []Rule {
+	infos, err := ioutil.ReadDir(zardir)
+	if err != nil {
+		return nil
+	}
+	names := make(map[string]bool)
+	var rules []Rule
+	for _, info := range infos {
+		name := info.Name()
+		if strings.HasSuffix(name, ruleExt) {
+			if rule, err := readRuleDef(zardir, strings.TrimSuffix(name, ruleExt)); err == nil {
+				rules = append(rules, *rule)
+			}
+		} else if strings.HasSuffix(name, ".zng") {
+			names[strings.TrimSuffix(name, ".zng")] = true
+		}
+	}
+	for name := range names {
+		// Skip the files holding the upper levels of a zdx index,
+		// which are named <index>.<level>.zng.
+		if i := strings.LastIndexByte(name, '.'); i >= 0 && names[name[:i]] {
+			if _, err := strconv.Atoi(name[i+1:]); err == nil {
+				continue
+			}
+		}
+		var rule *Rule
+		switch {
+		case strings.HasPrefix(name, "zdx-type-"):
+			rule, err = NewTypeRule(strings.TrimPrefix(name, "zdx-type-"))
+		case strings.HasPrefix(name, "zdx-field-"):
+			rule, err = NewFieldRule(strings.TrimPrefix(name, "zdx-field-"))
+		default:
+			continue
+		}
+		if err == nil {
+			rules = append(rules, *rule)
+		}
+	}
+	sort.Slice(rules, func(i, j int) bool {
+		return rules[i].path < rules[j].path
+	})
+	return rules
+}
+
+// appendRules appends to rules those rules in add whose indexes are not
+// already created by rules.
+func appendRules(rules []Rule, add []Rule) []Rule {
+	for _, r := range add {
+		found := false
+		for _, existing := range rules {
+			if existing.path == r.path {
+				found = true
+				break
+			}
+		}
+		if !found {
+			rules = append(rules, r)
+		}
+	}
+	return rules
+}
+
 func IndexDirTree(ark *Archive, rules []Rule, path string, progress chan<- string) error {
 	return Walk(ark, func(zardir string) error {
 		logPath := Localize(zardir, path)
@@ -65,6 +136,13 @@ func run(zardir string, rules []Rule, logPath string, progress chan<- string) er
 		if err != nil {
 			return err
 		}
+		// Only a custom index built from the log itself can be
+		// rebuilt when the log is merged with others.
+		if rule.zql != "" && logPath == ZarDirToLog(zardir) {
+			if err := rule.writeDef(zardir); err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
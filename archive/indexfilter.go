@@ -0,0 +1,224 @@
+package archive
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/brimsec/zq/ast"
+	"github.com/brimsec/zq/zdx"
+	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
+)
+
+// An IndexFilter uses the summaries and the type and field indexes of the
+// logs in an archive to determine which logs cannot contain records
+// matching a search filter, so that searches can skip them.
+type IndexFilter struct {
+	filter ast.BooleanExpr
+}
+
+// NewIndexFilter returns an IndexFilter for filter, or nil if no part of
+// filter can be evaluated with a summary or an index.
+func NewIndexFilter(filter ast.BooleanExpr) *IndexFilter {
+	if filter == nil || !indexable(filter) {
+		return nil
+	}
+	return &IndexFilter{filter}
+}
+
+// indexable reports whether any comparison in e, other than those under a
+// negation, can be evaluated with a summary or looked up in an index.
+func indexable(e ast.BooleanExpr) bool {
+	switch e := e.(type) {
+	case *ast.LogicalAnd:
+		return indexable(e.Left) || indexable(e.Right)
+	case *ast.LogicalOr:
+		return indexable(e.Left) && indexable(e.Right)
+	case *ast.CompareField:
+		if _, ok := fieldPath(e.Field); !ok {
+			return false
+		}
+		return summaryIndexable(e.Comparator, e.Value) ||
+			(e.Comparator == "=" && literalMatchesKey(e.Value) != nil)
+	case *ast.CompareAny:
+		return e.Comparator == "=" && (e.Value.Type == "ip" || e.Value.Type == "string")
+	}
+	return false
+}
+
+// MayMatch reports whether the log of zardir may contain records matching
+// the filter.  It returns false only if the summary or the indexes in
+// zardir show that no record can match.
+func (f *IndexFilter) MayMatch(ctx context.Context, zardir string) (bool, error) {
+	m := &matcher{zctx: resolver.NewContext(), zardir: zardir}
+	return m.mayMatch(ctx, f.filter)
+}
+
+// A matcher evaluates a filter against the summary and indexes of a log.
+type matcher struct {
+	zctx    *resolver.Context
+	zardir  string
+	summary *summary
+	loaded  bool
+}
+
+// getSummary returns the summary of the log, reading it on first use, or
+// nil if the log has none.
+func (m *matcher) getSummary() (*summary, error) {
+	if !m.loaded {
+		s, err := readSummary(m.zardir)
+		if err != nil {
+			return nil, err
+		}
+		m.summary = s
+		m.loaded = true
+	}
+	return m.summary, nil
+}
+
+func (m *matcher) mayMatch(ctx context.Context, e ast.BooleanExpr) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	switch e := e.(type) {
+	case *ast.LogicalAnd:
+		ok, err := m.mayMatch(ctx, e.Left)
+		if !ok || err != nil {
+			return ok, err
+		}
+		return m.mayMatch(ctx, e.Right)
+	case *ast.LogicalOr:
+		ok, err := m.mayMatch(ctx, e.Left)
+		if ok || err != nil {
+			return ok, err
+		}
+		return m.mayMatch(ctx, e.Right)
+	case *ast.CompareField:
+		name, ok := fieldPath(e.Field)
+		if !ok {
+			return true, nil
+		}
+		if summaryIndexable(e.Comparator, e.Value) {
+			s, err := m.getSummary()
+			if err != nil {
+				return false, err
+			}
+			if s != nil && !s.mayMatchField(name, e.Comparator, e.Value) {
+				return false, nil
+			}
+		}
+		if e.Comparator != "=" {
+			return true, nil
+		}
+		return indexContains(m.zctx, filepath.Join(m.zardir, fieldZdxName(name)), e.Value)
+	case *ast.CompareAny:
+		if e.Comparator != "=" {
+			return true, nil
+		}
+		switch e.Value.Type {
+		case "ip":
+			// An ip compares equal only to values of type ip, all of
+			// which, unlike the values of other types that may compare
+			// equal to a literal, are in the ip type index and the
+			// Bloom filter of the summary.
+			s, err := m.getSummary()
+			if err != nil {
+				return false, err
+			}
+			if s != nil && !s.mayContain(e.Value) {
+				return false, nil
+			}
+			return indexContains(m.zctx, filepath.Join(m.zardir, typeZdxName(zng.TypeIP)), e.Value)
+		case "string":
+			// A string compares equal only to string values, all of
+			// which are in the Bloom filter of the summary.
+			s, err := m.getSummary()
+			if err != nil {
+				return false, err
+			}
+			return s == nil || s.mayContain(e.Value), nil
+		}
+	}
+	return true, nil
+}
+
+// indexContains reports whether the zdx index at path may hold a key equal
+// to literal.  If the index doesn't exist or its keys can't be compared
+// with the literal, it returns true.
+func indexContains(zctx *resolver.Context, path string, literal ast.Literal) (bool, error) {
+	finder := zdx.NewFinder(zctx, path)
+	if err := finder.Open(); err != nil {
+		if os.IsNotExist(err) {
+			return true, nil
+		}
+		return false, err
+	}
+	defer finder.Close()
+	cols := finder.Keys().Columns
+	if len(cols) != 1 || !literalMatchesKey(literal)(zng.AliasedType(cols[0].Type)) {
+		return true, nil
+	}
+	keys, err := finder.ParseKeys([]string{literal.Value})
+	if err != nil {
+		// A value that can't be represented in the key's type may
+		// still compare equal to some value in the log, e.g., 1.5 and
+		// an int64 key, so fall back to scanning the log.
+		return true, nil
+	}
+	rec, err := finder.Lookup(keys)
+	if err != nil {
+		return false, err
+	}
+	return rec != nil, nil
+}
+
+// literalMatchesKey returns a function reporting whether a key of a given
+// type compares equal to literal exactly when it has the same value once
+// literal is parsed as that type, or nil if there is no such key type.
+func literalMatchesKey(literal ast.Literal) func(zng.Type) bool {
+	switch literal.Type {
+	case "bool":
+		return func(typ zng.Type) bool { return typ.ID() == zng.IdBool }
+	case "float64":
+		return func(typ zng.Type) bool { return typ.ID() == zng.IdFloat64 }
+	case "int64":
+		return func(typ zng.Type) bool {
+			switch typ.ID() {
+			case zng.IdByte, zng.IdInt16, zng.IdInt32, zng.IdInt64,
+				zng.IdUint16, zng.IdUint32, zng.IdUint64, zng.IdPort, zng.IdFloat64:
+				return true
+			}
+			return false
+		}
+	case "ip":
+		return func(typ zng.Type) bool { return typ.ID() == zng.IdIP }
+	case "string":
+		// Escapes in the literal may not be parsed the same way as
+		// the key's type would parse them.
+		if strings.ContainsRune(literal.Value, '\\') {
+			return nil
+		}
+		return func(typ zng.Type) bool {
+			return typ.ID() == zng.IdString || typ.ID() == zng.IdBstring
+		}
+	}
+	return nil
+}
+
+// fieldPath returns the dotted name of the record field referenced by e
+// as used to name field indexes.
+func fieldPath(e ast.FieldExpr) (string, bool) {
+	switch e := e.(type) {
+	case *ast.FieldRead:
+		return e.Field, true
+	case *ast.FieldCall:
+		if e.Fn == "RecordFieldRead" {
+			if parent, ok := fieldPath(e.Field); ok {
+				return parent + "." + e.Param, true
+			}
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,95 @@
+package archive
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/brimsec/zq/driver"
+	"github.com/brimsec/zq/filter"
+	"github.com/brimsec/zq/zio/detector"
+	"github.com/brimsec/zq/zng/resolver"
+	"github.com/brimsec/zq/zql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIndexFilter(t *testing.T) {
+	datapath, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer os.RemoveAll(datapath)
+
+	thresh := int64(1000)
+	createArchiveSpace(t, datapath, "../tests/suite/zdx/babble.tzng", &CreateOptions{
+		LogSizeThreshold: &thresh,
+	})
+	indexArchiveSpace(t, datapath, "v")
+	ark, err := OpenArchive(datapath, nil)
+	require.NoError(t, err)
+
+	cases := []struct {
+		query     string
+		indexable bool
+		pruned    bool
+	}{
+		{"v=257", true, true},
+		{"v=257 s=harefoot-raucous", true, true},
+		{"v=257 or v=336", true, true},
+		{"v=257.5", true, false},
+		{"v=257 or s=harefoot-raucous", true, true},
+		{"v=257 or harefoot-raucous", false, false},
+		{"v!=257", false, false},
+		{"not v=257", false, false},
+		{"s=harefoot-raucous", true, true},
+		{"s=no-such-string", true, true},
+		{"*=harefoot-raucous", true, true},
+		{"*=10.1.2.3", true, true},
+		{"v<10", true, true},
+		{"v>=490", true, true},
+		{"v<0 or v>100000", true, true},
+		{"v>0", true, false},
+		{"s!=harefoot-raucous", false, false},
+		{"harefoot-raucous", false, false},
+	}
+	for _, c := range cases {
+		t.Run(c.query, func(t *testing.T) {
+			proc, err := zql.ParseProc(c.query)
+			require.NoError(t, err)
+			fp, _ := driver.LiftFilter(proc)
+			require.NotNil(t, fp)
+			f := NewIndexFilter(fp.Filter)
+			if !c.indexable {
+				assert.Nil(t, f)
+				return
+			}
+			require.NotNil(t, f)
+			match, err := filter.Compile(fp.Filter)
+			require.NoError(t, err)
+			var pruned int
+			err = Walk(ark, func(zardir string) error {
+				ok, err := f.MayMatch(context.Background(), zardir)
+				require.NoError(t, err)
+				if ok {
+					return nil
+				}
+				pruned++
+				// Logs are only pruned if none of their
+				// records match.
+				r, err := detector.OpenFile(resolver.NewContext(), ZarDirToLog(zardir), detector.OpenConfig{})
+				require.NoError(t, err)
+				defer r.Close()
+				for {
+					rec, err := r.Read()
+					require.NoError(t, err)
+					if rec == nil {
+						return nil
+					}
+					assert.False(t, match(rec), "%s matches in pruned log %s", c.query, zardir)
+				}
+			})
+			require.NoError(t, err)
+			assert.Equal(t, c.pruned, pruned > 0)
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package archive
+
+import (
+	"fmt"
+
+	"github.com/brimsec/zq/pkg/nano"
+)
+
+// A Partition is the granularity of time by which an archive groups its
+// logs into directories.  Every log lies within a single partition.
+type Partition string
+
+const (
+	PartitionHour  = Partition("hour")
+	PartitionDay   = Partition("day")
+	PartitionMonth = Partition("month")
+)
+
+const DefaultPartition = PartitionDay
+
+func ParsePartition(s string) (Partition, error) {
+	switch p := Partition(s); p {
+	case PartitionHour, PartitionDay, PartitionMonth:
+		return p, nil
+	}
+	return "", fmt.Errorf("unknown partition: %s (must be hour, day or month)", s)
+}
+
+// Dir returns the name of the directory holding the logs of the partition
+// containing ts.
+func (p Partition) Dir(ts nano.Ts) string {
+	switch p {
+	case PartitionHour:
+		return ts.Time().Format("2006010215")
+	case PartitionMonth:
+		return ts.Time().Format("200601")
+	default:
+		return ts.Time().Format("20060102")
+	}
+}
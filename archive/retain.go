@@ -0,0 +1,52 @@
+package archive
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/brimsec/zq/pkg/nano"
+)
+
+// Retain removes the logs whose spans end at or before cutoff, along with
+// their index directories, and returns the spans of the removed logs.
+func Retain(ctx context.Context, ark *Archive, cutoff nano.Ts) ([]SpanInfo, error) {
+	if _, err := ark.UpdateCheck(); err != nil {
+		return nil, err
+	}
+	ark.mu.RLock()
+	var aged []SpanInfo
+	for _, si := range ark.spans {
+		if si.Span.End() <= cutoff {
+			aged = append(aged, si)
+		}
+	}
+	ark.mu.RUnlock()
+	if len(aged) == 0 {
+		return nil, nil
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	var ids []LogID
+	for _, si := range aged {
+		ids = append(ids, si.LogID)
+	}
+	if err := ark.updateSpans(ids, nil); err != nil {
+		return nil, err
+	}
+	for _, si := range aged {
+		p := si.LogID.Path(ark)
+		if err := os.RemoveAll(LogToZarDir(p)); err != nil {
+			return nil, err
+		}
+		if err := os.Remove(p); err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+		// Remove the partition directory once its last log is gone.
+		if dir := filepath.Dir(p); dir != ark.Root {
+			os.Remove(dir)
+		}
+	}
+	return aged, nil
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/brimsec/zq/ast"
+	"github.com/brimsec/zq/pkg/fs"
 	"github.com/brimsec/zq/zng/resolver"
 	"github.com/brimsec/zq/zqe"
 	"github.com/brimsec/zq/zql"
@@ -116,6 +117,8 @@ type Rule struct {
 	path      string
 	framesize int
 	keys      []string
+	// zql is the source of a custom rule's proc.
+	zql string
 }
 
 func newRuleAST(proc ast.Proc, path string, keys []string, framesize int) (*Rule, error) {
@@ -135,7 +138,42 @@ func NewZqlRule(s, path string, keys []string, framesize int) (*Rule, error) {
 	if err != nil {
 		return nil, err
 	}
-	return newRuleAST(proc, path, keys, framesize)
+	rule, err := newRuleAST(proc, path, keys, framesize)
+	if err != nil {
+		return nil, err
+	}
+	rule.zql = s
+	return rule, nil
+}
+
+// ruleExt is the extension of the file in a zar directory that holds the
+// definition of the custom rule that created the index of the same name.
+// Standard rules are recovered from the names of their indexes.
+const ruleExt = ".rule.json"
+
+type ruleDef struct {
+	Zql       string   `json:"zql"`
+	Keys      []string `json:"keys"`
+	Framesize int      `json:"framesize"`
+}
+
+// writeDef saves the definition of a custom rule in zardir so that its
+// index can be rebuilt when the log is merged with others.
+func (f *Rule) writeDef(zardir string) error {
+	def := ruleDef{
+		Zql:       f.zql,
+		Keys:      f.keys,
+		Framesize: f.framesize,
+	}
+	return fs.MarshalJSONFile(def, f.Path(zardir)+ruleExt, 0600)
+}
+
+func readRuleDef(zardir, path string) (*Rule, error) {
+	var def ruleDef
+	if err := fs.UnmarshalJSONFile(filepath.Join(zardir, path+ruleExt), &def); err != nil {
+		return nil, err
+	}
+	return NewZqlRule(def.Zql, path, def.Keys, def.Framesize)
 }
 
 func (f *Rule) Path(dir string) string {
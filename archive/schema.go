@@ -20,6 +20,8 @@ type Metadata struct {
 	Version           int            `json:"version"`
 	LogSizeThreshold  int64          `json:"log_size_threshold"`
 	DataSortDirection zbuf.Direction `json:"data_sort_direction"`
+	ZngLZ4BlockSize   int            `json:"zng_lz4_block_size,omitempty"`
+	Partition         Partition      `json:"partition,omitempty"`
 	Spans             []SpanInfo     `json:"spans"`
 }
 
@@ -60,10 +62,13 @@ func MetadataRead(path string) (*Metadata, time.Time, error) {
 const (
 	DefaultLogSizeThreshold  = 500 * 1024 * 1024
 	DefaultDataSortDirection = zbuf.DirTimeReverse
+	DefaultZngLZ4BlockSize   = 0
 )
 
 type CreateOptions struct {
 	LogSizeThreshold *int64
+	ZngLZ4BlockSize  *int
+	Partition        *Partition
 }
 
 func (c *CreateOptions) toMetadata() *Metadata {
@@ -71,11 +76,19 @@ func (c *CreateOptions) toMetadata() *Metadata {
 		Version:           0,
 		LogSizeThreshold:  DefaultLogSizeThreshold,
 		DataSortDirection: DefaultDataSortDirection,
+		ZngLZ4BlockSize:   DefaultZngLZ4BlockSize,
+		Partition:         DefaultPartition,
 	}
 
 	if c.LogSizeThreshold != nil {
 		m.LogSizeThreshold = *c.LogSizeThreshold
 	}
+	if c.ZngLZ4BlockSize != nil {
+		m.ZngLZ4BlockSize = *c.ZngLZ4BlockSize
+	}
+	if c.Partition != nil {
+		m.Partition = *c.Partition
+	}
 
 	return m
 }
@@ -84,7 +97,13 @@ type Archive struct {
 	Root              string
 	DataSortDirection zbuf.Direction
 	LogSizeThreshold  int64
-	LogsFiltered      bool
+	// ZngLZ4BlockSize is the block size used to compress imported zng
+	// files, or zero if they aren't compressed.
+	ZngLZ4BlockSize int
+	// Partition is the granularity of time by which logs are grouped
+	// into directories.
+	Partition    Partition
+	LogsFiltered bool
 
 	// mu protects below fields.
 	mu    sync.RWMutex
@@ -99,6 +118,12 @@ type Archive struct {
 }
 
 func (ark *Archive) AppendSpans(spans []SpanInfo) error {
+	return ark.updateSpans(nil, spans)
+}
+
+// updateSpans removes the spans of the logs in remove from the archive and
+// adds the spans in add.
+func (ark *Archive) updateSpans(remove []LogID, add []SpanInfo) error {
 	if ark.LogsFiltered {
 		return errors.New("cannot add spans to log filtered archive")
 	}
@@ -106,7 +131,20 @@ func (ark *Archive) AppendSpans(spans []SpanInfo) error {
 	ark.mu.Lock()
 	defer ark.mu.Unlock()
 
-	ark.spans = append(ark.spans, spans...)
+	if len(remove) > 0 {
+		removed := make(map[LogID]bool)
+		for _, id := range remove {
+			removed[id] = true
+		}
+		var spans []SpanInfo
+		for _, si := range ark.spans {
+			if !removed[si.LogID] {
+				spans = append(spans, si)
+			}
+		}
+		ark.spans = spans
+	}
+	ark.spans = append(ark.spans, add...)
 
 	sort.Slice(ark.spans, func(i, j int) bool {
 		if ark.DataSortDirection == zbuf.DirTimeForward {
@@ -129,6 +167,8 @@ func (ark *Archive) metaWrite() error {
 		Version:           0,
 		LogSizeThreshold:  ark.LogSizeThreshold,
 		DataSortDirection: ark.DataSortDirection,
+		ZngLZ4BlockSize:   ark.ZngLZ4BlockSize,
+		Partition:         ark.Partition,
 		Spans:             ark.spans,
 	}
 	return m.Write(ark.mdPath())
@@ -198,10 +238,18 @@ func OpenArchive(path string, oo *OpenOptions) (*Archive, error) {
 		Root:              path,
 		DataSortDirection: m.DataSortDirection,
 		LogSizeThreshold:  m.LogSizeThreshold,
+		ZngLZ4BlockSize:   m.ZngLZ4BlockSize,
+		Partition:         m.Partition,
 		mdModTime:         mtime,
 		mdUpdateCount:     1,
 	}
 
+	if ark.Partition == "" {
+		// Archives created before partitions were configurable are
+		// partitioned by day.
+		ark.Partition = PartitionDay
+	}
+
 	if oo != nil && len(oo.LogFilter) != 0 {
 		ark.LogsFiltered = true
 		lmap := make(map[LogID]struct{})
@@ -0,0 +1,68 @@
+package archive
+
+import (
+	"context"
+
+	"github.com/brimsec/zq/driver"
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zio/detector"
+	"github.com/brimsec/zq/zng/resolver"
+)
+
+// SearchInputs returns the inputs of a parallel search (see
+// driver.CompileParallel) over the logs in ark that overlap span.  Logs
+// whose summaries or indexes show to hold no records matching f, if f is
+// not nil, are skipped when their input is opened.  Logs that overlap one
+// another in time are merged into a single input with a zbuf.Combiner, so
+// the inputs are sorted by time and don't overlap.
+func SearchInputs(ark *Archive, span nano.Span, f *IndexFilter) ([]driver.Opener, error) {
+	var clusters [][]string
+	var clusterSpan nano.Span
+	err := SpanWalk(ark, func(si SpanInfo, zardir string) error {
+		if !span.Overlaps(si.Span) {
+			return nil
+		}
+		if len(clusters) > 0 && clusterSpan.Overlaps(si.Span) {
+			clusters[len(clusters)-1] = append(clusters[len(clusters)-1], zardir)
+			clusterSpan = clusterSpan.Union(si.Span)
+			return nil
+		}
+		clusters = append(clusters, []string{zardir})
+		clusterSpan = si.Span
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	inputs := make([]driver.Opener, 0, len(clusters))
+	for _, zardirs := range clusters {
+		inputs = append(inputs, opener(ark, zardirs, f))
+	}
+	return inputs, nil
+}
+
+func opener(ark *Archive, zardirs []string, f *IndexFilter) driver.Opener {
+	return func(ctx context.Context, zctx *resolver.Context) (zbuf.ReadCloser, error) {
+		var readers []zbuf.Reader
+		for _, zardir := range zardirs {
+			if f != nil {
+				ok, err := f.MayMatch(ctx, zardir)
+				if err != nil {
+					zbuf.NewCombiner(readers, nil).Close()
+					return nil, err
+				}
+				if !ok {
+					continue
+				}
+			}
+			r, err := detector.OpenFile(zctx, ZarDirToLog(zardir), detector.OpenConfig{Format: "zng"})
+			if err != nil {
+				zbuf.NewCombiner(readers, nil).Close()
+				return nil, err
+			}
+			readers = append(readers, r)
+		}
+		return zbuf.NewCombiner(readers, zbuf.RecordCompare(ark.DataSortDirection)), nil
+	}
+}
@@ -0,0 +1,440 @@
+package archive
+
+import (
+	"bytes"
+	"math"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/brimsec/zq/ast"
+	"github.com/brimsec/zq/filter"
+	"github.com/brimsec/zq/pkg/bloom"
+	"github.com/brimsec/zq/pkg/bufwriter"
+	"github.com/brimsec/zq/pkg/fs"
+	"github.com/brimsec/zq/zcode"
+	"github.com/brimsec/zq/zio"
+	"github.com/brimsec/zq/zio/zngio"
+	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
+)
+
+// summaryFile is the name of the file in each zar directory that
+// summarizes the values in its log.  It holds a record with the minimum
+// and maximum values of each numeric, time, and ip field for each type the
+// field takes, followed by a record holding a Bloom filter over the string
+// and ip values anywhere in the log.
+const summaryFile = "summary.zng"
+
+// bloomFalsePositiveRate is the false positive rate of the Bloom filters
+// in log summaries.
+const bloomFalsePositiveRate = 0.01
+
+// A valueRange holds the smallest and largest values of a field of a
+// given type in a log.
+type valueRange struct {
+	field string
+	typ   zng.Type
+	min   zcode.Bytes
+	max   zcode.Bytes
+}
+
+type rangeKey struct {
+	field string
+	id    int
+}
+
+// A summarizer accumulates the summary of the records written to a log.
+type summarizer struct {
+	ranges map[rangeKey]*valueRange
+	hashes map[uint64]struct{}
+}
+
+func newSummarizer() *summarizer {
+	return &summarizer{
+		ranges: make(map[rangeKey]*valueRange),
+		hashes: make(map[uint64]struct{}),
+	}
+}
+
+// isRanged returns true if the minimum and maximum values of the type are
+// kept in a summary.
+func isRanged(typ zng.Type) bool {
+	switch typ.ID() {
+	case zng.IdByte, zng.IdInt16, zng.IdUint16, zng.IdInt32, zng.IdUint32,
+		zng.IdInt64, zng.IdUint64, zng.IdFloat64, zng.IdIP, zng.IdPort,
+		zng.IdTime, zng.IdDuration:
+		return true
+	}
+	return false
+}
+
+// compareValues compares two values of a ranged type, returning a negative
+// number, zero, or a positive number.  IP addresses are compared in their 16-byte form.
+func compareValues(typ zng.Type, a, b zcode.Bytes) int {
+	switch typ.ID() {
+	case zng.IdByte:
+		x, _ := zng.DecodeByte(a)
+		y, _ := zng.DecodeByte(b)
+		return int(x) - int(y)
+	case zng.IdPort:
+		x, _ := zng.DecodePort(a)
+		y, _ := zng.DecodePort(b)
+		return int(x) - int(y)
+	case zng.IdUint16, zng.IdUint32, zng.IdUint64:
+		x, _ := zng.DecodeUint(a)
+		y, _ := zng.DecodeUint(b)
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+		return 0
+	case zng.IdFloat64:
+		x, _ := zng.DecodeFloat64(a)
+		y, _ := zng.DecodeFloat64(b)
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+		return 0
+	case zng.IdIP:
+		x, _ := zng.DecodeIP(a)
+		y, _ := zng.DecodeIP(b)
+		return bytes.Compare(x.To16(), y.To16())
+	default:
+		// The signed integers, time, and duration.
+		x, _ := zng.DecodeInt(a)
+		y, _ := zng.DecodeInt(b)
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+		return 0
+	}
+}
+
+func (s *summarizer) add(rec *zng.Record) error {
+	if err := s.addRanges("", rec.Type, rec.Raw); err != nil {
+		return err
+	}
+	return rec.Walk(func(typ zng.Type, body zcode.Bytes) error {
+		if h, ok := bloomHash(typ, body); ok {
+			s.hashes[h] = struct{}{}
+		}
+		return nil
+	})
+}
+
+// addRanges adds the ranged values of the record body of type typ, whose
+// fields are named with prefix, to the summary.  Unlike a fieldIter, it
+// handles unset records.
+func (s *summarizer) addRanges(prefix string, typ *zng.TypeRecord, body zcode.Bytes) error {
+	if body == nil {
+		return nil
+	}
+	it := body.Iter()
+	for _, col := range typ.Columns {
+		if it.Done() {
+			return zng.ErrMismatch
+		}
+		zv, _, err := it.Next()
+		if err != nil {
+			return err
+		}
+		name := prefix + col.Name
+		colType := zng.AliasedType(col.Type)
+		if recType, ok := colType.(*zng.TypeRecord); ok {
+			if err := s.addRanges(name+".", recType, zv); err != nil {
+				return err
+			}
+			continue
+		}
+		s.addValue(name, colType, zv)
+	}
+	return nil
+}
+
+func (s *summarizer) addValue(name string, typ zng.Type, zv zcode.Bytes) {
+	if zv == nil || !isRanged(typ) {
+		return
+	}
+	if typ.ID() == zng.IdFloat64 {
+		if v, _ := zng.DecodeFloat64(zv); math.IsNaN(v) {
+			// NaN compares false with everything.
+			return
+		}
+	}
+	key := rangeKey{name, typ.ID()}
+	r, ok := s.ranges[key]
+	if !ok {
+		b := copyBytes(zv)
+		s.ranges[key] = &valueRange{field: name, typ: typ, min: b, max: b}
+		return
+	}
+	if compareValues(typ, zv, r.min) < 0 {
+		r.min = copyBytes(zv)
+	}
+	if compareValues(typ, zv, r.max) > 0 {
+		r.max = copyBytes(zv)
+	}
+}
+
+// copyBytes returns a copy of b.  Unlike append, it preserves the
+// distinction between an empty value, e.g., a zero integer, and an unset
+// one.
+func copyBytes(b zcode.Bytes) zcode.Bytes {
+	if b == nil {
+		return nil
+	}
+	c := make(zcode.Bytes, len(b))
+	copy(c, b)
+	return c
+}
+
+// bloomHash returns the hash of a string or ip value as it is added to the
+// Bloom filter of a summary.
+func bloomHash(typ zng.Type, body zcode.Bytes) (uint64, bool) {
+	if body == nil {
+		return 0, false
+	}
+	switch zng.AliasedType(typ).ID() {
+	case zng.IdString, zng.IdBstring:
+		return bloom.Hash(body), true
+	case zng.IdIP:
+		ip, err := zng.DecodeIP(body)
+		if err != nil {
+			return 0, false
+		}
+		return bloom.Hash(ip.To16()), true
+	}
+	return 0, false
+}
+
+// write writes the summary to the file at path.
+func (s *summarizer) write(path string) error {
+	out, err := fs.OpenFile(path, os.O_EXCL|os.O_WRONLY|os.O_CREATE, 0644)
+	if err != nil {
+		return err
+	}
+	bw := bufwriter.New(out)
+	zw := zngio.NewWriter(bw, zio.WriterFlags{})
+	zctx := resolver.NewContext()
+	ranges := make([]*valueRange, 0, len(s.ranges))
+	for _, r := range s.ranges {
+		ranges = append(ranges, r)
+	}
+	sort.Slice(ranges, func(i, j int) bool {
+		if ranges[i].field != ranges[j].field {
+			return ranges[i].field < ranges[j].field
+		}
+		return ranges[i].typ.ID() < ranges[j].typ.ID()
+	})
+	for _, r := range ranges {
+		typ := zctx.MustLookupTypeRecord([]zng.Column{
+			{"field", zng.TypeString},
+			{"min", r.typ},
+			{"max", r.typ},
+		})
+		rec := zng.NewBuilder(typ).Build(zng.EncodeString(r.field), r.min, r.max)
+		if err := zw.Write(rec); err != nil {
+			bw.Close()
+			return err
+		}
+	}
+	bf := bloom.New(len(s.hashes), bloomFalsePositiveRate)
+	for h := range s.hashes {
+		bf.Add(h)
+	}
+	typ := zctx.MustLookupTypeRecord([]zng.Column{
+		{"bloom", zng.TypeBstring},
+		{"k", zng.TypeInt64},
+	})
+	rec := zng.NewBuilder(typ).Build(bf.Bytes(), zng.EncodeInt(int64(bf.K())))
+	if err := zw.Write(rec); err != nil {
+		bw.Close()
+		return err
+	}
+	if err := zw.Flush(); err != nil {
+		bw.Close()
+		return err
+	}
+	return bw.Close()
+}
+
+// A summary is the summary of a log read from its zar directory.
+type summary struct {
+	ranges map[string][]valueRange
+	bloom  *bloom.Filter
+}
+
+// readSummary reads the summary in zardir, returning nil if there is none.
+func readSummary(zardir string) (*summary, error) {
+	f, err := fs.Open(filepath.Join(zardir, summaryFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+	r := zngio.NewReader(f, resolver.NewContext())
+	s := &summary{ranges: make(map[string][]valueRange)}
+	for {
+		rec, err := r.Read()
+		if err != nil {
+			return nil, err
+		}
+		if rec == nil {
+			break
+		}
+		if bits, err := rec.Access("bloom"); err == nil {
+			k, err := rec.AccessInt("k")
+			if err != nil {
+				return nil, err
+			}
+			// The record is reused by the reader.
+			b := append([]byte(nil), bits.Bytes...)
+			if s.bloom, err = bloom.FromBytes(b, int(k)); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		field, err := rec.AccessString("field")
+		if err != nil {
+			return nil, err
+		}
+		min, err := rec.Access("min")
+		if err != nil {
+			return nil, err
+		}
+		max, err := rec.Access("max")
+		if err != nil {
+			return nil, err
+		}
+		s.ranges[field] = append(s.ranges[field], valueRange{
+			field: field,
+			typ:   min.Type,
+			min:   copyBytes(min.Bytes),
+			max:   copyBytes(max.Bytes),
+		})
+	}
+	return s, nil
+}
+
+// summaryIndexable returns true if a comparison of a field with literal
+// using comparator can be evaluated with a summary.
+func summaryIndexable(comparator string, literal ast.Literal) bool {
+	switch literal.Type {
+	case "int64", "float64", "port":
+		switch comparator {
+		case "=", "<", "<=", ">", ">=":
+			return true
+		}
+	case "ip", "string":
+		return comparator == "="
+	}
+	return false
+}
+
+// mayMatchField returns false if no value of field in the log compares
+// true with literal using comparator.
+func (s *summary) mayMatchField(field, comparator string, literal ast.Literal) bool {
+	if !summaryIndexable(comparator, literal) {
+		return true
+	}
+	if literal.Type == "string" {
+		// Strings are only in the Bloom filter, which doesn't say
+		// what fields they're in.
+		return s.mayContain(literal)
+	}
+	if literal.Type == "ip" && !s.mayContain(literal) {
+		return false
+	}
+	for _, r := range s.ranges[field] {
+		if r.mayMatch(comparator, literal) {
+			return true
+		}
+	}
+	return false
+}
+
+// mayMatch returns false if no value between r.min and r.max compares true
+// with literal using comparator.  The filter's comparison of values of
+// r.typ with the literal is monotonic in the order of compareValues
+// except as noted, so it suffices to compare the literal with r.min or
+// r.max.
+func (r *valueRange) mayMatch(comparator string, literal ast.Literal) bool {
+	if r.typ.ID() == zng.IdIP {
+		// Addresses compare true only with address literals, and
+		// their ordering is only consistent for equality.
+		if literal.Type != "ip" {
+			return false
+		}
+		if comparator != "=" {
+			return true
+		}
+		v, err := zng.ParseLiteral(literal)
+		if err != nil {
+			return true
+		}
+		ip := zng.EncodeIP(v.(net.IP))
+		return compareValues(r.typ, ip, r.min) >= 0 && compareValues(r.typ, ip, r.max) <= 0
+	}
+	switch r.typ.ID() {
+	case zng.IdUint16, zng.IdUint32, zng.IdUint64:
+		// Integer literals compare false with unsigned values too
+		// large to be an int64.
+		if max, _ := zng.DecodeUint(r.max); max > math.MaxInt64 {
+			return true
+		}
+	}
+	val := func(b zcode.Bytes) zng.Value { return zng.Value{r.typ, b} }
+	switch comparator {
+	case "=":
+		atLeast, err := filter.Comparison(">=", literal)
+		if err != nil {
+			return true
+		}
+		atMost, err := filter.Comparison("<=", literal)
+		if err != nil {
+			return true
+		}
+		return atMost(val(r.min)) && atLeast(val(r.max))
+	case "<", "<=", ">", ">=":
+		compare, err := filter.Comparison(comparator, literal)
+		if err != nil {
+			return true
+		}
+		if comparator[0] == '<' {
+			return compare(val(r.min))
+		}
+		return compare(val(r.max))
+	}
+	return true
+}
+
+// mayContain returns false if the string or ip literal is not in the Bloom
+// filter of the summary.
+func (s *summary) mayContain(literal ast.Literal) bool {
+	if s.bloom == nil {
+		return true
+	}
+	v, err := zng.ParseLiteral(literal)
+	if err != nil {
+		return true
+	}
+	switch v := v.(type) {
+	case zng.Bstring:
+		return s.bloom.Test(bloom.Hash([]byte(v)))
+	case net.IP:
+		return s.bloom.Test(bloom.Hash(v.To16()))
+	}
+	return true
+}
@@ -0,0 +1,100 @@
+package archive
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/brimsec/zq/driver"
+	"github.com/brimsec/zq/filter"
+	"github.com/brimsec/zq/zio/tzngio"
+	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
+	"github.com/brimsec/zq/zql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const summaryLog = `
+#0:record[a:int64,u:uint64,f:float64,p:port,addr:ip,s:string,r:record[x:int32,t:set[bstring]]]
+0:[0;5;1.5;80;10.0.0.1;hello;[-3;[foo;]]]
+0:[42;18446744073709551615;NaN;443;192.168.1.1;-;-;]
+0:[-;7;-2.5;-;-;world;[12;-;]]
+`
+
+func TestSummary(t *testing.T) {
+	zardir, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer os.RemoveAll(zardir)
+
+	var recs []*zng.Record
+	r := tzngio.NewReader(strings.NewReader(summaryLog), resolver.NewContext())
+	s := newSummarizer()
+	for {
+		rec, err := r.Read()
+		require.NoError(t, err)
+		if rec == nil {
+			break
+		}
+		rec = rec.Keep()
+		recs = append(recs, rec)
+		require.NoError(t, s.add(rec))
+	}
+	require.NoError(t, s.write(filepath.Join(zardir, summaryFile)))
+
+	cases := []struct {
+		query  string
+		pruned bool
+	}{
+		{"a=0", false},
+		{"a<0", true},
+		{"a>42", true},
+		{"a>=42", false},
+		{"a=5.5", false},
+		{"u=6", false},
+		{"u>100", false},
+		// The largest u is too large for an int64 comparison.
+		{"u<5", false},
+		{"f>1.5", true},
+		{"f<-2", false},
+		{"p=80", false},
+		{"p=:80", false},
+		{"p=:22", true},
+		{"p>443", true},
+		{"addr=10.0.0.1", false},
+		{"addr=10.0.0.2", true},
+		{"*=192.168.1.1", false},
+		{"*=10.0.0.2", true},
+		{"s=hello", false},
+		{"s=goodbye", true},
+		{"*=foo", false},
+		{"*=bar", true},
+		{"r.x<-3", true},
+		{"r.x=12", false},
+		{"missing=1", true},
+		{"a=1 or r.x=-3", false},
+	}
+	for _, c := range cases {
+		t.Run(c.query, func(t *testing.T) {
+			proc, err := zql.ParseProc(c.query)
+			require.NoError(t, err)
+			fp, _ := driver.LiftFilter(proc)
+			require.NotNil(t, fp)
+			f := NewIndexFilter(fp.Filter)
+			require.NotNil(t, f)
+			ok, err := f.MayMatch(context.Background(), zardir)
+			require.NoError(t, err)
+			assert.Equal(t, c.pruned, !ok)
+			if !ok {
+				match, err := filter.Compile(fp.Filter)
+				require.NoError(t, err)
+				for _, rec := range recs {
+					assert.False(t, match(rec), "%s matches pruned record %s", c.query, rec)
+				}
+			}
+		})
+	}
+}
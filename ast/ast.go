@@ -100,6 +100,15 @@ type (
 		Field      FieldExpr `json:"field"`
 		Value      Literal   `json:"value"`
 	}
+	// A CompareExpr node represents a comparison operator with the
+	// value of an expression.  A function call by itself is represented
+	// as a comparison of its result with true.
+	CompareExpr struct {
+		Node
+		Comparator string     `json:"comparator"`
+		Expr       Expression `json:"expr"`
+		Value      Literal    `json:"value"`
+	}
 )
 
 // booleanEpxrNode() ensures that only boolean expression nodes can be
@@ -112,6 +121,7 @@ func (*LogicalNot) booleanExprNode()   {}
 func (*MatchAll) booleanExprNode()     {}
 func (*CompareAny) booleanExprNode()   {}
 func (*CompareField) booleanExprNode() {}
+func (*CompareExpr) booleanExprNode()  {}
 
 // A FieldExpr is any expression that refers to a field.
 type (
@@ -264,9 +274,18 @@ type (
 	// grouping key. In this case, the proc outputs the reducer
 	// results from each key as they complete so that large inputs
 	// are processed and streamed efficiently.
-	// The Limit field specifies the number of different groups that can be
-	// aggregated over. When absent, the runtime defaults to an
-	// appropriate value.
+	// The Limit field specifies the number of different groups that are
+	// held in memory. When absent, the runtime defaults to an
+	// appropriate value.  When the limit is exceeded, the partial
+	// results of the groups in memory are spilled to disk (if every
+	// reducer is decomposable and the input is unsorted) and merged
+	// when the input is exhausted.
+	// The EmitPart and ConsumePart fields, which require every reducer
+	// to be decomposable, split the computation in two for parallel
+	// execution: when EmitPart is set, the proc outputs the key columns
+	// and partial result of each reducer for each group, and when
+	// ConsumePart is set, it merges these partial results, grouping by
+	// the key targets.
 	GroupByProc struct {
 		Node
 		Duration     Duration     `json:"duration"`
@@ -274,6 +293,8 @@ type (
 		Limit        int          `json:"limit,omitempty"`
 		Keys         []Assignment `json:"keys"`
 		Reducers     []Reducer    `json:"reducers"`
+		ConsumePart  bool         `json:"consume_part,omitempty"`
+		EmitPart     bool         `json:"emit_part,omitempty"`
 	}
 	// TopProc is similar to proc.SortProc with a few key differences:
 	// - It only sorts in descending order.
@@ -291,6 +312,54 @@ type (
 		Node
 		Clauses []Assignment `json:"clauses"`
 	}
+
+	// A JoinProc node represents a proc that combines the records of
+	// the two parallel branches that precede it in the flowgraph.
+	// Records from the first (left) branch are matched with records
+	// from the second (right) branch whose RightKeys fields are
+	// equal to the left record's LeftKeys fields.  Kind is one of
+	// "inner" (emit each left record merged with every matching
+	// right record), "left" (like inner but also emit unmatched left
+	// records as is), or "anti" (emit only the unmatched left records).
+	JoinProc struct {
+		Node
+		Kind      string   `json:"kind"`
+		LeftKeys  []string `json:"left_keys"`
+		RightKeys []string `json:"right_keys"`
+	}
+
+	// A RunningProc node represents a proc that appends to each record
+	// the results of its reducers applied to a window of records that
+	// ends with that record.  Windows are computed separately for
+	// each partition of the input given by the values of the Keys
+	// fields.  If Rows is non-zero, the window comprises the last Rows
+	// records of the partition.  If Span is non-zero, the window
+	// comprises the records of the partition whose ts is within Span
+	// of the current record's ts.  Otherwise, the window comprises all
+	// of the records of the partition seen so far.
+	RunningProc struct {
+		Node
+		Rows     int       `json:"rows,omitempty"`
+		Span     Duration  `json:"span"`
+		Keys     []string  `json:"keys"`
+		Reducers []Reducer `json:"reducers"`
+	}
+
+	// A RenameProc node represents a proc that renames fields.  Each
+	// field is renamed in the order given and a nested field may be
+	// renamed only within the record that contains it, e.g., the
+	// target of id.orig_h must be of the form id.<name>.
+	RenameProc struct {
+		Node
+		Fields []FieldRename `json:"fields"`
+	}
+
+	// A FuseProc node represents a proc that consumes all the records
+	// in its input and converts them to a single record type that is
+	// the union of their types.
+	FuseProc struct {
+		Node
+	}
 )
 
 type Assignment struct {
@@ -298,6 +367,12 @@ type Assignment struct {
 	Expr   Expression `json:"expression"`
 }
 
+// A FieldRename gives the new name (Target) of a field (Source).
+type FieldRename struct {
+	Target string `json:"target"`
+	Source string `json:"source"`
+}
+
 //XXX TBD: chance to nano.Duration
 type Duration struct {
 	Seconds int `json:"seconds"`
@@ -331,13 +406,20 @@ func (*ReduceProc) ProcNode()     {}
 func (*GroupByProc) ProcNode()    {}
 func (*TopProc) ProcNode()        {}
 func (*PutProc) ProcNode()        {}
+func (*JoinProc) ProcNode()       {}
+func (*RunningProc) ProcNode()    {}
+func (*RenameProc) ProcNode()     {}
+func (*FuseProc) ProcNode()       {}
 
 // A Reducer is an AST node that represents a reducer function.  The Op
 // parameter indicates the specific reducer while the Field parameter indicates
 // which field of the incoming records should be operated upon by the reducer.
 // The result is given the field name specified by the Var parameter.
+// Param holds the reducer's optional argument, e.g., the percentile
+// computed by a Percentile reducer.
 type Reducer struct {
 	Node
 	Var   string    `json:"var"`
 	Field FieldExpr `json:"field,omitempty"`
+	Param string    `json:"param,omitempty"`
 }
@@ -108,6 +108,18 @@ func unpackProc(custom Unpacker, node joe.JSON) (Proc, error) {
 			return nil, err
 		}
 		return &TopProc{Fields: fields}, nil
+	case "JoinProc":
+		return &JoinProc{}, nil
+	case "RenameProc":
+		return &RenameProc{}, nil
+	case "FuseProc":
+		return &FuseProc{}, nil
+	case "RunningProc":
+		reducers, err := unpackReducers(node.Get("reducers"))
+		if err != nil {
+			return nil, err
+		}
+		return &RunningProc{Reducers: reducers}, nil
 	default:
 		return nil, fmt.Errorf("unknown proc op: %s", op)
 	}
@@ -310,6 +322,16 @@ func unpackBooleanExpr(node joe.JSON) (BooleanExpr, error) {
 			return nil, err
 		}
 		return &CompareField{Field: field}, nil
+	case "CompareExpr":
+		child := node.Get("expr")
+		if child == joe.Undefined {
+			return nil, errors.New("CompareExpr missing expr property")
+		}
+		expr, err := unpackExpression(child)
+		if err != nil {
+			return nil, err
+		}
+		return &CompareExpr{Expr: expr}, nil
 
 	default:
 		return nil, fmt.Errorf("unknown op: %s", op)
@@ -54,7 +54,7 @@ func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
 		to:      tsflag(nano.MaxTs),
 	}
 	f.StringVar(&c.Format, "f", "text", "format for output data [zng,ndjson,table,text,types,zeek,zjson,tzng]")
-	f.StringVar(&c.protocol, "p", "zng", "protocol to use for search request [ndjson,zjson,zng]")
+	f.StringVar(&c.protocol, "p", "zng", "protocol to use for search request [csv,json,ndjson,table,text,tsv,tzng,zeek,zjson,zng]")
 	f.StringVar(&c.dir, "d", "", "directory for output data files")
 	f.StringVar(&c.outputFile, "o", "", "write data to output file")
 	f.BoolVar(&c.stats, "S", false, "display search stats on stderr")
@@ -5,6 +5,7 @@ import (
 	"flag"
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/alecthomas/units"
 	"github.com/brimsec/zq/archive"
@@ -45,6 +46,9 @@ type Command struct {
 	kind     storage.Kind
 	datapath string
 	thresh   bytesValue
+	maintain time.Duration
+	compact  bool
+	retain   time.Duration
 }
 
 func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
@@ -56,6 +60,9 @@ func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
 	f.Var(&c.kind, "k", "kind of storage for this space")
 	f.StringVar(&c.datapath, "d", "", "specific directory for storage data")
 	f.Var(&c.thresh, "thresh", "target size of chopped files, as '10MB', '4GiB', etc.")
+	f.DurationVar(&c.maintain, "maintain", 0, "interval between scheduled maintenance runs of an archive space")
+	f.BoolVar(&c.compact, "compact", false, "merge small logs during scheduled maintenance")
+	f.DurationVar(&c.retain, "retain", 0, "remove logs older than this during scheduled maintenance")
 	return c, nil
 }
 
@@ -67,6 +74,10 @@ func (c *Command) Run(args []string) error {
 		return errors.New("must specify a space name")
 	}
 
+	if c.maintain == 0 && (c.compact || c.retain != 0) {
+		return errors.New("-compact and -retain require a -maintain interval")
+	}
+
 	client := c.Client()
 	req := api.SpacePostRequest{
 		Name:     args[0],
@@ -80,6 +91,13 @@ func (c *Command) Run(args []string) error {
 			},
 		},
 	}
+	if c.maintain != 0 {
+		req.Storage.Archive.Maintenance = &storage.ArchiveMaintenance{
+			Interval: int64(c.maintain),
+			Compact:  c.compact,
+			Retain:   int64(c.retain),
+		}
+	}
 	if _, err := client.SpacePost(c.Context(), req); err != nil {
 		return fmt.Errorf("couldn't create new space %s: %v", req.Name, err)
 	}
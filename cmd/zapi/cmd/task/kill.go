@@ -0,0 +1,49 @@
+package task
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"strconv"
+
+	"github.com/brimsec/zq/cmd/zapi/cmd"
+	"github.com/mccanne/charm"
+)
+
+var Kill = &charm.Spec{
+	Name:  "kill",
+	Usage: "kill <task id> [task id ...]",
+	Short: "cancel running searches",
+	Long: `The kill command cancels the searches with the given task IDs, as
+listed by the ps command.`,
+	New: NewKill,
+}
+
+type KillCommand struct {
+	*cmd.Command
+}
+
+func NewKill(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	return &KillCommand{Command: parent.(*cmd.Command)}, nil
+}
+
+func (c *KillCommand) Run(args []string) error {
+	if len(args) == 0 {
+		return errors.New("no task id provided")
+	}
+	var ids []int64
+	for _, arg := range args {
+		id, err := strconv.ParseInt(arg, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid task id: %s", arg)
+		}
+		ids = append(ids, id)
+	}
+	for _, id := range ids {
+		if err := c.Client().TaskCancel(c.Context(), id); err != nil {
+			return fmt.Errorf("task %d: %w", id, err)
+		}
+		fmt.Printf("task %d: canceled\n", id)
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package task
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/brimsec/zq/cmd/zapi/cmd"
+	"github.com/brimsec/zq/cmd/zapi/format"
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/mccanne/charm"
+)
+
+var Ps = &charm.Spec{
+	Name:  "ps",
+	Usage: "ps",
+	Short: "list running searches",
+	Long: `The ps command lists the searches running on the zqd host along with
+the progress of each.  The task ID shown may be passed to the kill command.`,
+	New: NewPs,
+}
+
+func init() {
+	cmd.CLI.Add(Ps)
+	cmd.CLI.Add(Kill)
+}
+
+type PsCommand struct {
+	*cmd.Command
+}
+
+func NewPs(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	return &PsCommand{Command: parent.(*cmd.Command)}, nil
+}
+
+func (c *PsCommand) Run(args []string) error {
+	if len(args) > 0 {
+		return errors.New("ps takes no arguments")
+	}
+	tasks, err := c.Client().TaskList(c.Context())
+	if err != nil {
+		return err
+	}
+	now := nano.Now()
+	w := tabwriter.NewWriter(os.Stdout, 0, 2, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tKIND\tSPACE\tELAPSED\tREAD\tMATCHED")
+	for _, t := range tasks {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%d\n", t.TaskID, t.Kind, t.Space,
+			time.Duration(now.SubTs(t.StartTime)).Truncate(time.Second),
+			format.Bytes(t.BytesRead), t.RecordsMatched)
+	}
+	return w.Flush()
+}
@@ -12,6 +12,7 @@ import (
 	_ "github.com/brimsec/zq/cmd/zapi/cmd/post"
 	_ "github.com/brimsec/zq/cmd/zapi/cmd/rename"
 	_ "github.com/brimsec/zq/cmd/zapi/cmd/rm"
+	_ "github.com/brimsec/zq/cmd/zapi/cmd/task"
 )
 
 // These variables are populated via the Go linker.
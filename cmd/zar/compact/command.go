@@ -0,0 +1,55 @@
+package compact
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"os"
+
+	"github.com/brimsec/zq/archive"
+	"github.com/brimsec/zq/cmd/zar/root"
+	"github.com/mccanne/charm"
+)
+
+var Compact = &charm.Spec{
+	Name:  "compact",
+	Usage: "compact [-R dir]",
+	Short: "merge small logs in an archive",
+	Long: `
+"zar compact" merges runs of adjacent logs in an archive whose combined
+size is no more than the archive's log size threshold into single logs.
+Logs are only merged with others in the same time partition.  The type and
+field indexes of the merged logs are rebuilt for the new logs, while any
+custom indexes are removed and must be recreated with "zar index".
+`,
+	New: New,
+}
+
+func init() {
+	root.Zar.Add(Compact)
+}
+
+type Command struct {
+	*root.Command
+	root string
+}
+
+func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	c := &Command{Command: parent.(*root.Command)}
+	f.StringVar(&c.root, "R", os.Getenv("ZAR_ROOT"), "root directory of zar archive to compact")
+	return c, nil
+}
+
+func (c *Command) Run(args []string) error {
+	if len(args) != 0 {
+		return errors.New("zar compact: too many arguments")
+	}
+	if c.root == "" {
+		return errors.New("zar compact: no archive root specified with -R or ZAR_ROOT")
+	}
+	ark, err := archive.OpenArchive(c.root, nil)
+	if err != nil {
+		return err
+	}
+	return archive.Compact(context.Background(), ark)
+}
@@ -27,6 +27,12 @@ into chunks where each chunk is created when the size threshold is exceeded,
 either in bytes (-b) or megabytes (-s).  The path of each chunk is a subdirectory
 in the specified directory (-R or ZAR_ROOT) where the subdirectory name is derived from the
 timestamp of the first zng record in that chunk.
+
+Each subdirectory holds the chunks of one partition of time, an hour, day
+or month as given by -p when the archive is created, and no chunk crosses
+a partition boundary.  Chunks of new data that overlap in time with chunks
+already in the archive are merged with them so that the time spans of
+the chunks in an archive never overlap.
 `,
 	New: New,
 }
@@ -39,6 +45,8 @@ type Command struct {
 	*root.Command
 	root        string
 	thresh      string
+	lz4         int
+	partition   string
 	empty       bool
 	ReaderFlags zio.ReaderFlags
 }
@@ -47,6 +55,8 @@ func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
 	c := &Command{Command: parent.(*root.Command)}
 	f.StringVar(&c.root, "R", os.Getenv("ZAR_ROOT"), "root directory of zar archive for chopped files")
 	f.StringVar(&c.thresh, "s", units.Base2Bytes(archive.DefaultLogSizeThreshold).String(), "target size of chopped files, as '10MB' or '4GiB', etc.")
+	f.IntVar(&c.lz4, "znglz4blocksize", archive.DefaultZngLZ4BlockSize, "LZ4 block size in bytes for compressing chopped files (0 for no compression)")
+	f.StringVar(&c.partition, "p", string(archive.DefaultPartition), "time partition of chunk directories when creating an archive [hour,day,month]")
 	f.BoolVar(&c.empty, "empty", false, "create an archive without initial data")
 	c.ReaderFlags.SetFlags(f)
 	return c, nil
@@ -65,6 +75,12 @@ func (c *Command) Run(args []string) error {
 	} else {
 		co.LogSizeThreshold = &thresh
 	}
+	co.ZngLZ4BlockSize = &c.lz4
+	partition, err := archive.ParsePartition(c.partition)
+	if err != nil {
+		return err
+	}
+	co.Partition = &partition
 
 	ark, err := archive.CreateOrOpenArchive(c.root, co, nil)
 	if err != nil {
@@ -4,10 +4,12 @@ import (
 	"fmt"
 	"os"
 
+	_ "github.com/brimsec/zq/cmd/zar/compact"
 	_ "github.com/brimsec/zq/cmd/zar/find"
 	_ "github.com/brimsec/zq/cmd/zar/import"
 	_ "github.com/brimsec/zq/cmd/zar/index"
 	_ "github.com/brimsec/zq/cmd/zar/ls"
+	_ "github.com/brimsec/zq/cmd/zar/retain"
 	_ "github.com/brimsec/zq/cmd/zar/rm"
 	_ "github.com/brimsec/zq/cmd/zar/rmdirs"
 	"github.com/brimsec/zq/cmd/zar/root"
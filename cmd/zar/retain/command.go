@@ -0,0 +1,88 @@
+package retain
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/brimsec/zq/archive"
+	"github.com/brimsec/zq/cmd/zar/root"
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/mccanne/charm"
+)
+
+var Retain = &charm.Spec{
+	Name:  "retain",
+	Usage: "retain [-R dir] -older-than duration",
+	Short: "remove aged logs from an archive",
+	Long: `
+"zar retain" removes the logs of an archive, along with their zar
+directories, whose records are all older than the duration given by
+-older-than, e.g., "90d" or "12h".  The removed logs are listed as they are
+removed.
+`,
+	New: New,
+}
+
+func init() {
+	root.Zar.Add(Retain)
+}
+
+type Command struct {
+	*root.Command
+	root      string
+	olderThan string
+}
+
+func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	c := &Command{Command: parent.(*root.Command)}
+	f.StringVar(&c.root, "R", os.Getenv("ZAR_ROOT"), "root directory of zar archive")
+	f.StringVar(&c.olderThan, "older-than", "", "remove logs older than this duration (e.g., 90d, 36h)")
+	return c, nil
+}
+
+// parseAge parses a duration as time.ParseDuration does, but also accepts
+// a whole number of days such as "90d".
+func parseAge(s string) (time.Duration, error) {
+	if strings.HasSuffix(s, "d") {
+		days, err := strconv.Atoi(strings.TrimSuffix(s, "d"))
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q", s)
+		}
+		return time.Duration(days) * 24 * time.Hour, nil
+	}
+	return time.ParseDuration(s)
+}
+
+func (c *Command) Run(args []string) error {
+	if len(args) != 0 {
+		return errors.New("zar retain: too many arguments")
+	}
+	if c.root == "" {
+		return errors.New("zar retain: no archive root specified with -R or ZAR_ROOT")
+	}
+	if c.olderThan == "" {
+		return errors.New("zar retain: no age specified with -older-than")
+	}
+	age, err := parseAge(c.olderThan)
+	if err != nil {
+		return fmt.Errorf("zar retain: %w", err)
+	}
+	if age < 0 {
+		return errors.New("zar retain: -older-than must not be negative")
+	}
+	ark, err := archive.OpenArchive(c.root, nil)
+	if err != nil {
+		return err
+	}
+	removed, err := archive.Retain(context.Background(), ark, nano.Now().Sub(int64(age)))
+	for _, si := range removed {
+		fmt.Printf("removed %s\n", si.LogID)
+	}
+	return err
+}
@@ -1,18 +1,23 @@
 package zq
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"flag"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"runtime"
+	"sync"
 
 	"github.com/brimsec/zq/archive"
 	"github.com/brimsec/zq/ast"
 	"github.com/brimsec/zq/cmd/zar/root"
 	"github.com/brimsec/zq/driver"
 	"github.com/brimsec/zq/emitter"
+	"github.com/brimsec/zq/pkg/bufwriter"
 	"github.com/brimsec/zq/pkg/nano"
 	"github.com/brimsec/zq/zbuf"
 	"github.com/brimsec/zq/zio"
@@ -34,6 +39,16 @@ the zq logic relative to that directory and emits the results in zng format.
 The file names here are relative to that directory and the special name "_" refers
 to the actual log file in the parent of the zar directory.
 
+When the input is "_" and the query begins with a search filter that compares
+fields with values (e.g., "id.orig_h=10.1.2.3" or "orig_bytes>1000") or looks
+for an IP address or string in any field (e.g., "*=10.1.2.3"), the summary
+written for each log by "zar import" and the field and type indexes created by
+"zar index" are consulted first, and logs whose summaries or indexes show they
+hold no matching records are skipped.
+
+The -P option sets the number of logs that are queried at once, which is the
+number of CPUs by default.  Output is written in the same order regardless.
+
 If the root directory is not specified by either the ZAR_ROOT environemnt
 variable or the -R option, then the current directory is assumed.
 `,
@@ -46,10 +61,11 @@ func init() {
 
 type Command struct {
 	*root.Command
-	root       string
-	outputFile string
-	stopErr    bool
-	quiet      bool
+	root        string
+	outputFile  string
+	stopErr     bool
+	quiet       bool
+	parallelism int
 }
 
 func fileExists(path string) bool {
@@ -69,6 +85,7 @@ func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
 	f.BoolVar(&c.quiet, "q", false, "don't display zql warnings")
 	f.StringVar(&c.outputFile, "o", "", "write data to output file")
 	f.BoolVar(&c.stopErr, "e", true, "stop upon input errors")
+	f.IntVar(&c.parallelism, "P", runtime.GOMAXPROCS(0), "number of logs to query at once")
 
 	return c, nil
 }
@@ -88,65 +105,181 @@ func (c *Command) Run(args []string) error {
 	if len(args) == 0 {
 		return errors.New("zar zq needs input arguments")
 	}
-	// XXX this is parallelizable except for writing to stdout when
-	// concatenating results
-	return archive.Walk(ark, func(zardir string) error {
-		inputs := args
-		var query ast.Proc
-		var err error
-		first := archive.Localize(zardir, inputs[0])
-		if first != "" && fileExists(first) {
-			query, err = zql.ParseProc("*")
-			if err != nil {
-				return err
+	var zardirs []string
+	err = archive.Walk(ark, func(zardir string) error {
+		zardirs = append(zardirs, zardir)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	// Run the query over as many as c.parallelism logs at once.  The
+	// output to stdout of the log that is next in the order of the walk
+	// is streamed while that of the logs running ahead of it is buffered
+	// until its turn, so the output is the same as if the logs were
+	// queried one at a time.
+	parallelism := c.parallelism
+	if parallelism < 1 {
+		parallelism = 1
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sem := make(chan struct{}, parallelism)
+	queries := make(chan *logQuery, parallelism)
+	go func() {
+		defer close(queries)
+		for _, zardir := range zardirs {
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				return
 			}
-		} else {
-			query, err = zql.ParseProc(inputs[0])
-			if err != nil {
-				return err
+			q := &logQuery{done: make(chan error, 1)}
+			go func(zardir string) {
+				q.done <- c.query(ctx, ark, zardir, args, &q.out)
+			}(zardir)
+			select {
+			case queries <- q:
+			case <-ctx.Done():
+				return
 			}
-			inputs = inputs[1:]
 		}
-		var localPaths []string
-		for _, input := range inputs {
-			localPaths = append(localPaths, archive.Localize(zardir, input))
+	}()
+	for q := range queries {
+		err := q.out.stream(os.Stdout)
+		if err == nil {
+			err = <-q.done
 		}
-		paths, err := c.verifyPaths(localPaths)
+		<-sem
 		if err != nil {
 			return err
 		}
-		if len(paths) == 0 {
-			// skip and warn if no inputs found
-			if !c.quiet {
-				fmt.Fprintf(os.Stderr, "%s: no inputs files found\n", zardir)
-			}
-			return nil
-		}
-		cfg := detector.OpenConfig{Format: "zng"}
-		rc := detector.MultiFileReader(resolver.NewContext(), paths, cfg)
-		defer rc.Close()
-		reader := zbuf.Reader(rc)
-		wch := make(chan string, 5)
-		if !c.stopErr {
-			reader = zbuf.NewWarningReader(reader, wch)
-		}
-		writer, err := c.openOutput(zardir, c.outputFile)
+	}
+	return nil
+}
+
+type logQuery struct {
+	out  output
+	done chan error
+}
+
+// output holds the output to stdout of a query until stream is called and
+// then passes it through.
+type output struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+	w   io.Writer
+}
+
+func (o *output) Write(b []byte) (int, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.w != nil {
+		return o.w.Write(b)
+	}
+	return o.buf.Write(b)
+}
+
+func (o *output) Close() error {
+	return nil
+}
+
+// stream writes the output held so far to w and then has later output
+// written directly to w.
+func (o *output) stream(w io.Writer) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if _, err := w.Write(o.buf.Bytes()); err != nil {
+		return err
+	}
+	o.buf = bytes.Buffer{}
+	o.w = w
+	return nil
+}
+
+// query runs the query given by args relative to zardir, writing any
+// output to stdout to out.
+func (c *Command) query(ctx context.Context, ark *archive.Archive, zardir string, args []string, out io.WriteCloser) error {
+	inputs := args
+	var query ast.Proc
+	var err error
+	first := archive.Localize(zardir, inputs[0])
+	if first != "" && fileExists(first) {
+		query, err = zql.ParseProc("*")
 		if err != nil {
 			return err
 		}
-		defer writer.Close()
-		// XXX we shouldn't need zap here, nano?  etc
-		reverse := ark.DataSortDirection == zbuf.DirTimeReverse
-		mux, err := driver.CompileWarningsCh(context.Background(), query, reader, reverse, nano.MaxSpan, zap.NewNop(), wch)
+	} else {
+		query, err = zql.ParseProc(inputs[0])
 		if err != nil {
 			return err
 		}
-		d := driver.NewCLI(writer)
+		inputs = inputs[1:]
+	}
+	var localPaths []string
+	for _, input := range inputs {
+		localPaths = append(localPaths, archive.Localize(zardir, input))
+	}
+	paths, err := c.verifyPaths(localPaths)
+	if err != nil {
+		return err
+	}
+	if len(paths) == 0 {
+		// skip and warn if no inputs found
 		if !c.quiet {
-			d.SetWarningsWriter(os.Stderr)
+			fmt.Fprintf(os.Stderr, "%s: no inputs files found\n", zardir)
 		}
-		return driver.Run(mux, d, nil)
-	})
+		return nil
+	}
+	if filterProc, _ := driver.LiftFilter(query); filterProc != nil && logOnly(inputs) {
+		if f := archive.NewIndexFilter(filterProc.Filter); f != nil {
+			ok, err := f.MayMatch(ctx, zardir)
+			if !ok || err != nil {
+				// The indexes show that no records match,
+				// so the output is empty.
+				return err
+			}
+		}
+	}
+	cfg := detector.OpenConfig{Format: "zng"}
+	rc := detector.MultiFileReader(resolver.NewContext(), paths, cfg)
+	defer rc.Close()
+	reader := zbuf.Reader(rc)
+	wch := make(chan string, 5)
+	if !c.stopErr {
+		reader = zbuf.NewWarningReader(reader, wch)
+	}
+	writer, err := c.openOutput(zardir, c.outputFile, out)
+	if err != nil {
+		return err
+	}
+	// XXX we shouldn't need zap here, nano?  etc
+	reverse := ark.DataSortDirection == zbuf.DirTimeReverse
+	mux, err := driver.CompileWarningsCh(ctx, query, reader, reverse, nano.MaxSpan, zap.NewNop(), wch)
+	if err != nil {
+		writer.Close()
+		return err
+	}
+	d := driver.NewCLI(writer)
+	if !c.quiet {
+		d.SetWarningsWriter(os.Stderr)
+	}
+	if err := driver.Run(mux, d, nil); err != nil {
+		writer.Close()
+		return err
+	}
+	return writer.Close()
+}
+
+// logOnly returns true if the only input is the log of a zar directory,
+// whose contents its indexes describe.
+func logOnly(inputs []string) bool {
+	for _, input := range inputs {
+		if input != "_" {
+			return false
+		}
+	}
+	return true
 }
 
 func (c *Command) verifyPaths(paths []string) ([]string, error) {
@@ -175,15 +308,12 @@ func (c *Command) verifyPaths(paths []string) ([]string, error) {
 	return files, nil
 }
 
-func (c *Command) openOutput(zardir, filename string) (zbuf.WriteCloser, error) {
-	path := filename
-	// prepend path if not stdout
-	if path != "" {
-		path = filepath.Join(zardir, filename)
-	}
-	w, err := emitter.NewFile(path, &zio.WriterFlags{Format: "zng"})
-	if err != nil {
-		return nil, err
+// openOutput opens the output file of the query relative to zardir or, if
+// filename is empty, a writer to stdout.
+func (c *Command) openOutput(zardir, filename string, stdout io.WriteCloser) (zbuf.WriteCloser, error) {
+	flags := &zio.WriterFlags{Format: "zng"}
+	if filename == "" {
+		return detector.LookupWriter(bufwriter.New(stdout), flags), nil
 	}
-	return w, nil
+	return emitter.NewFile(filepath.Join(zardir, filename), flags)
 }
@@ -54,14 +54,15 @@ func init() {
 
 type Command struct {
 	*root.Command
-	listenAddr     string
-	conf           zqd.Config
-	pprof          bool
-	zeekRunnerPath string
-	configfile     string
-	loggerConf     *logger.Config
-	logger         *zap.Logger
-	devMode        bool
+	listenAddr         string
+	conf               zqd.Config
+	pprof              bool
+	zeekRunnerPath     string
+	suricataRunnerPath string
+	configfile         string
+	loggerConf         *logger.Config
+	logger             *zap.Logger
+	devMode            bool
 }
 
 func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
@@ -69,6 +70,9 @@ func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
 	f.StringVar(&c.listenAddr, "l", ":9867", "[addr]:port to listen on")
 	f.StringVar(&c.conf.Root, "datadir", ".", "data directory")
 	f.StringVar(&c.zeekRunnerPath, "zeekrunner", "", "path to command that generates zeek logs from pcap data")
+	f.StringVar(&c.suricataRunnerPath, "suricatarunner", "", "path to command that generates suricata eve.json from pcap data")
+	f.Int64Var(&c.conf.SearchCacheMaxBytes, "searchcachemaxbytes", 256*1024*1024, "size limit in bytes of the search result cache (0 disables the cache)")
+	f.IntVar(&c.conf.SearchParallelism, "searchparallelism", 0, "number of archive logs each search reads at once (0 means the number of CPUs)")
 	f.BoolVar(&c.pprof, "pprof", false, "add pprof routes to api")
 	f.StringVar(&c.configfile, "config", "", "path to a zqd config file")
 	f.BoolVar(&c.devMode, "dev", false, "runs zqd in development mode")
@@ -87,6 +91,7 @@ func (c *Command) Run(args []string) error {
 		zap.String("datadir", c.conf.Root),
 		zap.Bool("pprof_routes", c.pprof),
 		zap.Bool("zeek_supported", core.HasZeek()),
+		zap.Bool("suricata_supported", core.HasSuricata()),
 	)
 	h := zqd.NewHandler(core, c.logger)
 	if c.pprof {
@@ -118,7 +123,10 @@ func (c *Command) init() error {
 	if err := c.initLogger(); err != nil {
 		return err
 	}
-	return c.initZeek()
+	if err := c.initZeek(); err != nil {
+		return err
+	}
+	return c.initSuricata()
 }
 
 func pprofHandlers(h http.Handler) http.Handler {
@@ -158,7 +166,7 @@ func (c *Command) loadConfigFile() error {
 	c.loggerConf = &conf.Logger
 	if v := conf.SortMemMaxBytes; v != nil {
 		if *v <= 0 {
-			return fmt.Errorf("%s: sortMemMaxBytes value must be greater than zero", c.configfile)
+			return fmt.Errorf("%s: sort_mem_max_bytes value must be greater than zero", c.configfile)
 		}
 		proc.SortMemMaxBytes = *v
 	}
@@ -177,6 +185,18 @@ func (c *Command) initZeek() error {
 	return nil
 }
 
+func (c *Command) initSuricata() error {
+	if c.suricataRunnerPath == "" {
+		return nil
+	}
+	ln, err := zeek.NewLauncher("suricata", c.suricataRunnerPath)
+	if err != nil {
+		return err
+	}
+	c.conf.SuricataLauncher = ln
+	return nil
+}
+
 func (c *Command) initLogger() error {
 	if c.loggerConf == nil {
 		c.loggerConf = defaultLogger
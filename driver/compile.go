@@ -41,7 +41,7 @@ func CompileWarningsChCustom(ctx context.Context, custom proc.Compiler, program
 		}
 		setGroupByProcInputSortDir(program, readerSortKey, dir)
 	}
-	filterAst, program := liftFilter(program)
+	filterAst, program := LiftFilter(program)
 	scanner, err := newScanner(ctx, reader, filterAst, span)
 	if err != nil {
 		return nil, err
@@ -60,11 +60,11 @@ func CompileWarningsChCustom(ctx context.Context, custom proc.Compiler, program
 	return NewMuxOutput(pctx, leaves, scanner), nil
 }
 
-// liftFilter removes the filter at the head of the flowgraph AST, if
+// LiftFilter removes the filter at the head of the flowgraph AST, if
 // one is present, and returns it and the modified flowgraph AST. If
 // the flowgraph does not start with a filter, it returns nil and the
 // unmodified flowgraph.
-func liftFilter(p ast.Proc) (*ast.FilterProc, ast.Proc) {
+func LiftFilter(p ast.Proc) (*ast.FilterProc, ast.Proc) {
 	if fp, ok := p.(*ast.FilterProc); ok {
 		pass := &ast.PassProc{
 			Node: ast.Node{"PassProc"},
@@ -24,7 +24,13 @@ type MuxOutput struct {
 	muxProcs []*Mux
 	once     sync.Once
 	in       chan MuxResult
-	scanner  *scanner.Scanner
+	stats    statser
+}
+
+// A statser reports the statistics of the scanning at the head of a
+// flowgraph.
+type statser interface {
+	Stats() api.ScannerStats
 }
 
 type Mux struct {
@@ -67,9 +73,17 @@ func (m *Mux) run() {
 }
 
 func NewMuxOutput(ctx *proc.Context, parents []proc.Proc, scanner *scanner.Scanner) *MuxOutput {
+	var stats statser
+	if scanner != nil {
+		stats = scanner
+	}
+	return newMuxOutput(ctx, parents, stats)
+}
+
+func newMuxOutput(ctx *proc.Context, parents []proc.Proc, stats statser) *MuxOutput {
 	n := len(parents)
 	c := make(chan MuxResult, n)
-	mux := &MuxOutput{ctx: ctx, runners: n, in: c, scanner: scanner}
+	mux := &MuxOutput{ctx: ctx, runners: n, in: c, stats: stats}
 	for id, parent := range parents {
 		mux.muxProcs = append(mux.muxProcs, newMux(ctx, parent, id, c))
 	}
@@ -77,10 +91,10 @@ func NewMuxOutput(ctx *proc.Context, parents []proc.Proc, scanner *scanner.Scann
 }
 
 func (m *MuxOutput) Stats() api.ScannerStats {
-	if m.scanner == nil {
+	if m.stats == nil {
 		return api.ScannerStats{}
 	}
-	return m.scanner.Stats()
+	return m.stats.Stats()
 }
 
 func (m *MuxOutput) Complete() bool {
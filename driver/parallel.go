@@ -0,0 +1,277 @@
+package driver
+
+import (
+	"context"
+	"sync"
+
+	"github.com/brimsec/zq/ast"
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/proc"
+	"github.com/brimsec/zq/reducer"
+	"github.com/brimsec/zq/reducer/compile"
+	"github.com/brimsec/zq/scanner"
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zng/resolver"
+	"github.com/brimsec/zq/zqd/api"
+	"github.com/brimsec/zq/zqe"
+	"go.uber.org/zap"
+)
+
+// An Opener opens one of the inputs of a parallel flowgraph, reading its
+// records into zctx.
+type Opener func(ctx context.Context, zctx *resolver.Context) (zbuf.ReadCloser, error)
+
+// inputBatches is the number of batches of output from each input that
+// may be queued ahead of the rest of a parallel flowgraph.
+const inputBatches = 4
+
+// CompileParallel is like CompileWarningsChCustom but reads its input from
+// the readers returned by inputs, which must each be sorted by time in the
+// direction given by reverse and must not overlap one another in time.
+// The filter at the head of program, along with the group-by or reduce
+// proc that follows it if all of its reducers are decomposable, is run
+// over as many as parallelism inputs at once.  The outputs of the inputs
+// are passed to the rest of the flowgraph in the order of inputs, so time
+// order is preserved, and the partial results of a parallel group-by are
+// merged there.
+func CompileParallel(ctx context.Context, program ast.Proc, inputs []Opener, readerSortKey string, reverse bool, span nano.Span, logger *zap.Logger, ch chan string, parallelism int) (*MuxOutput, error) {
+	ReplaceGroupByProcDurationWithKey(program)
+	if readerSortKey != "" {
+		dir := 1
+		if reverse {
+			dir = -1
+		}
+		setGroupByProcInputSortDir(program, readerSortKey, dir)
+	}
+	filterAst, program := LiftFilter(program)
+	groupby, program := liftDecomposable(program)
+	pctx := &proc.Context{
+		Context:     ctx,
+		TypeContext: resolver.NewContext(),
+		Logger:      logger,
+		Reverse:     reverse,
+		Warnings:    ch,
+	}
+	// Compile the head once here so that errors are reported before
+	// the search starts.
+	if _, err := newScanner(ctx, nil, filterAst, span); err != nil {
+		return nil, err
+	}
+	if groupby != nil {
+		if _, err := proc.CompileGroupBy(groupby, pctx.TypeContext); err != nil {
+			return nil, err
+		}
+	}
+	if parallelism < 1 {
+		parallelism = 1
+	}
+	head := &parallelHead{
+		pctx:    pctx,
+		inputs:  inputs,
+		filter:  filterAst,
+		groupby: groupby,
+		span:    span,
+		sem:     make(chan struct{}, parallelism),
+		results: make(chan chan proc.Result, parallelism),
+	}
+	leaves, err := proc.CompileProc(nil, program, pctx, head)
+	if err != nil {
+		return nil, err
+	}
+	return newMuxOutput(pctx, leaves, head), nil
+}
+
+// liftDecomposable splits the group-by or reduce proc at the head of the
+// flowgraph AST, if all of its reducers are decomposable, into a group-by
+// proc that emits partial results, which it returns, and one that consumes
+// them, which takes its place in the returned flowgraph AST.  Otherwise, it
+// returns nil and the unmodified flowgraph.
+func liftDecomposable(p ast.Proc) (*ast.GroupByProc, ast.Proc) {
+	first := p
+	var rest []ast.Proc
+	if seq, ok := p.(*ast.SequentialProc); ok {
+		if len(seq.Procs) == 0 {
+			return nil, p
+		}
+		first, rest = seq.Procs[0], seq.Procs[1:]
+	}
+	var groupby ast.GroupByProc
+	switch first := first.(type) {
+	case *ast.GroupByProc:
+		groupby = *first
+	case *ast.ReduceProc:
+		// A reduce is a group-by with no keys.
+		groupby = ast.GroupByProc{
+			Node:     ast.Node{"GroupByProc"},
+			Reducers: first.Reducers,
+		}
+	default:
+		return nil, p
+	}
+	if !decomposable(groupby.Reducers) {
+		return nil, p
+	}
+	// Partial results are output in no particular order.
+	groupby.InputSortDir = 0
+	emit, consume := groupby, groupby
+	emit.EmitPart = true
+	consume.ConsumePart = true
+	return &emit, &ast.SequentialProc{
+		Node:  ast.Node{"SequentialProc"},
+		Procs: append([]ast.Proc{&consume}, rest...),
+	}
+}
+
+func decomposable(reducers []ast.Reducer) bool {
+	zctx := resolver.NewContext()
+	for _, r := range reducers {
+		compiled, err := compile.Compile(r, zctx)
+		if err != nil {
+			return false
+		}
+		if _, ok := compiled.Instantiate().(reducer.Decomposable); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// A parallelHead is the head of a flowgraph compiled by CompileParallel.
+// It runs a scanner, followed by a group-by proc emitting partial results
+// if there is one, over each input in its own goroutine, running no more
+// goroutines at once than the capacity of sem, and returns their outputs
+// in the order of the inputs.
+type parallelHead struct {
+	pctx    *proc.Context
+	inputs  []Opener
+	filter  *ast.FilterProc
+	groupby *ast.GroupByProc
+	span    nano.Span
+	sem     chan struct{}
+	once    sync.Once
+	// results holds the output channel of each running input in the
+	// order of the inputs.
+	results chan chan proc.Result
+	current chan proc.Result
+
+	mu       sync.Mutex
+	scanners []*scanner.Scanner
+}
+
+func (p *parallelHead) Pull() (zbuf.Batch, error) {
+	p.once.Do(func() { go p.run() })
+	for {
+		if p.current == nil {
+			ch, ok := <-p.results
+			if !ok {
+				return nil, p.pctx.Err()
+			}
+			p.current = ch
+		}
+		var res proc.Result
+		select {
+		case res = <-p.current:
+		case <-p.pctx.Done():
+			return nil, p.pctx.Err()
+		}
+		if proc.EOS(res.Batch, res.Err) {
+			if res.Err != nil {
+				return nil, res.Err
+			}
+			p.current = nil
+			continue
+		}
+		return res.Batch, nil
+	}
+}
+
+// Done is required to implement proc.Proc.  As with scanner.Scanner, the
+// inputs stop when the search's context is canceled.
+func (p *parallelHead) Done() {}
+
+// Parents is required to implement proc.Proc.  A parallelHead is always the
+// head of a flowgraph.
+func (p *parallelHead) Parents() []proc.Proc { return nil }
+
+// Stats returns the sum of the statistics of the scanners of the inputs.
+func (p *parallelHead) Stats() api.ScannerStats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var stats api.ScannerStats
+	for _, s := range p.scanners {
+		ss := s.Stats()
+		stats.BytesRead += ss.BytesRead
+		stats.BytesMatched += ss.BytesMatched
+		stats.RecordsRead += ss.RecordsRead
+		stats.RecordsMatched += ss.RecordsMatched
+	}
+	return stats
+}
+
+func (p *parallelHead) run() {
+	defer close(p.results)
+	for _, open := range p.inputs {
+		select {
+		case p.sem <- struct{}{}:
+		case <-p.pctx.Done():
+			return
+		}
+		ch := make(chan proc.Result, inputBatches)
+		go func(open Opener) {
+			p.runInput(open, ch)
+			<-p.sem
+		}(open)
+		select {
+		case p.results <- ch:
+		case <-p.pctx.Done():
+			return
+		}
+	}
+}
+
+// runInput sends the output of the head of the flowgraph over the input
+// opened by open to ch, ending with an end of stream or an error.
+func (p *parallelHead) runInput(open Opener, ch chan<- proc.Result) {
+	send := func(batch zbuf.Batch, err error) bool {
+		select {
+		case ch <- proc.Result{Batch: batch, Err: err}:
+			return true
+		case <-p.pctx.Done():
+			return false
+		}
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			send(nil, zqe.RecoverError(r))
+		}
+	}()
+	reader, err := open(p.pctx, p.pctx.TypeContext)
+	if err != nil {
+		send(nil, err)
+		return
+	}
+	defer reader.Close()
+	s, err := newScanner(p.pctx, reader, p.filter, p.span)
+	if err != nil {
+		send(nil, err)
+		return
+	}
+	p.mu.Lock()
+	p.scanners = append(p.scanners, s)
+	p.mu.Unlock()
+	var head proc.Proc = s
+	if p.groupby != nil {
+		params, err := proc.CompileGroupBy(p.groupby, p.pctx.TypeContext)
+		if err != nil {
+			send(nil, err)
+			return
+		}
+		head = proc.NewGroupBy(p.pctx, s, *params)
+	}
+	for {
+		batch, err := head.Pull()
+		if !send(batch, err) || proc.EOS(batch, err) {
+			return
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package driver
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/brimsec/zq/ast"
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zio/tzngio"
+	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
+	"github.com/brimsec/zq/zql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type sliceReader struct {
+	recs []*zng.Record
+}
+
+func (r *sliceReader) Read() (*zng.Record, error) {
+	if len(r.recs) == 0 {
+		return nil, nil
+	}
+	rec := r.recs[0]
+	r.recs = r.recs[1:]
+	return rec, nil
+}
+
+func (r *sliceReader) Close() error { return nil }
+
+func TestCompileParallel(t *testing.T) {
+	f, err := os.Open("../tests/suite/zdx/babble.tzng")
+	require.NoError(t, err)
+	defer f.Close()
+	zctx := resolver.NewContext()
+	var recs []*zng.Record
+	r := tzngio.NewReader(f, zctx)
+	for {
+		rec, err := r.Read()
+		require.NoError(t, err)
+		if rec == nil {
+			break
+		}
+		recs = append(recs, rec.Keep())
+	}
+	// Split the records, which are sorted by time, into inputs of
+	// varying size.
+	var inputs []Opener
+	for off, n := 0, 1; off < len(recs); off, n = off+n, n+7 {
+		end := off + n
+		if end > len(recs) {
+			end = len(recs)
+		}
+		chunk := recs[off:end]
+		inputs = append(inputs, func(context.Context, *resolver.Context) (zbuf.ReadCloser, error) {
+			return &sliceReader{chunk}, nil
+		})
+	}
+	require.True(t, len(inputs) > 10)
+
+	run := func(mux *MuxOutput, err error) string {
+		require.NoError(t, err)
+		var b strings.Builder
+		require.NoError(t, Run(mux, NewCLI(tzngio.NewWriter(&b)), nil))
+		return b.String()
+	}
+	queries := []string{
+		"*",
+		"v=257",
+		"head 3",
+		"tail 3",
+		"count()",
+		"v>400 | count()",
+		"count(), sum(v), avg(v), min(v), max(v), first(s), last(s)",
+		"countdistinct(s)",
+		"count() by v | sort v",
+		"every 1h count() | sort ts",
+		"sum(v) by s | sort s | head 10",
+		"v<10 | cut s",
+		"no-such-record | count()",
+	}
+	for _, q := range queries {
+		t.Run(q, func(t *testing.T) {
+			query, err := zql.ParseProc(q)
+			require.NoError(t, err)
+			reader := &sliceReader{recs}
+			expected := run(Compile(context.Background(), query, reader, "ts", false, nano.MaxSpan, nil))
+			for _, parallelism := range []int{1, 3, 16} {
+				query, err := zql.ParseProc(q)
+				require.NoError(t, err)
+				ch := make(chan string, 5)
+				actual := run(CompileParallel(context.Background(), query, inputs, "ts", false, nano.MaxSpan, nil, ch, parallelism))
+				assert.Equal(t, expected, actual, "parallelism %d", parallelism)
+			}
+		})
+	}
+}
+
+func TestLiftDecomposable(t *testing.T) {
+	cases := []struct {
+		query string
+		split bool
+	}{
+		{"count()", true},
+		{"count() by s | sort s", true},
+		{"* | every 1h sum(v)", true},
+		{"s=foo | first(v), last(v), avg(v)", true},
+		{"lag(v)", false},
+		{"head 1 | count()", false},
+		{"sort v", false},
+	}
+	for _, c := range cases {
+		query, err := zql.ParseProc(c.query)
+		require.NoError(t, err)
+		ReplaceGroupByProcDurationWithKey(query)
+		_, query = LiftFilter(query)
+		groupby, rest := liftDecomposable(query)
+		if !c.split {
+			assert.Nil(t, groupby, c.query)
+			assert.Equal(t, query, rest, c.query)
+			continue
+		}
+		require.NotNil(t, groupby, c.query)
+		assert.True(t, groupby.EmitPart, c.query)
+		seq, ok := rest.(*ast.SequentialProc)
+		require.True(t, ok, c.query)
+		consume, ok := seq.Procs[0].(*ast.GroupByProc)
+		require.True(t, ok, c.query)
+		assert.True(t, consume.ConsumePart, c.query)
+	}
+}
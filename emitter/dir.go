@@ -102,24 +102,33 @@ func (d *Dir) filename(r *zng.Record) (string, string) {
 	} else {
 		base = strconv.Itoa(r.Type.ID())
 	}
-	name := d.prefix + base + d.ext
+	return d.join(d.prefix + base + d.ext), _path
+}
+
+func (d *Dir) join(name string) string {
 	if u, _ := url.Parse(d.dir); u != nil {
 		u.Path = path.Join(u.Path, name)
-		return u.String(), _path
+		return u.String()
 	}
-	return filepath.Join(d.dir, name), _path
+	return filepath.Join(d.dir, name)
 }
 
 func (d *Dir) newFile(rec *zng.Record) (*zio.Writer, error) {
 	filename, path := d.filename(rec)
 	if w, ok := d.paths[path]; ok {
-		return w, nil
+		if w.MixedTypes() {
+			return w, nil
+		}
+		// The file can't hold records of more than one type (e.g.,
+		// it's a Parquet file) so each type with this _path gets its
+		// own file.
+		filename = d.join(d.prefix + path + "-" + strconv.Itoa(rec.Type.ID()) + d.ext)
 	}
 	w, err := NewFileWithSource(filename, d.flags, d.source)
 	if err != nil {
 		return nil, err
 	}
-	if path != "" {
+	if _, ok := d.paths[path]; !ok && path != "" {
 		d.paths[path] = w
 	}
 	return w, err
@@ -12,6 +12,7 @@ import (
 	"github.com/brimsec/zq/reglob"
 	"github.com/brimsec/zq/zcode"
 	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
 	"github.com/brimsec/zq/zngnative"
 )
 
@@ -27,8 +28,9 @@ var ErrBadCast = errors.New("bad cast")
 type NativeEvaluator func(*zng.Record) (zngnative.Value, error)
 
 // CompileExpr tries to compile the given Expression into a function
-// that evalutes the expression against a provided Record.  Returns an
-// error if compilation fails for any reason.
+// that evalutes the expression against a provided Record.  Any container
+// types created by the expression's functions are allocated from zctx.
+// Returns an error if compilation fails for any reason.
 //
 // This is currently not particularly optimized -- it creates a bunch
 // of closures and every evaluation involves some allocations.
@@ -38,8 +40,8 @@ type NativeEvaluator func(*zng.Record) (zngnative.Value, error)
 // more efficiently.  ZNG unions are a challenge for this approach, but
 // we could fail back to the "slow path" implemented here if an
 // expression ever touches a union.
-func CompileExpr(node ast.Expression) (ExpressionEvaluator, error) {
-	ne, err := compileNative(node)
+func CompileExpr(zctx *resolver.Context, node ast.Expression) (ExpressionEvaluator, error) {
+	ne, err := compileNative(zctx, node)
 	if err != nil {
 		return nil, err
 	}
@@ -54,7 +56,7 @@ func CompileExpr(node ast.Expression) (ExpressionEvaluator, error) {
 	}, nil
 }
 
-func compileNative(node ast.Expression) (NativeEvaluator, error) {
+func compileNative(zctx *resolver.Context, node ast.Expression) (NativeEvaluator, error) {
 	switch n := node.(type) {
 	case *ast.Literal:
 		v, err := zng.Parse(*n)
@@ -85,14 +87,14 @@ func compileNative(node ast.Expression) (NativeEvaluator, error) {
 		}, nil
 
 	case *ast.UnaryExpression:
-		return compileUnary(*n)
+		return compileUnary(zctx, *n)
 
 	case *ast.BinaryExpression:
-		lhsFunc, err := compileNative(n.LHS)
+		lhsFunc, err := compileNative(zctx, n.LHS)
 		if err != nil {
 			return nil, err
 		}
-		rhsFunc, err := compileNative(n.RHS)
+		rhsFunc, err := compileNative(zctx, n.RHS)
 		if err != nil {
 			return nil, err
 		}
@@ -118,24 +120,24 @@ func compileNative(node ast.Expression) (NativeEvaluator, error) {
 		}
 
 	case *ast.ConditionalExpression:
-		return compileConditional(*n)
+		return compileConditional(zctx, *n)
 
 	case *ast.FunctionCall:
-		return compileFunctionCall(*n)
+		return compileFunctionCall(zctx, *n)
 
 	case *ast.CastExpression:
-		return compileCast(*n)
+		return compileCast(zctx, *n)
 
 	default:
 		return nil, fmt.Errorf("invalid expression type %T", node)
 	}
 }
 
-func compileUnary(node ast.UnaryExpression) (NativeEvaluator, error) {
+func compileUnary(zctx *resolver.Context, node ast.UnaryExpression) (NativeEvaluator, error) {
 	if node.Operator != "!" {
 		return nil, fmt.Errorf("unknown unary operator %s\n", node.Operator)
 	}
-	fn, err := compileNative(node.Operand)
+	fn, err := compileNative(zctx, node.Operand)
 	if err != nil {
 		return nil, err
 	}
@@ -837,16 +839,16 @@ func compileArrayIndex(lhsFunc, rhsFunc NativeEvaluator, operator string) (Nativ
 	}, nil
 }
 
-func compileConditional(node ast.ConditionalExpression) (NativeEvaluator, error) {
-	conditionFunc, err := compileNative(node.Condition)
+func compileConditional(zctx *resolver.Context, node ast.ConditionalExpression) (NativeEvaluator, error) {
+	conditionFunc, err := compileNative(zctx, node.Condition)
 	if err != nil {
 		return nil, err
 	}
-	thenFunc, err := compileNative(node.Then)
+	thenFunc, err := compileNative(zctx, node.Then)
 	if err != nil {
 		return nil, err
 	}
-	elseFunc, err := compileNative(node.Else)
+	elseFunc, err := compileNative(zctx, node.Else)
 	if err != nil {
 		return nil, err
 	}
@@ -902,8 +904,8 @@ func compileFieldReference(lhsFunc, rhsFunc NativeEvaluator, operator string) (N
 	}, nil
 }
 
-func compileFunctionCall(node ast.FunctionCall) (NativeEvaluator, error) {
-	fn, ok := allFns[node.Function]
+func compileFunctionCall(zctx *resolver.Context, node ast.FunctionCall) (NativeEvaluator, error) {
+	fn, ok := lookupFunction(zctx, node.Function)
 	if !ok {
 		return nil, fmt.Errorf("%s: %w", node.Function, ErrNoSuchFunction)
 	}
@@ -918,7 +920,7 @@ func compileFunctionCall(node ast.FunctionCall) (NativeEvaluator, error) {
 
 	exprs := make([]NativeEvaluator, nargs)
 	for i, expr := range node.Args {
-		eval, err := compileNative(expr)
+		eval, err := compileNative(zctx, expr)
 		if err != nil {
 			return nil, err
 		}
@@ -939,8 +941,8 @@ func compileFunctionCall(node ast.FunctionCall) (NativeEvaluator, error) {
 	}, nil
 }
 
-func compileCast(node ast.CastExpression) (NativeEvaluator, error) {
-	fn, err := compileNative(node.Expr)
+func compileCast(zctx *resolver.Context, node ast.CastExpression) (NativeEvaluator, error) {
+	fn, err := compileNative(zctx, node.Expr)
 	if err != nil {
 		return nil, err
 	}
@@ -5,12 +5,16 @@ import (
 	"fmt"
 	"math"
 	"net"
+	"regexp"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 	"unicode/utf8"
 
+	"github.com/brimsec/zq/zcode"
 	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
 	"github.com/brimsec/zq/zngnative"
 )
 
@@ -19,12 +23,15 @@ type Function func([]zngnative.Value) (zngnative.Value, error)
 var ErrTooFewArgs = errors.New("too few arguments")
 var ErrTooManyArgs = errors.New("too many arguments")
 var ErrBadArgument = errors.New("bad argument")
+var ErrNoMatch = errors.New("no match")
 
-var allFns = map[string]struct {
+type function struct {
 	minArgs int
 	maxArgs int
 	impl    Function
-}{
+}
+
+var allFns = map[string]function{
 	"len": {1, 1, lenFn},
 
 	"Math.abs":   {1, 1, mathAbs},
@@ -38,15 +45,27 @@ var allFns = map[string]struct {
 	"Math.pow":   {2, 2, mathPow},
 	"Math.sqrt":  {1, 1, mathSqrt},
 
+	"Net.broadcast":   {1, 1, netBroadcast},
+	"Net.family":      {1, 1, netFamily},
+	"Net.isMulticast": {1, 1, netIsMulticast},
+	"Net.isPrivate":   {1, 1, netIsPrivate},
+	"Net.mask":        {2, 2, netMask},
+
 	"String.byteLen":     {1, 1, stringByteLen},
+	"String.endsWith":    {2, 2, stringEndsWith},
+	"String.extract":     {2, 3, stringExtract},
 	"String.formatFloat": {1, 1, stringFormatFloat},
 	"String.formatInt":   {1, 1, stringFormatInt},
 	"String.formatIp":    {1, 1, stringFormatIp},
+	"String.join":        {2, 2, stringJoin},
+	"String.match":       {2, 2, stringMatch},
 	"String.parseFloat":  {1, 1, stringParseFloat},
 	"String.parseInt":    {1, 1, stringParseInt},
 	"String.parseIp":     {1, 1, stringParseIp},
 	"String.replace":     {3, 3, stringReplace},
 	"String.runeLen":     {1, 1, stringRuneLen},
+	"String.startsWith":  {2, 2, stringStartsWith},
+	"String.substr":      {2, 3, stringSubstr},
 	"String.toLower":     {1, 1, stringToLower},
 	"String.toUpper":     {1, 1, stringToUpper},
 	"String.trim":        {1, 1, stringTrim},
@@ -58,6 +77,27 @@ var allFns = map[string]struct {
 	"Time.trunc":            {2, 2, timeTrunc},
 }
 
+// ctxFns holds the functions whose results have container types, which
+// must be allocated from the type context of the calling proc.  Each entry
+// returns the implementation of its function for a context.
+var ctxFns = map[string]struct {
+	minArgs int
+	maxArgs int
+	newImpl func(*resolver.Context) Function
+}{
+	"String.split": {2, 2, newStringSplit},
+}
+
+func lookupFunction(zctx *resolver.Context, name string) (function, bool) {
+	if fn, ok := allFns[name]; ok {
+		return fn, true
+	}
+	if fn, ok := ctxFns[name]; ok {
+		return function{fn.minArgs, fn.maxArgs, fn.newImpl(zctx)}, true
+	}
+	return function{}, false
+}
+
 func err(fn string, err error) (zngnative.Value, error) {
 	return zngnative.Value{}, fmt.Errorf("%s: %w", fn, err)
 }
@@ -283,6 +323,103 @@ func mathSqrt(args []zngnative.Value) (zngnative.Value, error) {
 	return zngnative.Value{zng.TypeFloat64, r}, nil
 }
 
+func netMask(args []zngnative.Value) (zngnative.Value, error) {
+	if args[0].Type.ID() != zng.IdIP {
+		return err("Net.mask", ErrBadArgument)
+	}
+	bits, ok := zngnative.CoerceNativeToInt(args[1])
+	if !ok {
+		return err("Net.mask", ErrBadArgument)
+	}
+	ip := args[0].Value.(net.IP)
+	n := 128
+	if v4 := ip.To4(); v4 != nil {
+		ip = v4
+		n = 32
+	}
+	if bits < 0 || bits > int64(n) {
+		return err("Net.mask", ErrBadArgument)
+	}
+	mask := net.CIDRMask(int(bits), n)
+	return zngnative.Value{zng.TypeNet, &net.IPNet{IP: ip.Mask(mask), Mask: mask}}, nil
+}
+
+func netBroadcast(args []zngnative.Value) (zngnative.Value, error) {
+	if args[0].Type.ID() != zng.IdNet {
+		return err("Net.broadcast", ErrBadArgument)
+	}
+	subnet := args[0].Value.(*net.IPNet)
+	ip := subnet.IP.To4()
+	if ip == nil {
+		ip = subnet.IP.To16()
+	}
+	mask := subnet.Mask
+	if len(mask) != len(ip) {
+		return err("Net.broadcast", ErrBadArgument)
+	}
+	out := make(net.IP, len(ip))
+	for k := range ip {
+		out[k] = ip[k] | ^mask[k]
+	}
+	return zngnative.Value{zng.TypeIP, out}, nil
+}
+
+// netFamily returns 4 for IPv4 addresses and networks and 6 for IPv6
+// addresses and networks.
+func netFamily(args []zngnative.Value) (zngnative.Value, error) {
+	var ip net.IP
+	switch args[0].Type.ID() {
+	case zng.IdIP:
+		ip = args[0].Value.(net.IP)
+	case zng.IdNet:
+		ip = args[0].Value.(*net.IPNet).IP
+	default:
+		return err("Net.family", ErrBadArgument)
+	}
+	family := int64(6)
+	if ip.To4() != nil {
+		family = 4
+	}
+	return zngnative.Value{zng.TypeInt64, family}, nil
+}
+
+func netIsMulticast(args []zngnative.Value) (zngnative.Value, error) {
+	if args[0].Type.ID() != zng.IdIP {
+		return err("Net.isMulticast", ErrBadArgument)
+	}
+	return zngnative.Value{zng.TypeBool, args[0].Value.(net.IP).IsMulticast()}, nil
+}
+
+// privateNets are the private address blocks of RFC 1918 and the unique
+// local address block of RFC 4193.
+var privateNets = []*net.IPNet{
+	mustParseCIDR("10.0.0.0/8"),
+	mustParseCIDR("172.16.0.0/12"),
+	mustParseCIDR("192.168.0.0/16"),
+	mustParseCIDR("fc00::/7"),
+}
+
+func mustParseCIDR(s string) *net.IPNet {
+	_, subnet, err := net.ParseCIDR(s)
+	if err != nil {
+		panic(err)
+	}
+	return subnet
+}
+
+func netIsPrivate(args []zngnative.Value) (zngnative.Value, error) {
+	if args[0].Type.ID() != zng.IdIP {
+		return err("Net.isPrivate", ErrBadArgument)
+	}
+	ip := args[0].Value.(net.IP)
+	for _, subnet := range privateNets {
+		if subnet.Contains(ip) {
+			return zngnative.Value{zng.TypeBool, true}, nil
+		}
+	}
+	return zngnative.Value{zng.TypeBool, false}, nil
+}
+
 func stringByteLen(args []zngnative.Value) (zngnative.Value, error) {
 	switch args[0].Type.ID() {
 	case zng.IdString, zng.IdBstring:
@@ -415,6 +552,169 @@ func stringTrim(args []zngnative.Value) (zngnative.Value, error) {
 	return zngnative.Value{zng.TypeString, s}, nil
 }
 
+func stringStartsWith(args []zngnative.Value) (zngnative.Value, error) {
+	if !isString(args[0]) || !isString(args[1]) {
+		return err("String.startsWith", ErrBadArgument)
+	}
+	b := strings.HasPrefix(args[0].Value.(string), args[1].Value.(string))
+	return zngnative.Value{zng.TypeBool, b}, nil
+}
+
+func stringEndsWith(args []zngnative.Value) (zngnative.Value, error) {
+	if !isString(args[0]) || !isString(args[1]) {
+		return err("String.endsWith", ErrBadArgument)
+	}
+	b := strings.HasSuffix(args[0].Value.(string), args[1].Value.(string))
+	return zngnative.Value{zng.TypeBool, b}, nil
+}
+
+// stringSubstr returns the runes of a string starting at the rune offset
+// given by the second argument.  The optional third argument limits the
+// number of runes returned.  Offsets and lengths past the end of the string
+// are truncated to the end of the string.
+func stringSubstr(args []zngnative.Value) (zngnative.Value, error) {
+	if !isString(args[0]) {
+		return err("String.substr", ErrBadArgument)
+	}
+	start, ok := zngnative.CoerceNativeToInt(args[1])
+	if !ok || start < 0 {
+		return err("String.substr", ErrBadArgument)
+	}
+	runes := []rune(args[0].Value.(string))
+	end := int64(len(runes))
+	if len(args) == 3 {
+		n, ok := zngnative.CoerceNativeToInt(args[2])
+		if !ok || n < 0 {
+			return err("String.substr", ErrBadArgument)
+		}
+		if start+n < end {
+			end = start + n
+		}
+	}
+	if start > end {
+		start = end
+	}
+	return zngnative.Value{zng.TypeString, string(runes[start:end])}, nil
+}
+
+func newStringSplit(zctx *resolver.Context) Function {
+	typ := zctx.LookupTypeArray(zng.TypeString)
+	return func(args []zngnative.Value) (zngnative.Value, error) {
+		if !isString(args[0]) || !isString(args[1]) {
+			return err("String.split", ErrBadArgument)
+		}
+		var b zcode.Bytes
+		for _, s := range strings.Split(args[0].Value.(string), args[1].Value.(string)) {
+			b = zcode.AppendPrimitive(b, zng.EncodeString(s))
+		}
+		if b == nil {
+			// An empty array is not unset.
+			b = zcode.Bytes{}
+		}
+		return zngnative.Value{typ, b}, nil
+	}
+}
+
+func stringJoin(args []zngnative.Value) (zngnative.Value, error) {
+	var inner zng.Type
+	switch typ := zng.AliasedType(args[0].Type).(type) {
+	case *zng.TypeArray:
+		inner = typ.Type
+	case *zng.TypeSet:
+		inner = typ.InnerType
+	default:
+		return err("String.join", ErrBadArgument)
+	}
+	switch zng.AliasedType(inner).ID() {
+	case zng.IdString, zng.IdBstring:
+	default:
+		return err("String.join", ErrBadArgument)
+	}
+	if !isString(args[1]) {
+		return err("String.join", ErrBadArgument)
+	}
+	var elems []string
+	for it := args[0].Value.(zcode.Bytes).Iter(); !it.Done(); {
+		zv, _, e := it.Next()
+		if e != nil {
+			return err("String.join", e)
+		}
+		elems = append(elems, string(zv))
+	}
+	return zngnative.Value{zng.TypeString, strings.Join(elems, args[1].Value.(string))}, nil
+}
+
+// regexpCache holds compiled regular expressions by pattern so patterns
+// aren't recompiled for every record.  It's shared by all function calls
+// and is simply cleared if it grows too large, which could happen if
+// patterns are computed from record values.
+var regexpCache = struct {
+	sync.Mutex
+	m map[string]*regexp.Regexp
+}{m: make(map[string]*regexp.Regexp)}
+
+const maxRegexpCache = 1000
+
+func compileRegexp(fn string, v zngnative.Value) (*regexp.Regexp, error) {
+	if !isString(v) {
+		return nil, fmt.Errorf("%s: %w", fn, ErrBadArgument)
+	}
+	pattern := v.Value.(string)
+	regexpCache.Lock()
+	defer regexpCache.Unlock()
+	if re, ok := regexpCache.m[pattern]; ok {
+		return re, nil
+	}
+	re, e := regexp.Compile(pattern)
+	if e != nil {
+		return nil, fmt.Errorf("%s: %w", fn, e)
+	}
+	if len(regexpCache.m) >= maxRegexpCache {
+		regexpCache.m = make(map[string]*regexp.Regexp)
+	}
+	regexpCache.m[pattern] = re
+	return re, nil
+}
+
+func stringMatch(args []zngnative.Value) (zngnative.Value, error) {
+	if !isString(args[0]) {
+		return err("String.match", ErrBadArgument)
+	}
+	re, e := compileRegexp("String.match", args[1])
+	if e != nil {
+		return zngnative.Value{}, e
+	}
+	b := re.MatchString(args[0].Value.(string))
+	return zngnative.Value{zng.TypeBool, b}, nil
+}
+
+// stringExtract returns the text matched by a capture group of a regular
+// expression, where the optional third argument is the number of the group
+// and group 0, the default, is the entire match.
+func stringExtract(args []zngnative.Value) (zngnative.Value, error) {
+	if !isString(args[0]) {
+		return err("String.extract", ErrBadArgument)
+	}
+	re, e := compileRegexp("String.extract", args[1])
+	if e != nil {
+		return zngnative.Value{}, e
+	}
+	var group int64
+	if len(args) == 3 {
+		var ok bool
+		group, ok = zngnative.CoerceNativeToInt(args[2])
+		if !ok || group < 0 || group > int64(re.NumSubexp()) {
+			return err("String.extract", ErrBadArgument)
+		}
+	}
+	match := re.FindStringSubmatchIndex(args[0].Value.(string))
+	if match == nil || match[2*group] < 0 {
+		return err("String.extract", ErrNoMatch)
+	}
+	s := args[0].Value.(string)[match[2*group]:match[2*group+1]]
+	return zngnative.Value{zng.TypeString, s}, nil
+}
+
 func timeFromISO(args []zngnative.Value) (zngnative.Value, error) {
 	if !isString(args[0]) {
 		return err("Time.fromISO", ErrBadArgument)
@@ -8,7 +8,9 @@ import (
 
 	"github.com/brimsec/zq/expr"
 	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/zcode"
 	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
 	"github.com/stretchr/testify/require"
 )
 
@@ -17,6 +19,14 @@ func zaddr(addr string) zng.Value {
 	return zng.Value{zng.TypeIP, zng.EncodeIP(parsed)}
 }
 
+func znet(s string) zng.Value {
+	_, subnet, err := net.ParseCIDR(s)
+	if err != nil {
+		panic(err)
+	}
+	return zng.Value{zng.TypeNet, zng.EncodeNet(subnet)}
+}
+
 func TestBadFunction(t *testing.T) {
 	testError(t, "notafunction()", nil, expr.ErrNoSuchFunction, "calling nonexistent function")
 }
@@ -142,6 +152,37 @@ func TestMod(t *testing.T) {
 	testError(t, "Math.mod(3.2, 2)", record, expr.ErrBadArgument, "mod() with float64 arg")
 }
 
+func TestNetFuncs(t *testing.T) {
+	testSuccessful(t, "Net.mask(10.1.2.3, 24)", nil, znet("10.1.2.0/24"))
+	testSuccessful(t, "Net.mask(10.1.2.3, 0)", nil, znet("0.0.0.0/0"))
+	testSuccessful(t, "Net.mask(fe80::1:2, 64)", nil, znet("fe80::/64"))
+	testError(t, "Net.mask(10.1.2.3, 33)", nil, expr.ErrBadArgument, "mask() with too many bits")
+	testError(t, `Net.mask("10.1.2.3", 8)`, nil, expr.ErrBadArgument, "mask() with non-ip arg")
+	testError(t, "Net.mask(10.1.2.3)", nil, expr.ErrTooFewArgs, "mask() with too few args")
+
+	testSuccessful(t, "Net.broadcast(10.1.0.0/16)", nil, zaddr("10.1.255.255"))
+	testSuccessful(t, "Net.broadcast(Net.mask(10.1.2.3, 30))", nil, zaddr("10.1.2.3"))
+	testSuccessful(t, "Net.broadcast(Net.mask(fe80::1:2, 112))", nil, zaddr("fe80::1:ffff"))
+	testError(t, "Net.broadcast(10.1.2.3)", nil, expr.ErrBadArgument, "broadcast() with non-net arg")
+
+	testSuccessful(t, "Net.family(10.1.2.3)", nil, zint64(4))
+	testSuccessful(t, "Net.family(::1)", nil, zint64(6))
+	testSuccessful(t, "Net.family(10.0.0.0/8)", nil, zint64(4))
+	testError(t, `Net.family("10.1.2.3")`, nil, expr.ErrBadArgument, "family() with string arg")
+
+	testSuccessful(t, "Net.isPrivate(10.1.2.3)", nil, zbool(true))
+	testSuccessful(t, "Net.isPrivate(172.31.0.1)", nil, zbool(true))
+	testSuccessful(t, "Net.isPrivate(172.32.0.1)", nil, zbool(false))
+	testSuccessful(t, "Net.isPrivate(192.168.1.1)", nil, zbool(true))
+	testSuccessful(t, "Net.isPrivate(fd00::1)", nil, zbool(true))
+	testSuccessful(t, "Net.isPrivate(8.8.8.8)", nil, zbool(false))
+
+	testSuccessful(t, "Net.isMulticast(224.0.0.1)", nil, zbool(true))
+	testSuccessful(t, "Net.isMulticast(ff02::1)", nil, zbool(true))
+	testSuccessful(t, "Net.isMulticast(10.1.2.3)", nil, zbool(false))
+	testError(t, "Net.isMulticast(10.0.0.0/8)", nil, expr.ErrBadArgument, "isMulticast() with net arg")
+}
+
 func TestStrFormat(t *testing.T) {
 	testSuccessful(t, "String.formatFloat(1.2)", nil, zstring("1.2"))
 	testError(t, "String.formatFloat()", nil, expr.ErrTooFewArgs, "formatFloat() with no args")
@@ -196,6 +237,67 @@ func TestOtherStrFuncs(t *testing.T) {
 	testError(t, `String.trim("  hi  ", "  there  ")`, nil, expr.ErrTooManyArgs, "trim() with too many args")
 }
 
+func TestStrSubstrings(t *testing.T) {
+	testSuccessful(t, `String.startsWith("foobar", "foo")`, nil, zbool(true))
+	testSuccessful(t, `String.startsWith("foobar", "bar")`, nil, zbool(false))
+	testError(t, `String.startsWith("foobar")`, nil, expr.ErrTooFewArgs, "startsWith() with too few args")
+	testError(t, `String.startsWith("foobar", 1)`, nil, expr.ErrBadArgument, "startsWith() with non-string arg")
+
+	testSuccessful(t, `String.endsWith("foobar", "bar")`, nil, zbool(true))
+	testSuccessful(t, `String.endsWith("foobar", "foo")`, nil, zbool(false))
+	testError(t, `String.endsWith(1, "foo")`, nil, expr.ErrBadArgument, "endsWith() with non-string arg")
+
+	testSuccessful(t, `String.substr("foobar", 3)`, nil, zstring("bar"))
+	testSuccessful(t, `String.substr("foobar", 1, 2)`, nil, zstring("oo"))
+	testSuccessful(t, `String.substr("foobar", 4, 10)`, nil, zstring("ar"))
+	testSuccessful(t, `String.substr("foobar", 10)`, nil, zstring(""))
+	testSuccessful(t, `String.substr("🍺🍕x", 1, 1)`, nil, zstring("🍕"))
+	testError(t, `String.substr("foobar", -1)`, nil, expr.ErrBadArgument, "substr() with negative start")
+	testError(t, `String.substr("foobar", 1, 2, 3)`, nil, expr.ErrTooManyArgs, "substr() with too many args")
+}
+
+func TestStrSplitJoin(t *testing.T) {
+	record, err := parseOneRecord(`
+#0:record[a:array[string],s:set[bstring],n:array[int32]]
+0:[[a;b;c;][x;y;][1;2;]]`)
+	require.NoError(t, err)
+
+	// The array type is the first type created in the fresh context
+	// in which each expression is compiled.
+	strings := resolver.NewContext().LookupTypeArray(zng.TypeString)
+	split := func(elems ...string) zng.Value {
+		b := zcode.Bytes{}
+		for _, e := range elems {
+			b = zcode.AppendPrimitive(b, zng.EncodeString(e))
+		}
+		return zng.Value{strings, b}
+	}
+	testSuccessful(t, `String.split("a,b,c", ",")`, nil, split("a", "b", "c"))
+	testSuccessful(t, `String.split("abc", ",")`, nil, split("abc"))
+	testSuccessful(t, `String.split(",a,", ",")`, nil, split("", "a", ""))
+	testError(t, `String.split("a,b,c")`, nil, expr.ErrTooFewArgs, "split() with too few args")
+	testError(t, `String.split("a,b,c", 1)`, nil, expr.ErrBadArgument, "split() with non-string arg")
+
+	testSuccessful(t, `String.join(a, "-")`, record, zstring("a-b-c"))
+	testSuccessful(t, `String.join(s, "")`, record, zstring("xy"))
+	testSuccessful(t, `String.join(String.split("a,b", ","), ";")`, nil, zstring("a;b"))
+	testError(t, `String.join(n, "-")`, record, expr.ErrBadArgument, "join() with non-string elements")
+	testError(t, `String.join("abc", "-")`, record, expr.ErrBadArgument, "join() with non-container arg")
+}
+
+func TestRegexpFuncs(t *testing.T) {
+	testSuccessful(t, `String.match("/admin/login", "^/admin/")`, nil, zbool(true))
+	testSuccessful(t, `String.match("/index.html", "^/admin/")`, nil, zbool(false))
+	testError(t, `String.match("foo", 1)`, nil, expr.ErrBadArgument, "match() with non-string pattern")
+
+	testSuccessful(t, `String.extract("GET /a.php?x=1", "[a-z]+\\.php")`, nil, zstring("a.php"))
+	testSuccessful(t, `String.extract("user=bob&id=1", "user=([a-z]+)", 1)`, nil, zstring("bob"))
+	testSuccessful(t, `String.extract("user=bob&id=1", "(user)=([a-z]+)", 2)`, nil, zstring("bob"))
+	testError(t, `String.extract("id=1", "user=([a-z]+)", 1)`, nil, expr.ErrNoMatch, "extract() with no match")
+	testError(t, `String.extract("user=bob", "user=([a-z]+)", 2)`, nil, expr.ErrBadArgument, "extract() with bad group")
+	testError(t, `String.extract("user=bob")`, nil, expr.ErrTooFewArgs, "extract() with too few args")
+}
+
 func TestLen(t *testing.T) {
 	record, err := parseOneRecord(`
 #0:record[s:set[int32],a:array[int32]]
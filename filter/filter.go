@@ -10,6 +10,7 @@ import (
 	"github.com/brimsec/zq/pkg/byteconv"
 	"github.com/brimsec/zq/zcode"
 	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
 	"github.com/brimsec/zq/zngnative"
 )
 
@@ -75,6 +76,29 @@ func CompileFieldCompare(node *ast.CompareField) (Filter, error) {
 	return combine(resolver, comparison), nil
 }
 
+// CompileExprCompare returns a filter that compares the value of an
+// expression with a literal.  Records for which the expression can't be
+// evaluated (e.g., because a field is missing) don't match.
+func CompileExprCompare(node *ast.CompareExpr) (Filter, error) {
+	comparison, err := Comparison(node.Comparator, node.Value)
+	if err != nil {
+		return nil, err
+	}
+	// The expression's value is only compared and never stored in a
+	// record, so any types it creates can live in a context of its own.
+	eval, err := expr.CompileExpr(resolver.NewContext(), node.Expr)
+	if err != nil {
+		return nil, err
+	}
+	return func(r *zng.Record) bool {
+		v, err := eval(r)
+		if err != nil {
+			return false
+		}
+		return comparison(v)
+	}, nil
+}
+
 func EvalAny(eval Predicate, recursive bool) Filter {
 	if !recursive {
 		return func(r *zng.Record) bool {
@@ -277,6 +301,9 @@ func Compile(node ast.BooleanExpr) (Filter, error) {
 
 		return CompileFieldCompare(v)
 
+	case *ast.CompareExpr:
+		return CompileExprCompare(v)
+
 	case *ast.CompareAny:
 		if v.Comparator == "in" {
 			compare, err := Comparison("=", v.Value)
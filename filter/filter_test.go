@@ -486,6 +486,23 @@ func TestFilters(t *testing.T) {
 		{"rec.sub", true},
 		{"c.s", true},
 	})
+
+	// Test function calls
+	record, err = parseOneRecord(`
+#0:record[uri:string,n:int32]
+0:[/admin/login.php?user=bob;5;]`)
+	require.NoError(t, err)
+	runCases(t, record, []testcase{
+		{`String.startsWith(uri, "/admin")`, true},
+		{`String.startsWith(uri, "/index")`, false},
+		{`not String.endsWith(uri, ".html")`, true},
+		{`String.match(uri, "login\\.php") and n=5`, true},
+		{`String.extract(uri, "user=(\\w+)", 1) = bob`, true},
+		{`String.extract(uri, "user=(\\w+)", 1) = alice`, false},
+		{`String.extract(uri, "id=(\\w+)", 1) = bob`, false},
+		{`String.runeLen(uri) > 10`, true},
+		{`String.toUpper(missing) = FOO`, false},
+	})
 }
 
 func TestBadFilter(t *testing.T) {
@@ -13,11 +13,13 @@ require (
 	github.com/google/gopacket v1.1.17
 	github.com/gorilla/mux v1.7.4
 	github.com/gosuri/uilive v0.0.4
+	github.com/influxdata/tdigest v0.0.1
 	github.com/kballard/go-shellquote v0.0.0-20180428030007-95032a82bc51
 	github.com/mccanne/charm v0.0.3-0.20191224190439-b05e1b7b1be3
 	github.com/mccanne/joe v0.0.0-20181124064909-25770742c256
 	github.com/minio/minio v0.0.0-20200506004754-8eb99d3a877f
 	github.com/peterh/liner v1.1.0
+	github.com/pierrec/lz4 v2.4.0+incompatible
 	github.com/pmezard/go-difflib v1.0.0
 	github.com/segmentio/ksuid v1.0.2
 	github.com/stretchr/testify v1.5.1
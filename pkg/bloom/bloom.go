@@ -0,0 +1,83 @@
+// Package bloom provides a Bloom filter, a compact set representation that
+// answers membership queries with false positives but no false negatives.
+package bloom
+
+import (
+	"errors"
+	"hash/fnv"
+	"math"
+)
+
+// Filter is a Bloom filter whose elements are added and tested by their
+// 64-bit hashes as computed by Hash.
+type Filter struct {
+	bits []byte
+	k    int
+}
+
+// New returns a Filter sized to hold n elements with a false positive
+// rate of about p.
+func New(n int, p float64) *Filter {
+	if n < 1 {
+		n = 1
+	}
+	m := int(math.Ceil(-float64(n) * math.Log(p) / (math.Ln2 * math.Ln2)))
+	k := int(math.Round(float64(m) / float64(n) * math.Ln2))
+	if k < 1 {
+		k = 1
+	}
+	return &Filter{
+		bits: make([]byte, (m+7)/8),
+		k:    k,
+	}
+}
+
+// FromBytes returns the Filter with k hash functions whose bits, as
+// returned by Bytes, are b.
+func FromBytes(b []byte, k int) (*Filter, error) {
+	if len(b) == 0 || k < 1 {
+		return nil, errors.New("bloom: invalid filter")
+	}
+	return &Filter{bits: b, k: k}, nil
+}
+
+// Hash returns the hash of b used to add it to and test it in a Filter.
+func Hash(b []byte) uint64 {
+	h := fnv.New64a()
+	h.Write(b)
+	return h.Sum64()
+}
+
+// Add adds the element with hash h to the filter.
+func (f *Filter) Add(h uint64) {
+	m := uint64(len(f.bits)) * 8
+	h1, h2 := h&0xffffffff, h>>32
+	for i := uint64(0); i < uint64(f.k); i++ {
+		bit := (h1 + i*h2) % m
+		f.bits[bit/8] |= 1 << (bit % 8)
+	}
+}
+
+// Test returns false if the element with hash h was never added to the
+// filter and true if it may have been.
+func (f *Filter) Test(h uint64) bool {
+	m := uint64(len(f.bits)) * 8
+	h1, h2 := h&0xffffffff, h>>32
+	for i := uint64(0); i < uint64(f.k); i++ {
+		bit := (h1 + i*h2) % m
+		if f.bits[bit/8]&(1<<(bit%8)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Bytes returns the bits of the filter.
+func (f *Filter) Bytes() []byte {
+	return f.bits
+}
+
+// K returns the number of hash functions used by the filter.
+func (f *Filter) K() int {
+	return f.k
+}
@@ -0,0 +1,31 @@
+package bloom
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilter(t *testing.T) {
+	const n = 10000
+	f := New(n, 0.01)
+	for i := 0; i < n; i++ {
+		f.Add(Hash([]byte(strconv.Itoa(i))))
+	}
+	for i := 0; i < n; i++ {
+		require.True(t, f.Test(Hash([]byte(strconv.Itoa(i)))))
+	}
+	var fp int
+	for i := n; i < 2*n; i++ {
+		if f.Test(Hash([]byte(strconv.Itoa(i)))) {
+			fp++
+		}
+	}
+	assert.Less(t, fp, n/50)
+
+	g, err := FromBytes(f.Bytes(), f.K())
+	require.NoError(t, err)
+	assert.True(t, g.Test(Hash([]byte("42"))))
+}
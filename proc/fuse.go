@@ -0,0 +1,482 @@
+package proc
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zcode"
+	"github.com/brimsec/zq/zio"
+	"github.com/brimsec/zq/zio/zngio"
+	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
+	"github.com/brimsec/zq/zngnative"
+)
+
+// FuseMemMaxBytes specifies the maximum amount of memory that each fuse proc
+// will consume before spilling its input to a temporary file.
+var FuseMemMaxBytes = 128 * 1024 * 1024
+
+// Fuse converts every record of its input to a single record type, which
+// is the union of the record types of its input.  Since the union can't be
+// known until all the input has been seen, the input is buffered, spilling
+// to a temporary file if it is too large to hold in memory.
+//
+// Fields of nested records are merged by name.  A field missing from a
+// record is unset in the output.  When a field has different numeric types,
+// the field's type in the output is the narrowest type that holds all of
+// them, e.g., int32 and uint32 become int64 and int64 and float64 become
+// float64.  Any other difference in a field's type is resolved by making the
+// field a string holding the text form of its values.
+type Fuse struct {
+	Base
+	once     sync.Once
+	resultCh chan Result
+	uberType *zng.TypeRecord
+	types    map[*zng.TypeRecord]struct{}
+	warned   map[string]struct{}
+}
+
+func CompileFuseProc(c *Context, parent Proc) (*Fuse, error) {
+	return &Fuse{
+		Base:     Base{Context: c, Parent: parent},
+		resultCh: make(chan Result),
+		types:    make(map[*zng.TypeRecord]struct{}),
+		warned:   make(map[string]struct{}),
+	}, nil
+}
+
+func (f *Fuse) Pull() (zbuf.Batch, error) {
+	f.once.Do(func() { go f.run() })
+	r := <-f.resultCh
+	return r.Batch, r.Err
+}
+
+func (f *Fuse) run() {
+	defer close(f.resultCh)
+	recs, spill, err := f.buffer()
+	if err != nil {
+		f.sendResult(nil, err)
+		return
+	}
+	if spill != nil {
+		defer spill.closeAndRemove()
+	}
+	for f.Context.Err() == nil {
+		var batch []*zng.Record
+		if spill != nil {
+			b, err := zbuf.ReadBatch(spill.zr, 100)
+			if err != nil {
+				f.sendResult(nil, err)
+				return
+			}
+			if b != nil {
+				batch = b.Records()
+			}
+		} else {
+			n := len(recs)
+			if n > 100 {
+				n = 100
+			}
+			batch, recs = recs[:n], recs[n:]
+		}
+		if len(batch) == 0 {
+			f.sendResult(nil, nil)
+			return
+		}
+		out := make([]*zng.Record, 0, len(batch))
+		for _, rec := range batch {
+			rec, err := f.fuse(rec)
+			if err != nil {
+				f.sendResult(nil, err)
+				return
+			}
+			out = append(out, rec)
+		}
+		f.sendResult(zbuf.NewArray(out), nil)
+	}
+}
+
+func (f *Fuse) sendResult(b zbuf.Batch, err error) {
+	select {
+	case f.resultCh <- Result{Batch: b, Err: err}:
+	case <-f.Context.Done():
+	}
+}
+
+// buffer reads all of the input and computes uberType.  The input is
+// returned either as a slice of records or, if larger than FuseMemMaxBytes,
+// as a temporary file.
+func (f *Fuse) buffer() ([]*zng.Record, *fuseSpill, error) {
+	var nbytes int
+	var recs []*zng.Record
+	var spill *fuseSpill
+	for {
+		batch, err := f.Get()
+		if err != nil {
+			if spill != nil {
+				spill.closeAndRemove()
+			}
+			return nil, nil, err
+		}
+		if batch == nil {
+			break
+		}
+		for k := 0; k < batch.Length(); k++ {
+			rec := batch.Index(k)
+			err := f.addType(rec.Type)
+			if err == nil {
+				if spill != nil {
+					err = spill.zw.Write(rec)
+				} else {
+					rec.CopyBody()
+					recs = append(recs, rec)
+					nbytes += len(rec.Raw)
+					if nbytes >= FuseMemMaxBytes {
+						spill, err = newFuseSpill(recs)
+						recs = nil
+					}
+				}
+			}
+			if err != nil {
+				batch.Unref()
+				if spill != nil {
+					spill.closeAndRemove()
+				}
+				return nil, nil, err
+			}
+		}
+		batch.Unref()
+	}
+	if spill != nil {
+		if err := spill.rewind(f.TypeContext); err != nil {
+			spill.closeAndRemove()
+			return nil, nil, err
+		}
+	}
+	return recs, spill, nil
+}
+
+func (f *Fuse) addType(typ *zng.TypeRecord) error {
+	if _, ok := f.types[typ]; ok {
+		return nil
+	}
+	f.types[typ] = struct{}{}
+	if f.uberType == nil {
+		f.uberType = typ
+		return nil
+	}
+	uber, err := f.unify(f.uberType, typ)
+	if err != nil {
+		return err
+	}
+	f.uberType = uber.(*zng.TypeRecord)
+	return nil
+}
+
+// unify returns a type that can hold the values of both a and b.
+func (f *Fuse) unify(a, b zng.Type) (zng.Type, error) {
+	if a == b || b == zng.TypeNull {
+		return a, nil
+	}
+	if a == zng.TypeNull {
+		return b, nil
+	}
+	realA, realB := zng.AliasedType(a), zng.AliasedType(b)
+	if realA == realB {
+		return a, nil
+	}
+	switch realA := realA.(type) {
+	case *zng.TypeRecord:
+		if realB, ok := realB.(*zng.TypeRecord); ok {
+			return f.unifyRecords(realA, realB)
+		}
+	case *zng.TypeArray:
+		if realB, ok := realB.(*zng.TypeArray); ok {
+			inner, err := f.unify(realA.Type, realB.Type)
+			if err != nil {
+				return nil, err
+			}
+			return f.TypeContext.LookupTypeArray(inner), nil
+		}
+	case *zng.TypeSet:
+		if realB, ok := realB.(*zng.TypeSet); ok {
+			inner, err := f.unify(realA.InnerType, realB.InnerType)
+			if err != nil {
+				return nil, err
+			}
+			return f.TypeContext.LookupTypeSet(inner), nil
+		}
+	default:
+		if typ := widenNumber(realA, realB); typ != nil {
+			return typ, nil
+		}
+	}
+	return zng.TypeString, nil
+}
+
+// unifyRecords returns a record type with the columns of a and any columns
+// of b not in a.  A column of b not in a is placed after the column that
+// precedes it in b, or at the end if no preceding column is in a, so columns
+// added to a Zeek log, for example, appear in the same order as in the
+// newer log.
+func (f *Fuse) unifyRecords(a, b *zng.TypeRecord) (zng.Type, error) {
+	cols := make([]zng.Column, len(a.Columns))
+	copy(cols, a.Columns)
+	pos := len(cols) - 1
+	for _, col := range b.Columns {
+		k := columnOf(cols, col.Name)
+		if k < 0 {
+			pos++
+			cols = append(cols, zng.Column{})
+			copy(cols[pos+1:], cols[pos:])
+			cols[pos] = col
+			continue
+		}
+		typ, err := f.unify(cols[k].Type, col.Type)
+		if err != nil {
+			return nil, err
+		}
+		cols[k] = zng.NewColumn(col.Name, typ)
+		pos = k
+	}
+	return f.TypeContext.LookupTypeRecord(cols)
+}
+
+func columnOf(cols []zng.Column, name string) int {
+	for k, col := range cols {
+		if col.Name == name {
+			return k
+		}
+	}
+	return -1
+}
+
+// widenNumber returns the narrowest numeric type that can hold the values of
+// numeric types a and b or nil if either is not numeric.
+func widenNumber(a, b zng.Type) zng.Type {
+	idA, idB := a.ID(), b.ID()
+	if !isNumber(idA) || !isNumber(idB) {
+		return nil
+	}
+	if idA == zng.IdFloat64 || idB == zng.IdFloat64 {
+		return zng.TypeFloat64
+	}
+	if isSigned(idA) == isSigned(idB) {
+		if idA > idB {
+			return a
+		}
+		return b
+	}
+	// Find the narrowest signed type wider than the unsigned type.
+	// Since uint64 has no such type, int64 is used and values that
+	// don't fit are unset.
+	if isSigned(idA) {
+		idA, idB = idB, idA
+	}
+	switch max(idA+1, idB) {
+	case zng.IdInt16:
+		return zng.TypeInt16
+	case zng.IdInt32:
+		return zng.TypeInt32
+	default:
+		return zng.TypeInt64
+	}
+}
+
+func isNumber(id int) bool {
+	return id >= zng.IdByte && id <= zng.IdFloat64
+}
+
+func isSigned(id int) bool {
+	switch id {
+	case zng.IdInt16, zng.IdInt32, zng.IdInt64:
+		return true
+	}
+	return false
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func (f *Fuse) fuse(rec *zng.Record) (*zng.Record, error) {
+	if rec.Type == f.uberType {
+		return rec.Keep(), nil
+	}
+	body, err := f.convertRecord(nil, f.uberType, rec.Type, rec.Raw)
+	if err != nil {
+		return nil, err
+	}
+	return zng.NewRecord(f.uberType, body)
+}
+
+// convertRecord appends to dst the body of a record of type to holding the
+// values of the record of type from with body zv.
+func (f *Fuse) convertRecord(dst zcode.Bytes, to, from *zng.TypeRecord, zv zcode.Bytes) (zcode.Bytes, error) {
+	vals := make([]zcode.Bytes, len(from.Columns))
+	if zv != nil {
+		it := zv.Iter()
+		for k := range from.Columns {
+			v, _, err := it.Next()
+			if err != nil {
+				return nil, err
+			}
+			vals[k] = v
+		}
+	}
+	for _, col := range to.Columns {
+		k, ok := from.ColumnOfField(col.Name)
+		if !ok || zv == nil {
+			dst = appendFused(dst, col.Type, nil)
+			continue
+		}
+		v, err := f.convert(col.Type, from.Columns[k].Type, vals[k])
+		if err != nil {
+			return nil, err
+		}
+		dst = appendFused(dst, col.Type, v)
+	}
+	return dst, nil
+}
+
+func appendFused(dst zcode.Bytes, typ zng.Type, zv zcode.Bytes) zcode.Bytes {
+	if zng.IsContainerType(typ) {
+		return zcode.AppendContainer(dst, zv)
+	}
+	return zcode.AppendPrimitive(dst, zv)
+}
+
+// convert returns value zv of type from as a value of type to, which was
+// computed by unify from from and some other type.
+func (f *Fuse) convert(to, from zng.Type, zv zcode.Bytes) (zcode.Bytes, error) {
+	if to == from || zv == nil {
+		return zv, nil
+	}
+	realTo, realFrom := zng.AliasedType(to), zng.AliasedType(from)
+	if realTo == realFrom {
+		return zv, nil
+	}
+	if realTo == zng.TypeString {
+		return zng.EncodeString(from.StringOf(zv, zng.OutFormatUnescaped, false)), nil
+	}
+	switch realTo := realTo.(type) {
+	case *zng.TypeRecord:
+		body, err := f.convertRecord(make(zcode.Bytes, 0), realTo, realFrom.(*zng.TypeRecord), zv)
+		if err != nil {
+			return nil, err
+		}
+		return body, nil
+	case *zng.TypeArray:
+		return f.convertElements(realTo.Type, realFrom.(*zng.TypeArray).Type, zv)
+	case *zng.TypeSet:
+		body, err := f.convertElements(realTo.InnerType, realFrom.(*zng.TypeSet).InnerType, zv)
+		if err != nil {
+			return nil, err
+		}
+		return zng.NormalizeSet(body), nil
+	}
+	return f.convertNumber(realTo, realFrom, zv)
+}
+
+func (f *Fuse) convertElements(to, from zng.Type, zv zcode.Bytes) (zcode.Bytes, error) {
+	body := make(zcode.Bytes, 0)
+	for it := zv.Iter(); !it.Done(); {
+		v, _, err := it.Next()
+		if err != nil {
+			return nil, err
+		}
+		v, err = f.convert(to, from, v)
+		if err != nil {
+			return nil, err
+		}
+		body = appendFused(body, to, v)
+	}
+	return body, nil
+}
+
+func (f *Fuse) convertNumber(to, from zng.Type, zv zcode.Bytes) (zcode.Bytes, error) {
+	native, err := zngnative.ToNativeValue(zng.Value{Type: from, Bytes: zv})
+	if err != nil {
+		return nil, err
+	}
+	var out zcode.Bytes
+	ok := true
+	switch id := to.ID(); {
+	case id == zng.IdFloat64:
+		var v float64
+		v, ok = zngnative.CoerceNativeToFloat64(native)
+		out = zng.EncodeFloat64(v)
+	case isSigned(id):
+		var v int64
+		v, ok = zngnative.CoerceNativeToInt(native)
+		out = zng.EncodeInt(v)
+	default:
+		var v uint64
+		v, ok = zngnative.CoerceNativeToUint(native)
+		out = zng.EncodeUint(v)
+	}
+	if !ok {
+		f.maybeWarn(fmt.Sprintf("fuse: value %s of type %s cannot be represented as type %s and is unset", from.StringOf(zv, zng.OutFormatUnescaped, false), from, to))
+		return nil, nil
+	}
+	return out, nil
+}
+
+func (f *Fuse) maybeWarn(s string) {
+	if _, ok := f.warned[s]; !ok {
+		f.Warnings <- s
+		f.warned[s] = struct{}{}
+	}
+}
+
+// fuseSpill is a temporary file holding the input of a Fuse.
+type fuseSpill struct {
+	file *os.File
+	bw   *bufio.Writer
+	zw   *zngio.Writer
+	zr   zbuf.Reader
+}
+
+func newFuseSpill(recs []*zng.Record) (*fuseSpill, error) {
+	file, err := ioutil.TempFile("", "zq-fuse-")
+	if err != nil {
+		return nil, err
+	}
+	bw := bufio.NewWriter(file)
+	s := &fuseSpill{
+		file: file,
+		bw:   bw,
+		zw:   zngio.NewWriter(bw, zio.WriterFlags{}),
+	}
+	for _, rec := range recs {
+		if err := s.zw.Write(rec); err != nil {
+			s.closeAndRemove()
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// rewind prepares the spill file for reading with types from zctx.
+func (s *fuseSpill) rewind(zctx *resolver.Context) error {
+	if err := s.bw.Flush(); err != nil {
+		return err
+	}
+	if _, err := s.file.Seek(0, 0); err != nil {
+		return err
+	}
+	s.zr = zngio.NewReader(bufio.NewReader(s.file), zctx)
+	return nil
+}
+
+func (s *fuseSpill) closeAndRemove() {
+	s.file.Close()
+	os.Remove(s.file.Name())
+}
@@ -0,0 +1,38 @@
+package proc_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/brimsec/zq/proc"
+	"github.com/brimsec/zq/ztest"
+)
+
+func TestFuseExternal(t *testing.T) {
+	saved := proc.FuseMemMaxBytes
+	proc.FuseMemMaxBytes = 1024
+	defer func() {
+		proc.FuseMemMaxBytes = saved
+	}()
+
+	// Create enough records to exceed proc.FuseMemMaxBytes with
+	// alternating types.
+	var in, out strings.Builder
+	in.WriteString("#0:record[a:int32]\n#1:record[b:string]\n")
+	out.WriteString("#0:record[a:int32,b:string]\n")
+	for k := 0; k < proc.FuseMemMaxBytes; k++ {
+		if k%2 == 0 {
+			in.WriteString(fmt.Sprintf("0:[%d;]\n", k))
+			out.WriteString(fmt.Sprintf("0:[%d;-;]\n", k))
+		} else {
+			in.WriteString(fmt.Sprintf("1:[s%d;]\n", k))
+			out.WriteString(fmt.Sprintf("0:[-;s%d;]\n", k))
+		}
+	}
+	(&ztest.ZTest{
+		ZQL:    "fuse",
+		Input:  []string{in.String()},
+		Output: out.String(),
+	}).Run(t, "", "", "", "")
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/brimsec/zq/ast"
 	"github.com/brimsec/zq/expr"
+	"github.com/brimsec/zq/reducer"
 	"github.com/brimsec/zq/reducer/compile"
 	"github.com/brimsec/zq/zbuf"
 	"github.com/brimsec/zq/zcode"
@@ -26,6 +27,8 @@ type GroupByParams struct {
 	keys         []GroupByKey
 	reducers     []compile.CompiledReducer
 	builder      *ColumnBuilder
+	consumePart  bool
+	emitPart     bool
 }
 
 type errTooBig int
@@ -45,9 +48,16 @@ func CompileGroupBy(node *ast.GroupByProc, zctx *resolver.Context) (*GroupByPara
 	keys := make([]GroupByKey, 0)
 	var targets []string
 	for _, astKey := range node.Keys {
-		ex, err := compileKeyExpr(astKey.Expr)
-		if err != nil {
-			return nil, fmt.Errorf("compiling groupby: %w", err)
+		var ex expr.ExpressionEvaluator
+		if node.ConsumePart {
+			// The keys of partial results are in their targets.
+			ex = compileTargetExpr(astKey.Target)
+		} else {
+			var err error
+			ex, err = compileKeyExpr(zctx, astKey.Expr)
+			if err != nil {
+				return nil, fmt.Errorf("compiling groupby: %w", err)
+			}
 		}
 		keys = append(keys, GroupByKey{
 			target: astKey.Target,
@@ -56,11 +66,16 @@ func CompileGroupBy(node *ast.GroupByProc, zctx *resolver.Context) (*GroupByPara
 		targets = append(targets, astKey.Target)
 	}
 	reducers := make([]compile.CompiledReducer, 0)
-	for _, reducer := range node.Reducers {
-		compiled, err := compile.Compile(reducer)
+	for _, red := range node.Reducers {
+		compiled, err := compile.Compile(red, zctx)
 		if err != nil {
 			return nil, err
 		}
+		if node.ConsumePart || node.EmitPart {
+			if _, ok := compiled.Instantiate().(reducer.Decomposable); !ok {
+				return nil, fmt.Errorf("compiling groupby: reducer %s is not decomposable", compiled.Target())
+			}
+		}
 		reducers = append(reducers, compiled)
 	}
 	builder, err := NewColumnBuilder(zctx, targets)
@@ -73,10 +88,12 @@ func CompileGroupBy(node *ast.GroupByProc, zctx *resolver.Context) (*GroupByPara
 		reducers:     reducers,
 		builder:      builder,
 		inputSortDir: node.InputSortDir,
+		consumePart:  node.ConsumePart,
+		emitPart:     node.EmitPart,
 	}, nil
 }
 
-func compileKeyExpr(ex ast.Expression) (expr.ExpressionEvaluator, error) {
+func compileKeyExpr(zctx *resolver.Context, ex ast.Expression) (expr.ExpressionEvaluator, error) {
 	if fe, ok := ex.(ast.FieldExpr); ok {
 		f, err := expr.CompileFieldExpr(fe)
 		if err != nil {
@@ -87,7 +104,14 @@ func compileKeyExpr(ex ast.Expression) (expr.ExpressionEvaluator, error) {
 		}
 		return ev, nil
 	}
-	return expr.CompileExpr(ex)
+	return expr.CompileExpr(zctx, ex)
+}
+
+func compileTargetExpr(target string) expr.ExpressionEvaluator {
+	f := expr.CompileFieldAccess(target)
+	return func(r *zng.Record) (zng.Value, error) {
+		return f(r), nil
+	}
 }
 
 // GroupBy computes aggregations using a GroupByAggregator.
@@ -135,6 +159,32 @@ type GroupByAggregator struct {
 	recordCompare expr.CompareFn
 	maxKey        *zng.Value
 	inputSortDir  int
+	// When the table grows past limit, the partial results of its
+	// groups are spilled to sorted runs managed by spiller and are
+	// merged back together at EOS.  Spilled records are built in
+	// spillctx and comprise the nkeycols top-level key columns followed
+	// by the partial result of each reducer.
+	spiller      *runManager
+	spillctx     *resolver.Context
+	spillCompare expr.CompareFn
+	nkeycols     int
+	// span holds the groups of spilled records with equal keys (per
+	// spillCompare) that are being merged.  Groups whose keys compare
+	// as equal but differ in type are merged separately.
+	span      map[string]*spillRow
+	spanOrder []*spillRow
+	spanFirst *zng.Record
+	// When consumePart is set, the aggregator consumes the partial
+	// results output by an aggregator with emitPart set.
+	consumePart bool
+	emitPart    bool
+}
+
+// A spillRow accumulates the spilled partial results of a single group.
+type spillRow struct {
+	keycols  []zng.Column
+	keyvals  zcode.Bytes
+	reducers compile.Row
 }
 
 type GroupByRow struct {
@@ -178,6 +228,8 @@ func NewGroupByAggregator(c *Context, params GroupByParams) *GroupByAggregator {
 		table:         make(map[string]*GroupByRow),
 		recordCompare: recordCompare,
 		valueCompare:  valueCompare,
+		consumePart:   params.consumePart,
+		emitPart:      params.emitPart,
 	}
 }
 
@@ -200,6 +252,7 @@ func (g *GroupBy) Pull() (zbuf.Batch, error) {
 
 func (g *GroupBy) run() {
 	defer close(g.resultCh)
+	defer g.agg.cleanup()
 	for {
 		batch, err := g.Get()
 		if err != nil {
@@ -207,8 +260,15 @@ func (g *GroupBy) run() {
 			return
 		}
 		if batch == nil {
-			g.sendResult(g.agg.Results(true))
-			g.sendResult(nil, nil)
+			// Results returns a nil batch once all of the groups
+			// (including any spilled to disk) have been output.
+			for g.Context.Err() == nil {
+				b, err := g.agg.Results(true)
+				g.sendResult(b, err)
+				if b == nil || err != nil {
+					return
+				}
+			}
 			return
 		}
 		for k := 0; k < batch.Length(); k++ {
@@ -344,15 +404,35 @@ func (g *GroupByAggregator) Consume(r *zng.Record) error {
 	row, ok := g.table[string(keyBytes)]
 	if !ok {
 		if len(g.table) >= g.limit {
-			return errTooBig(g.limit)
+			if err := g.spillTable(); err != nil {
+				return err
+			}
 		}
 		row = g.createGroupByRow(keyRow.columns, keyBytes[4:], prim)
 		g.table[string(keyBytes)] = row
 	}
+	if g.consumePart {
+		return g.consumePartial(row, r)
+	}
 	row.reducers.Consume(r)
 	return nil
 }
 
+// consumePartial adds the partial results in a record output by an
+// aggregator with emitPart set to row.
+func (g *GroupByAggregator) consumePartial(row *GroupByRow, r *zng.Record) error {
+	for k, red := range row.reducers.Reducers {
+		part, err := r.ValueByField(row.reducers.Defs[k].Target())
+		if err != nil {
+			return err
+		}
+		if err := red.(reducer.Decomposable).ConsumePart(part); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (g *GroupByAggregator) updateMaxKey(v zng.Value) {
 	if g.maxKey == nil {
 		g.maxKey = &v
@@ -369,9 +449,12 @@ func (g *GroupByAggregator) updateMaxKey(v zng.Value) {
 // not necessarily in their input sort order). A final call with
 // eof=true should be made to get the final keys.
 //
-// If the input is not sorted, a single call (with eof=true) should be
-// made after all records have been Consumed()'d.
+// If the input is not sorted, calls (with eof=true) should be made after
+// all records have been Consumed()'d until a nil batch is returned.
 func (g *GroupByAggregator) Results(eof bool) (zbuf.Batch, error) {
+	if eof && g.spiller != nil {
+		return g.readSpills()
+	}
 	recs, err := g.records(eof)
 	if err != nil {
 		return nil, err
@@ -391,16 +474,20 @@ func (g *GroupByAggregator) records(eof bool) ([]*zng.Record, error) {
 		if !eof && g.valueCompare(*row.groupval, *g.maxKey) >= 0 {
 			continue
 		}
+		if g.emitPart {
+			r, err := g.partialRecord(row, g.zctx)
+			if err != nil {
+				return nil, err
+			}
+			recs = append(recs, r)
+			delete(g.table, k)
+			continue
+		}
 
 		var zv zcode.Bytes
 		zv = append(zv, row.keyvals...)
 		for _, red := range row.reducers.Reducers {
-			// a reducer value is never a container
-			v := red.Result()
-			if v.IsContainer() {
-				panic("internal bug: reducer result cannot be a container!")
-			}
-			zv = v.Encode(zv)
+			zv = red.Result().Encode(zv)
 		}
 		typ, err := g.lookupRowType(row)
 		if err != nil {
@@ -436,3 +523,195 @@ func (g *GroupByAggregator) lookupRowType(row *GroupByRow) (*zng.TypeRecord, err
 	// This could be more efficient but it's only done during group-by output...
 	return g.zctx.LookupTypeRecord(cols)
 }
+
+// spillTable writes the partial results of all of the groups in the table
+// to a new run sorted by the group-by keys and then empties the table.
+// Spilling requires unsorted input and decomposable reducers; otherwise,
+// errTooBig is returned.
+func (g *GroupByAggregator) spillTable() error {
+	if g.inputSortDir != 0 || !g.decomposable() {
+		return errTooBig(g.limit)
+	}
+	if g.spiller == nil {
+		var resolvers []expr.FieldExprResolver
+		for _, key := range g.keys {
+			resolvers = append(resolvers, expr.CompileFieldAccess(key.target))
+		}
+		g.spillCompare = expr.NewCompareFn(true, resolvers...)
+		rm, err := newRunManager(g.spillCompare)
+		if err != nil {
+			return err
+		}
+		g.spiller = rm
+		g.spillctx = resolver.NewContext()
+		g.span = make(map[string]*spillRow)
+	}
+	recs := make([]*zng.Record, 0, len(g.table))
+	for _, row := range g.table {
+		rec, err := g.partialRecord(row, g.spillctx)
+		if err != nil {
+			return err
+		}
+		recs = append(recs, rec)
+	}
+	g.table = make(map[string]*GroupByRow)
+	return g.spiller.createRun(recs)
+}
+
+func (g *GroupByAggregator) decomposable() bool {
+	for _, row := range g.table {
+		for _, red := range row.reducers.Reducers {
+			if _, ok := red.(reducer.Decomposable); !ok {
+				return false
+			}
+		}
+		// All rows have the same reducers.
+		return true
+	}
+	return true
+}
+
+// partialRecord returns a record in zctx comprising the key columns of row
+// followed by the partial result of each of its reducers.
+func (g *GroupByAggregator) partialRecord(row *GroupByRow, zctx *resolver.Context) (*zng.Record, error) {
+	types := make([]zng.Type, len(row.keycols))
+	for k, col := range row.keycols {
+		types[k] = col.Type
+	}
+	cols := g.builder.TypedColumns(types)
+	g.nkeycols = len(cols)
+	zv := append(zcode.Bytes{}, row.keyvals...)
+	for k, red := range row.reducers.Reducers {
+		part, err := red.(reducer.Decomposable).ResultPart(zctx)
+		if err != nil {
+			return nil, err
+		}
+		cols = append(cols, zng.NewColumn(row.reducers.Defs[k].Target(), part.Type))
+		zv = part.Encode(zv)
+	}
+	typ, err := zctx.TranslateTypeRecord(zng.NewTypeRecord(-1, cols))
+	if err != nil {
+		return nil, err
+	}
+	return zng.NewRecord(typ, zv)
+}
+
+const spillBatchSize = 100
+
+// readSpills returns a batch of results formed by merging the partial
+// results of each group from the spilled runs or nil after all groups
+// have been returned.
+func (g *GroupByAggregator) readSpills() (zbuf.Batch, error) {
+	if len(g.table) > 0 {
+		if err := g.spillTable(); err != nil {
+			return nil, err
+		}
+	}
+	var recs []*zng.Record
+	for len(recs) < spillBatchSize {
+		rec, err := g.spiller.Read()
+		if err != nil {
+			return nil, err
+		}
+		if rec == nil || (g.spanFirst != nil && g.spillCompare(rec, g.spanFirst) != 0) {
+			out, err := g.flushSpan()
+			if err != nil {
+				return nil, err
+			}
+			recs = append(recs, out...)
+		}
+		if rec == nil {
+			break
+		}
+		if err := g.mergeSpilled(rec); err != nil {
+			return nil, err
+		}
+	}
+	if len(recs) == 0 {
+		return nil, nil
+	}
+	return zbuf.NewArray(recs), nil
+}
+
+// mergeSpilled adds the partial results in a spilled record to the span.
+func (g *GroupByAggregator) mergeSpilled(rec *zng.Record) error {
+	if g.spanFirst == nil {
+		g.spanFirst = rec
+	}
+	it := rec.Raw.Iter()
+	for k := 0; k < g.nkeycols; k++ {
+		if _, _, err := it.Next(); err != nil {
+			return err
+		}
+	}
+	keycols := rec.Type.Columns[:g.nkeycols]
+	keyvals := rec.Raw[:len(rec.Raw)-len(it)]
+	var b []byte
+	for _, col := range keycols {
+		b = zcode.AppendUvarint(b, uint64(col.Type.ID()))
+	}
+	b = append(b, keyvals...)
+	row, ok := g.span[string(b)]
+	if !ok {
+		row = &spillRow{
+			keycols:  keycols,
+			keyvals:  keyvals,
+			reducers: compile.NewRow(g.reducerDefs),
+		}
+		g.span[string(b)] = row
+		g.spanOrder = append(g.spanOrder, row)
+	}
+	for k, red := range row.reducers.Reducers {
+		zv, _, err := it.Next()
+		if err != nil {
+			return err
+		}
+		part := zng.Value{Type: rec.Type.Columns[g.nkeycols+k].Type, Bytes: zv}
+		if err := red.(reducer.Decomposable).ConsumePart(part); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// flushSpan returns the result records (or partial result records if
+// emitPart is set) for the groups in the span and resets the span.
+func (g *GroupByAggregator) flushSpan() ([]*zng.Record, error) {
+	var recs []*zng.Record
+	for _, row := range g.spanOrder {
+		cols := append([]zng.Column{}, row.keycols...)
+		zv := append(zcode.Bytes{}, row.keyvals...)
+		for k, red := range row.reducers.Reducers {
+			v := red.Result()
+			if g.emitPart {
+				var err error
+				if v, err = red.(reducer.Decomposable).ResultPart(g.zctx); err != nil {
+					return nil, err
+				}
+			}
+			cols = append(cols, zng.NewColumn(row.reducers.Defs[k].Target(), v.Type))
+			zv = v.Encode(zv)
+		}
+		typ, err := g.zctx.TranslateTypeRecord(zng.NewTypeRecord(-1, cols))
+		if err != nil {
+			return nil, err
+		}
+		rec, err := zng.NewRecord(typ, zv)
+		if err != nil {
+			return nil, err
+		}
+		recs = append(recs, rec)
+	}
+	g.span = make(map[string]*spillRow)
+	g.spanOrder = nil
+	g.spanFirst = nil
+	return recs, nil
+}
+
+// cleanup removes any runs spilled to disk.
+func (g *GroupByAggregator) cleanup() {
+	if g.spiller != nil {
+		g.spiller.cleanup()
+		g.spiller = nil
+	}
+}
@@ -77,6 +77,21 @@ const reducersOut = `
 0:[b;1;1;1;1;1;1;]
 `
 
+const spanRunsIn = `
+#0:record[k:string,n:int32]
+#1:record[k:string]
+0:[a;100;]
+0:[b;100;]
+1:[a;]
+0:[b;200;]
+`
+
+const spanRunsOut = `
+#0:record[k:string,avg:float64,last:int32]
+0:[a;100;100;]
+0:[b;150;200;]
+`
+
 const arrayKeyIn = `
 #0:record[arr:array[int32],val:int32]
 0:[-;2;]
@@ -244,6 +259,15 @@ func tests() suite {
 	s.add(New("multiple-fields-assign", in, strings.ReplaceAll(groupMultiOut, "key2", "newkey"), "count() by key1,newkey=key2 | sort key1, newkey"))
 	s.add(New("key-in-record-assign", nestedKeyIn, nestedKeyAssignedOut, "count() by newkey=rec.i | sort newkey"))
 	s.add(New("computed-key", computedKeyIn, computedKeyOut, "count() by s=String.toLower(s), ij=i+j | sort"))
+
+	// Tests that spill groups to disk when the limit is exceeded
+	s.add(New("spill-simple", in, groupSingleOut, "count() by key1 -limit 1 | sort key1"))
+	s.add(New("spill-unset-keys", in+unsetKeyIn, groupSingleOut_unsetOut, "count() by key1 -limit 1 | sort key1"))
+	s.add(New("spill-multiple-fields", in, groupMultiOut, "count() by key1,key2 -limit 1 | sort key1, key2"))
+	s.add(New("spill-different-key-types", in+differentTypeIn, differentTypeOut, "count() by key1 -limit 1 | sort key1"))
+	s.add(New("spill-reducers", in, reducersOut, "first(n), last(n), sum(n), avg(n), min(n), max(n) by key1 -limit 1 | sort key1"))
+	s.add(New("spill-key-in-record", nestedKeyIn, nestedKeyOut, "count() by rec.i -limit 1 | sort rec.i"))
+	s.add(New("spill-key-spans-runs", spanRunsIn, spanRunsOut, "avg(n), last(n) by k -limit 1 | sort k"))
 	return s
 }
 
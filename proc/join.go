@@ -0,0 +1,293 @@
+package proc
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/brimsec/zq/ast"
+	"github.com/brimsec/zq/expr"
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zcode"
+	"github.com/brimsec/zq/zng"
+)
+
+// Join is a hash join of the records from two parent procs.  The right
+// parent is read to completion into a table keyed by the join key values,
+// then each record from the left parent is looked up in that table.
+// Since both parents are typically the branches of a split, the left
+// parent is pulled (and its batches held) while the right side is
+// loading so the split can make progress.
+type Join struct {
+	Base
+	left      Proc
+	right     Proc
+	kind      string
+	leftKeys  []expr.FieldExprResolver
+	rightKeys []expr.FieldExprResolver
+	once      sync.Once
+	doneCh    chan struct{}
+	err       error
+	table     map[string][]*zng.Record
+	pending   []zbuf.Batch
+	leftEOS   bool
+	cacheKey  []byte
+	joiners   map[joinerKey]*joiner
+	types     map[*zng.TypeRecord]*zng.TypeRecord
+}
+
+type joinerKey struct {
+	left  *zng.TypeRecord
+	right *zng.TypeRecord
+}
+
+// A joiner holds the output type for a given pair of left and right
+// input types along with the columns of the right record that are
+// appended to the left record.
+type joiner struct {
+	typ     *zng.TypeRecord
+	columns []int
+}
+
+func CompileJoinProc(c *Context, left, right Proc, node *ast.JoinProc) (*Join, error) {
+	switch node.Kind {
+	case "", "inner", "left", "anti":
+	default:
+		return nil, fmt.Errorf("unknown join kind: %s", node.Kind)
+	}
+	if len(node.LeftKeys) == 0 || len(node.LeftKeys) != len(node.RightKeys) {
+		return nil, fmt.Errorf("join requires matching left and right keys")
+	}
+	kind := node.Kind
+	if kind == "" {
+		kind = "inner"
+	}
+	var leftKeys, rightKeys []expr.FieldExprResolver
+	for k := range node.LeftKeys {
+		leftKeys = append(leftKeys, expr.CompileFieldAccess(node.LeftKeys[k]))
+		rightKeys = append(rightKeys, expr.CompileFieldAccess(node.RightKeys[k]))
+	}
+	return &Join{
+		Base:      Base{Context: c},
+		left:      left,
+		right:     right,
+		kind:      kind,
+		leftKeys:  leftKeys,
+		rightKeys: rightKeys,
+		doneCh:    make(chan struct{}),
+		table:     make(map[string][]*zng.Record),
+		joiners:   make(map[joinerKey]*joiner),
+		types:     make(map[*zng.TypeRecord]*zng.TypeRecord),
+	}, nil
+}
+
+func (j *Join) Parents() []Proc {
+	return []Proc{j.left, j.right}
+}
+
+func (j *Join) Done() {
+	j.left.Done()
+	j.right.Done()
+}
+
+// loadRight reads all of the records from the right parent into the
+// join table.
+func (j *Join) loadRight() {
+	defer close(j.doneCh)
+	for {
+		batch, err := j.right.Pull()
+		if err != nil {
+			j.err = err
+			return
+		}
+		if batch == nil {
+			return
+		}
+		for k := 0; k < batch.Length(); k++ {
+			rec := batch.Index(k)
+			key, ok := j.key(rec, j.rightKeys)
+			if !ok {
+				continue
+			}
+			j.table[key] = append(j.table[key], rec.Keep())
+		}
+		batch.Unref()
+	}
+}
+
+func (j *Join) Pull() (zbuf.Batch, error) {
+	j.once.Do(func() { go j.loadRight() })
+	for {
+		if !j.leftEOS {
+			select {
+			case <-j.doneCh:
+			default:
+				// The right side is still loading, so hold onto
+				// the left batches until it's done.
+				batch, err := j.left.Pull()
+				if err != nil {
+					return nil, err
+				}
+				if batch == nil {
+					j.leftEOS = true
+				} else {
+					j.pending = append(j.pending, batch)
+				}
+				continue
+			}
+		}
+		<-j.doneCh
+		if j.err != nil {
+			return nil, j.err
+		}
+		var batch zbuf.Batch
+		if len(j.pending) > 0 {
+			batch = j.pending[0]
+			j.pending = j.pending[1:]
+		} else if !j.leftEOS {
+			var err error
+			batch, err = j.left.Pull()
+			if err != nil {
+				return nil, err
+			}
+			if batch == nil {
+				j.leftEOS = true
+			}
+		}
+		if batch == nil {
+			return nil, nil
+		}
+		out, err := j.join(batch)
+		batch.Unref()
+		if err != nil {
+			return nil, err
+		}
+		if len(out) > 0 {
+			return zbuf.NewArray(out), nil
+		}
+	}
+}
+
+func (j *Join) join(batch zbuf.Batch) ([]*zng.Record, error) {
+	var out []*zng.Record
+	for k := 0; k < batch.Length(); k++ {
+		rec := batch.Index(k)
+		var matches []*zng.Record
+		if key, ok := j.key(rec, j.leftKeys); ok {
+			matches = j.table[key]
+		}
+		switch j.kind {
+		case "anti", "left":
+			if len(matches) == 0 {
+				typ, err := j.localize(rec.Type)
+				if err != nil {
+					return nil, err
+				}
+				out = append(out, zng.NewRecordTs(typ, rec.Ts, rec.Keep().Raw))
+			}
+			if j.kind == "anti" {
+				continue
+			}
+		}
+		for _, right := range matches {
+			joined, err := j.splice(rec, right)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, joined)
+		}
+	}
+	return out, nil
+}
+
+// key computes the table key for the values of the given key fields in rec.
+// Two keys are equal if the values are of the same type and have the
+// same encoding, except that string and bstring values are compared as
+// equals.  If any of the key fields is missing or unset, key returns false.
+func (j *Join) key(rec *zng.Record, keys []expr.FieldExprResolver) (string, bool) {
+	b := j.cacheKey[:0]
+	for _, resolver := range keys {
+		v := resolver(rec)
+		if v.Type == nil || v.Bytes == nil {
+			return "", false
+		}
+		id := v.Type.ID()
+		if id == zng.IdBstring {
+			id = zng.IdString
+		}
+		if id < zng.IdTypeDef {
+			b = append(b, byte(id))
+		} else {
+			// Container type IDs aren't comparable across type
+			// contexts, so use the type's name instead.
+			b = append(b, byte(zng.IdTypeDef))
+			b = zcode.AppendPrimitive(b, []byte(v.Type.String()))
+		}
+		if v.IsContainer() {
+			b = zcode.AppendContainer(b, v.Bytes)
+		} else {
+			b = zcode.AppendPrimitive(b, v.Bytes)
+		}
+	}
+	j.cacheKey = b
+	return string(b), true
+}
+
+// splice returns a new record comprising the columns of left followed
+// by each column of right that doesn't appear in left.
+func (j *Join) splice(left, right *zng.Record) (*zng.Record, error) {
+	jk := joinerKey{left.Type, right.Type}
+	jn, ok := j.joiners[jk]
+	if !ok {
+		leftType, err := j.localize(left.Type)
+		if err != nil {
+			return nil, err
+		}
+		rightType, err := j.localize(right.Type)
+		if err != nil {
+			return nil, err
+		}
+		cols := append([]zng.Column{}, leftType.Columns...)
+		var columns []int
+		for k, col := range rightType.Columns {
+			if leftType.HasField(col.Name) {
+				continue
+			}
+			cols = append(cols, col)
+			columns = append(columns, k)
+		}
+		typ, err := j.TypeContext.LookupTypeRecord(cols)
+		if err != nil {
+			return nil, err
+		}
+		jn = &joiner{typ, columns}
+		j.joiners[jk] = jn
+	}
+	zv := make(zcode.Bytes, len(left.Raw), len(left.Raw)+len(right.Raw))
+	copy(zv, left.Raw)
+	for _, k := range jn.columns {
+		body, err := right.Slice(k)
+		if err != nil {
+			return nil, err
+		}
+		if zng.IsContainerType(right.Type.Columns[k].Type) {
+			zv = zcode.AppendContainer(zv, body)
+		} else {
+			zv = zcode.AppendPrimitive(zv, body)
+		}
+	}
+	return zng.NewRecord(jn.typ, zv)
+}
+
+// localize returns the type in the proc's type context corresponding to
+// typ, which may be from the type context of either parent.
+func (j *Join) localize(typ *zng.TypeRecord) (*zng.TypeRecord, error) {
+	if local, ok := j.types[typ]; ok {
+		return local, nil
+	}
+	local, err := j.TypeContext.TranslateTypeRecord(typ)
+	if err != nil {
+		return nil, err
+	}
+	j.types[typ] = local
+	return local, nil
+}
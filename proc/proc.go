@@ -2,6 +2,7 @@ package proc
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/brimsec/zq/ast"
@@ -94,7 +95,7 @@ func CompileProc(custom Compiler, node ast.Proc, c *Context, parent Proc) ([]Pro
 	case *ast.ReduceProc:
 		reducers := make([]compile.CompiledReducer, 0)
 		for _, reducer := range v.Reducers {
-			compiled, err := compile.Compile(reducer)
+			compiled, err := compile.Compile(reducer, c.TypeContext)
 			if err != nil {
 				return nil, err
 			}
@@ -167,19 +168,55 @@ func CompileProc(custom Compiler, node ast.Proc, c *Context, parent Proc) ([]Pro
 		}
 		return []Proc{put}, nil
 
+	case *ast.RunningProc:
+		running, err := CompileRunningProc(c, parent, v)
+		if err != nil {
+			return nil, fmt.Errorf("compiling running: %w", err)
+		}
+		return []Proc{running}, nil
+
+	case *ast.RenameProc:
+		rename, err := CompileRenameProc(c, parent, v)
+		if err != nil {
+			return nil, fmt.Errorf("compiling rename: %w", err)
+		}
+		return []Proc{rename}, nil
+
+	case *ast.FuseProc:
+		fuse, err := CompileFuseProc(c, parent)
+		if err != nil {
+			return nil, fmt.Errorf("compiling fuse: %w", err)
+		}
+		return []Proc{fuse}, nil
+
 	case *ast.SequentialProc:
 		var parents []Proc
 		var err error
 		n := len(v.Procs)
 		for k := 0; k < n; k++ {
+			if join, ok := v.Procs[k].(*ast.JoinProc); ok {
+				// A join takes its two inputs from the
+				// parallel branches that precede it.
+				if len(parents) != 2 {
+					return nil, errors.New("join requires two parallel inputs")
+				}
+				p, err := CompileJoinProc(c, parents[0], parents[1], join)
+				if err != nil {
+					return nil, fmt.Errorf("compiling join: %w", err)
+				}
+				parent = p
+				parents = []Proc{p}
+				continue
+			}
 			parents, err = CompileProc(custom, v.Procs[k], c, parent)
 			if err != nil {
 				return nil, err
 			}
 			// merge unless we're at the end of the chain,
 			// in which case the output layer will mux
-			// into channels.
-			if len(parents) > 1 && k < n-1 {
+			// into channels, or the next proc is a join,
+			// which consumes the parallel outputs itself.
+			if len(parents) > 1 && k < n-1 && !isJoin(v.Procs[k+1]) {
 				parent = NewMerge(c, parents)
 			} else {
 				parent = parents[0]
@@ -187,6 +224,9 @@ func CompileProc(custom Compiler, node ast.Proc, c *Context, parent Proc) ([]Pro
 		}
 		return parents, nil
 
+	case *ast.JoinProc:
+		return nil, errors.New("join requires two parallel inputs")
+
 	case *ast.ParallelProc:
 		splitter := NewSplit(c, parent)
 		n := len(v.Procs)
@@ -211,6 +251,11 @@ func CompileProc(custom Compiler, node ast.Proc, c *Context, parent Proc) ([]Pro
 	}
 }
 
+func isJoin(p ast.Proc) bool {
+	_, ok := p.(*ast.JoinProc)
+	return ok
+}
+
 // Compile the proc AST and return a Executor ready to go
 // "scanner" is embedded into the source node during compilation... XXX fix
 func Compile(node ast.Proc, c *Context, custom Compiler) ([]Proc, error) {
@@ -1,7 +1,9 @@
 package proc
 
 import (
+	"fmt"
 	"sort"
+	"strings"
 
 	"github.com/brimsec/zq/ast"
 	"github.com/brimsec/zq/expr"
@@ -34,30 +36,49 @@ type Put struct {
 	vals   []zng.Value
 	outmap map[int]descinfo
 	warned map[string]struct{}
+	// nested is true if any clause has a dotted target, in which case
+	// records are built by putNested and nestedmap caches their types.
+	nested    bool
+	nestedmap map[*zng.TypeRecord]nestedinfo
 }
 
 type clause struct {
 	target string
+	path   []string
 	eval   expr.ExpressionEvaluator
 }
 
+// nestedinfo holds the output type computed by putNested for an input
+// type and the value types used to compute it.
+type nestedinfo struct {
+	typ      *zng.TypeRecord
+	valTypes []zng.Type
+}
+
 func CompilePutProc(c *Context, parent Proc, node *ast.PutProc) (*Put, error) {
 	clauses := make([]clause, len(node.Clauses))
+	var nested bool
 	for k, cl := range node.Clauses {
 		var err error
 		clauses[k].target = cl.Target
-		clauses[k].eval, err = expr.CompileExpr(cl.Expr)
+		clauses[k].path = strings.Split(cl.Target, ".")
+		if len(clauses[k].path) > 1 {
+			nested = true
+		}
+		clauses[k].eval, err = expr.CompileExpr(c.TypeContext, cl.Expr)
 		if err != nil {
 			return nil, err
 		}
 	}
 
 	return &Put{
-		Base:    Base{Context: c, Parent: parent},
-		clauses: clauses,
-		vals:    make([]zng.Value, len(node.Clauses)),
-		outmap:  make(map[int]descinfo),
-		warned:  make(map[string]struct{}),
+		Base:      Base{Context: c, Parent: parent},
+		clauses:   clauses,
+		vals:      make([]zng.Value, len(node.Clauses)),
+		outmap:    make(map[int]descinfo),
+		warned:    make(map[string]struct{}),
+		nested:    nested,
+		nestedmap: make(map[*zng.TypeRecord]nestedinfo),
 	}, nil
 }
 
@@ -71,6 +92,9 @@ func (p *Put) maybeWarn(err error) {
 }
 
 func (p *Put) put(in *zng.Record) *zng.Record {
+	if p.nested {
+		return p.putNested(in)
+	}
 	// Figure out the output descriptor.  If we don't have one for
 	// this input descriptor or if any values have different types,
 	// we'll need to recompute it below.
@@ -234,3 +258,124 @@ func (p *Put) Pull() (zbuf.Batch, error) {
 	batch.Unref()
 	return zbuf.NewArray(recs), nil
 }
+
+// putNested is like put but handles dotted targets, creating any records
+// along the path of a target that don't exist.
+func (p *Put) putNested(in *zng.Record) *zng.Record {
+	for k, cl := range p.clauses {
+		var err error
+		p.vals[k], err = cl.eval(in)
+		if err != nil {
+			p.maybeWarn(err)
+			return in
+		}
+	}
+	cols := in.Type.Columns
+	body := in.Raw
+	for k, cl := range p.clauses {
+		var err error
+		cols, body, err = p.setField(cols, body, cl.path, p.vals[k])
+		if err != nil {
+			p.maybeWarn(err)
+			return in
+		}
+	}
+	info, ok := p.nestedmap[in.Type]
+	if ok {
+		for k, v := range p.vals {
+			if info.valTypes[k] != v.Type {
+				ok = false
+				break
+			}
+		}
+	}
+	if !ok {
+		typ, err := p.TypeContext.LookupTypeRecord(cols)
+		if err != nil {
+			p.maybeWarn(err)
+			return in
+		}
+		valTypes := make([]zng.Type, len(p.vals))
+		for k, v := range p.vals {
+			valTypes[k] = v.Type
+		}
+		info = nestedinfo{typ, valTypes}
+		p.nestedmap[in.Type] = info
+	}
+	out, err := zng.NewRecord(info.typ, body)
+	if err != nil {
+		p.maybeWarn(err)
+		return in
+	}
+	return out
+}
+
+// setField returns the columns and body of a record formed by setting the
+// field at path to val in the record with the given columns and body.  If the
+// field doesn't exist, it's appended to the innermost record along path that
+// does exist, along with any records needed to hold it.
+func (p *Put) setField(cols []zng.Column, body zcode.Bytes, path []string, val zng.Value) ([]zng.Column, zcode.Bytes, error) {
+	outCols := make([]zng.Column, 0, len(cols)+1)
+	var outBody zcode.Bytes
+	found := false
+	it := body.Iter()
+	for _, col := range cols {
+		zv, container, err := it.Next()
+		if err != nil {
+			return nil, nil, err
+		}
+		if col.Name != path[0] {
+			outCols = append(outCols, col)
+			outBody = appendValue(outBody, zv, container)
+			continue
+		}
+		found = true
+		if len(path) == 1 {
+			outCols = append(outCols, zng.NewColumn(col.Name, val.Type))
+			outBody = val.Encode(outBody)
+			continue
+		}
+		inner, ok := col.Type.(*zng.TypeRecord)
+		if !ok {
+			return nil, nil, fmt.Errorf("put: %s is not a record", col.Name)
+		}
+		if zv == nil {
+			return nil, nil, fmt.Errorf("put: %s is unset", col.Name)
+		}
+		innerCols, innerBody, err := p.setField(inner.Columns, zv, path[1:], val)
+		if err != nil {
+			return nil, nil, err
+		}
+		innerType, err := p.TypeContext.LookupTypeRecord(innerCols)
+		if err != nil {
+			return nil, nil, err
+		}
+		outCols = append(outCols, zng.NewColumn(col.Name, innerType))
+		outBody = zcode.AppendContainer(outBody, innerBody)
+	}
+	if !found {
+		if len(path) == 1 {
+			outCols = append(outCols, zng.NewColumn(path[0], val.Type))
+			outBody = val.Encode(outBody)
+		} else {
+			innerCols, innerBody, err := p.setField(nil, zcode.Bytes{}, path[1:], val)
+			if err != nil {
+				return nil, nil, err
+			}
+			innerType, err := p.TypeContext.LookupTypeRecord(innerCols)
+			if err != nil {
+				return nil, nil, err
+			}
+			outCols = append(outCols, zng.NewColumn(path[0], innerType))
+			outBody = zcode.AppendContainer(outBody, innerBody)
+		}
+	}
+	return outCols, outBody, nil
+}
+
+func appendValue(b zcode.Bytes, zv zcode.Bytes, container bool) zcode.Bytes {
+	if container {
+		return zcode.AppendContainer(b, zv)
+	}
+	return zcode.AppendPrimitive(b, zv)
+}
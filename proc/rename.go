@@ -0,0 +1,137 @@
+package proc
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/brimsec/zq/ast"
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zng"
+)
+
+// Rename renames fields.  Since renaming a field changes only its
+// record's type, the body of each record is passed through as is.
+type Rename struct {
+	Base
+	fields []renameField
+	types  map[*zng.TypeRecord]*zng.TypeRecord
+	warned map[string]struct{}
+}
+
+type renameField struct {
+	// path is the dotted path of the source field.
+	path []string
+	// name is the new name of the field's last element.
+	name string
+}
+
+func CompileRenameProc(c *Context, parent Proc, node *ast.RenameProc) (*Rename, error) {
+	var fields []renameField
+	for _, f := range node.Fields {
+		source := strings.Split(f.Source, ".")
+		target := strings.Split(f.Target, ".")
+		if len(source) != len(target) {
+			return nil, fmt.Errorf("cannot rename %s to %s: fields must be in the same record", f.Source, f.Target)
+		}
+		n := len(source) - 1
+		for k := 0; k < n; k++ {
+			if source[k] != target[k] {
+				return nil, fmt.Errorf("cannot rename %s to %s: fields must be in the same record", f.Source, f.Target)
+			}
+		}
+		fields = append(fields, renameField{source, target[n]})
+	}
+	return &Rename{
+		Base:   Base{Context: c, Parent: parent},
+		fields: fields,
+		types:  make(map[*zng.TypeRecord]*zng.TypeRecord),
+		warned: make(map[string]struct{}),
+	}, nil
+}
+
+func (r *Rename) maybeWarn(err error) {
+	s := err.Error()
+	if _, ok := r.warned[s]; !ok {
+		r.Warnings <- s
+		r.warned[s] = struct{}{}
+	}
+}
+
+func (r *Rename) Pull() (zbuf.Batch, error) {
+	batch, err := r.Get()
+	if EOS(batch, err) {
+		return nil, err
+	}
+	recs := make([]*zng.Record, 0, batch.Length())
+	for k := 0; k < batch.Length(); k++ {
+		in := batch.Index(k)
+		typ, err := r.renameType(in.Type)
+		if err != nil {
+			r.maybeWarn(err)
+			typ = in.Type
+		}
+		// Use NewRecord rather than NewRecordTs since a field
+		// may have been renamed to or from ts.
+		out, err := zng.NewRecord(typ, in.Keep().Raw)
+		if err != nil {
+			r.maybeWarn(err)
+			continue
+		}
+		recs = append(recs, out)
+	}
+	batch.Unref()
+	return zbuf.NewArray(recs), nil
+}
+
+// renameType returns the type in the proc's type context that results from
+// renaming the fields of typ.  Fields that don't appear in typ are ignored.
+func (r *Rename) renameType(typ *zng.TypeRecord) (*zng.TypeRecord, error) {
+	if out, ok := r.types[typ]; ok {
+		return out, nil
+	}
+	out := typ
+	for _, f := range r.fields {
+		var err error
+		out, err = r.renameColumn(out, f.path, f.name)
+		if err != nil {
+			return nil, err
+		}
+	}
+	r.types[typ] = out
+	return out, nil
+}
+
+// renameColumn returns a copy of typ with the column at path renamed to
+// name or typ itself if there is no such column.
+func (r *Rename) renameColumn(typ *zng.TypeRecord, path []string, name string) (*zng.TypeRecord, error) {
+	k, ok := typ.ColumnOfField(path[0])
+	if !ok {
+		return typ, nil
+	}
+	col := typ.Columns[k]
+	if len(path) == 1 {
+		if name == col.Name {
+			return typ, nil
+		}
+		if typ.HasField(name) {
+			return nil, fmt.Errorf("cannot rename %s to %s: field already exists", col.Name, name)
+		}
+		col.Name = name
+	} else {
+		inner, ok := col.Type.(*zng.TypeRecord)
+		if !ok {
+			return typ, nil
+		}
+		renamed, err := r.renameColumn(inner, path[1:], name)
+		if err != nil {
+			return nil, err
+		}
+		if renamed == inner {
+			return typ, nil
+		}
+		col.Type = renamed
+	}
+	cols := append([]zng.Column{}, typ.Columns...)
+	cols[k] = col
+	return r.TypeContext.LookupTypeRecord(cols)
+}
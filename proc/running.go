@@ -0,0 +1,203 @@
+package proc
+
+import (
+	"fmt"
+
+	"github.com/brimsec/zq/ast"
+	"github.com/brimsec/zq/expr"
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/reducer/compile"
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zcode"
+	"github.com/brimsec/zq/zng"
+)
+
+// Running appends the results of a list of reducers to each record, where
+// each reducer is applied to a window of records ending with that record.
+// Unbounded windows (those holding every record of a partition seen so far)
+// are computed incrementally, while bounded windows are computed by
+// applying fresh reducers to the records of the window, so the cost of
+// processing each record is proportional to the size of its window.
+type Running struct {
+	Base
+	reducers   []compile.CompiledReducer
+	keys       []expr.FieldExprResolver
+	rows       int
+	span       nano.Ts
+	partitions map[string]*runningWindow
+	cacheKey   []byte
+	vals       []zng.Value
+	types      map[*zng.TypeRecord]*runningType
+	warned     map[string]struct{}
+}
+
+// A runningWindow holds the state of the window for a single partition.
+type runningWindow struct {
+	// row holds the reducers for an unbounded window.
+	row compile.Row
+	// recs holds the records of a bounded window.
+	recs []*zng.Record
+}
+
+// A runningType caches the output type for an input type along with the
+// reducer result types used to compute it.
+type runningType struct {
+	typ      *zng.TypeRecord
+	valTypes []zng.Type
+}
+
+func CompileRunningProc(c *Context, parent Proc, node *ast.RunningProc) (*Running, error) {
+	var reducers []compile.CompiledReducer
+	for _, reducer := range node.Reducers {
+		compiled, err := compile.CompileRunning(reducer, c.TypeContext)
+		if err != nil {
+			return nil, err
+		}
+		reducers = append(reducers, compiled)
+	}
+	var keys []expr.FieldExprResolver
+	for _, key := range node.Keys {
+		keys = append(keys, expr.CompileFieldAccess(key))
+	}
+	return &Running{
+		Base:       Base{Context: c, Parent: parent},
+		reducers:   reducers,
+		keys:       keys,
+		rows:       node.Rows,
+		span:       nano.Ts(node.Span.Seconds) * 1000000000,
+		partitions: make(map[string]*runningWindow),
+		vals:       make([]zng.Value, len(reducers)),
+		types:      make(map[*zng.TypeRecord]*runningType),
+		warned:     make(map[string]struct{}),
+	}, nil
+}
+
+func (r *Running) Pull() (zbuf.Batch, error) {
+	batch, err := r.Get()
+	if EOS(batch, err) {
+		return nil, err
+	}
+	recs := make([]*zng.Record, 0, batch.Length())
+	for k := 0; k < batch.Length(); k++ {
+		rec, err := r.running(batch.Index(k))
+		if err != nil {
+			r.maybeWarn(err)
+			continue
+		}
+		recs = append(recs, rec)
+	}
+	batch.Unref()
+	return zbuf.NewArray(recs), nil
+}
+
+func (r *Running) maybeWarn(err error) {
+	s := err.Error()
+	if _, ok := r.warned[s]; !ok {
+		r.Warnings <- s
+		r.warned[s] = struct{}{}
+	}
+}
+
+func (r *Running) running(in *zng.Record) (*zng.Record, error) {
+	in = in.Keep()
+	w := r.window(in)
+	if r.rows == 0 && r.span == 0 {
+		w.row.Consume(in)
+		for k, red := range w.row.Reducers {
+			r.vals[k] = red.Result()
+		}
+	} else {
+		w.recs = append(w.recs, in)
+		if r.rows > 0 && len(w.recs) > r.rows {
+			w.recs = w.recs[len(w.recs)-r.rows:]
+		}
+		if r.span > 0 {
+			var n int
+			for n < len(w.recs) && w.recs[n].Ts <= in.Ts-r.span {
+				n++
+			}
+			w.recs = w.recs[n:]
+		}
+		row := compile.NewRow(r.reducers)
+		for _, rec := range w.recs {
+			row.Consume(rec)
+		}
+		for k, red := range row.Reducers {
+			r.vals[k] = red.Result()
+		}
+	}
+	typ, err := r.outputType(in)
+	if err != nil {
+		return nil, err
+	}
+	zv := make(zcode.Bytes, len(in.Raw), len(in.Raw)+16*len(r.vals))
+	copy(zv, in.Raw)
+	for _, v := range r.vals {
+		zv = v.Encode(zv)
+	}
+	return zng.NewRecord(typ, zv)
+}
+
+// window returns the window for the partition of rec.
+func (r *Running) window(rec *zng.Record) *runningWindow {
+	b := r.cacheKey[:0]
+	for _, key := range r.keys {
+		v := key(rec)
+		if v.Type == nil {
+			// Records missing a key field form their own partition.
+			b = zcode.AppendPrimitive(b, nil)
+			continue
+		}
+		// Type IDs aren't comparable across type contexts, so use
+		// the type's name.
+		b = zcode.AppendPrimitive(b, []byte(v.Type.String()))
+		b = v.Encode(b)
+	}
+	r.cacheKey = b
+	w, ok := r.partitions[string(b)]
+	if !ok {
+		w = &runningWindow{}
+		if r.rows == 0 && r.span == 0 {
+			w.row = compile.NewRow(r.reducers)
+		}
+		r.partitions[string(b)] = w
+	}
+	return w
+}
+
+// outputType returns the type of the output record for in, which comprises
+// the columns of in followed by a column for each of the reducer results
+// in r.vals.
+func (r *Running) outputType(in *zng.Record) (*zng.TypeRecord, error) {
+	if rt, ok := r.types[in.Type]; ok && sameTypes(rt.valTypes, r.vals) {
+		return rt.typ, nil
+	}
+	cols := append([]zng.Column{}, in.Type.Columns...)
+	valTypes := make([]zng.Type, len(r.vals))
+	for k, v := range r.vals {
+		cols = append(cols, zng.NewColumn(r.reducers[k].Target(), v.Type))
+		valTypes[k] = v.Type
+	}
+	names := make(map[string]struct{})
+	for _, col := range cols {
+		if _, ok := names[col.Name]; ok {
+			return nil, fmt.Errorf("running: duplicate field %s", col.Name)
+		}
+		names[col.Name] = struct{}{}
+	}
+	typ, err := r.TypeContext.LookupTypeRecord(cols)
+	if err != nil {
+		return nil, err
+	}
+	r.types[in.Type] = &runningType{typ, valTypes}
+	return typ, nil
+}
+
+func sameTypes(types []zng.Type, vals []zng.Value) bool {
+	for k, v := range vals {
+		if types[k] != v.Type {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,165 @@
+package reducer
+
+import (
+	"github.com/brimsec/zq/expr"
+	"github.com/brimsec/zq/zcode"
+	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
+)
+
+type CollectProto struct {
+	target   string
+	resolver expr.FieldExprResolver
+	zctx     *resolver.Context
+	distinct bool
+}
+
+func (cp *CollectProto) Target() string {
+	return cp.target
+}
+
+func (cp *CollectProto) Instantiate() Interface {
+	c := &Collect{
+		Resolver: cp.resolver,
+		zctx:     cp.zctx,
+	}
+	if cp.distinct {
+		c.seen = make(map[string]struct{})
+	}
+	return c
+}
+
+// NewCollectProto returns a proto for reducers that collect each value of
+// a field into an array.  Container types for the result are allocated
+// from zctx.
+func NewCollectProto(target string, field expr.FieldExprResolver, zctx *resolver.Context) *CollectProto {
+	return &CollectProto{target, field, zctx, false}
+}
+
+// NewUnionProto returns a proto for reducers that collect the distinct
+// values of a field into a set.  Container types for the result are
+// allocated from zctx.
+func NewUnionProto(target string, field expr.FieldExprResolver, zctx *resolver.Context) *CollectProto {
+	return &CollectProto{target, field, zctx, true}
+}
+
+// Collect accumulates the values of a field into an array or, when it
+// was created by a union proto, into a set of distinct values.  The element
+// type is taken from the first value consumed and any value of a different
+// type is counted as a type mismatch.  Unset values are ignored.
+type Collect struct {
+	Reducer
+	Resolver expr.FieldExprResolver
+	zctx     *resolver.Context
+	// foreign caches the type of the most recently consumed value, which
+	// may be from a different type context, so that it need not be
+	// translated into zctx for every value.
+	foreign zng.Type
+	typ     zng.Type
+	body    zcode.Bytes
+	seen    map[string]struct{}
+}
+
+func (c *Collect) Consume(r *zng.Record) {
+	v := c.Resolver(r)
+	if v.Type == nil {
+		c.FieldNotFound++
+		return
+	}
+	if v.Bytes == nil {
+		return
+	}
+	if !c.checkType(v.Type) {
+		c.TypeMismatch++
+		return
+	}
+	c.add(v.Bytes, zng.IsContainerType(v.Type))
+}
+
+// checkType returns true if values of the given type may be added to
+// the collection.
+func (c *Collect) checkType(typ zng.Type) bool {
+	if typ == c.foreign {
+		return true
+	}
+	if c.seen != nil && zng.IsContainerType(typ) {
+		// Set elements must be primitive values.
+		return false
+	}
+	local, err := c.zctx.TranslateType(typ)
+	if err != nil {
+		return false
+	}
+	if c.typ == nil {
+		c.typ = local
+	} else if c.typ != local {
+		return false
+	}
+	c.foreign = typ
+	return true
+}
+
+func (c *Collect) add(zv zcode.Bytes, container bool) {
+	if c.seen != nil {
+		if _, ok := c.seen[string(zv)]; ok {
+			return
+		}
+		c.seen[string(zv)] = struct{}{}
+	}
+	if container {
+		c.body = zcode.AppendContainer(c.body, zv)
+	} else {
+		c.body = zcode.AppendPrimitive(c.body, zv)
+	}
+}
+
+func (c *Collect) Result() zng.Value {
+	if c.typ == nil {
+		return zng.Value{Type: zng.TypeNull}
+	}
+	if c.seen != nil {
+		return zng.Value{Type: c.zctx.LookupTypeSet(c.typ), Bytes: zng.NormalizeSet(c.body)}
+	}
+	return zng.Value{Type: c.zctx.LookupTypeArray(c.typ), Bytes: c.body}
+}
+
+func (c *Collect) ConsumePart(p zng.Value) error {
+	if p.Type == zng.TypeNull {
+		return nil
+	}
+	var inner zng.Type
+	switch typ := p.Type.(type) {
+	case *zng.TypeArray:
+		if c.seen != nil {
+			return ErrBadValue
+		}
+		inner = typ.Type
+	case *zng.TypeSet:
+		if c.seen == nil {
+			return ErrBadValue
+		}
+		inner = typ.InnerType
+	default:
+		return ErrBadValue
+	}
+	if !c.checkType(inner) {
+		return ErrBadValue
+	}
+	for it := p.Bytes.Iter(); !it.Done(); {
+		zv, container, err := it.Next()
+		if err != nil {
+			return ErrBadValue
+		}
+		c.add(zv, container)
+	}
+	return nil
+}
+
+func (c *Collect) ResultPart(zctx *resolver.Context) (zng.Value, error) {
+	v := c.Result()
+	typ, err := zctx.TranslateType(v.Type)
+	if err != nil {
+		return zng.Value{}, err
+	}
+	return zng.Value{Type: typ, Bytes: v.Bytes}, nil
+}
@@ -3,16 +3,20 @@ package compile
 import (
 	"errors"
 	"fmt"
+	"strconv"
 
 	"github.com/brimsec/zq/ast"
 	"github.com/brimsec/zq/expr"
 	"github.com/brimsec/zq/reducer"
 	"github.com/brimsec/zq/reducer/field"
+	"github.com/brimsec/zq/zng/resolver"
 )
 
 var (
 	ErrUnknownField  = errors.New("unknown field")
 	ErrFieldRequired = errors.New("field parameter required")
+	ErrBadPercentile = errors.New("percentile must be a number between 0 and 100")
+	ErrRunningOnly   = errors.New("lag is only supported by the running proc")
 )
 
 type CompiledReducer interface {
@@ -20,7 +24,9 @@ type CompiledReducer interface {
 	Instantiate() reducer.Interface
 }
 
-func Compile(params ast.Reducer) (CompiledReducer, error) {
+// Compile returns the compiled reducer for params.  Any container types
+// in the reducer's results are allocated from zctx.
+func Compile(params ast.Reducer, zctx *resolver.Context) (CompiledReducer, error) {
 	name := params.Var
 	var fld expr.FieldExprResolver
 	if params.Field != nil {
@@ -52,7 +58,40 @@ func Compile(params ast.Reducer) (CompiledReducer, error) {
 		if fld == nil {
 			return nil, ErrFieldRequired
 		}
-		return reducer.NewCountDistinctProto(name, fld), nil
+		precision := reducer.DefaultCountDistinctPrecision
+		if params.Param != "" {
+			var err error
+			precision, err = strconv.Atoi(params.Param)
+			if err != nil {
+				return nil, reducer.ErrBadPrecision
+			}
+		}
+		return reducer.NewCountDistinctProto(name, fld, precision)
+	case "Lag":
+		return nil, ErrRunningOnly
+	case "Collect":
+		if fld == nil {
+			return nil, ErrFieldRequired
+		}
+		return reducer.NewCollectProto(name, fld, zctx), nil
+	case "Union":
+		if fld == nil {
+			return nil, ErrFieldRequired
+		}
+		return reducer.NewUnionProto(name, fld, zctx), nil
+	case "Percentile", "Median":
+		if fld == nil {
+			return nil, ErrFieldRequired
+		}
+		percentile := 50.
+		if params.Op == "Percentile" {
+			var err error
+			percentile, err = strconv.ParseFloat(params.Param, 64)
+			if err != nil || percentile < 0 || percentile > 100 {
+				return nil, ErrBadPercentile
+			}
+		}
+		return reducer.NewPercentileProto(name, fld, percentile), nil
 	case "Sum", "Min", "Max":
 		if fld == nil {
 			return nil, ErrFieldRequired
@@ -62,3 +101,19 @@ func Compile(params ast.Reducer) (CompiledReducer, error) {
 		return nil, fmt.Errorf("unknown reducer op: %s", params.Op)
 	}
 }
+
+// CompileRunning is like Compile but also accepts lag, whose result depends
+// on the order of the records in a running proc's window.
+func CompileRunning(params ast.Reducer, zctx *resolver.Context) (CompiledReducer, error) {
+	if params.Op != "Lag" {
+		return Compile(params, zctx)
+	}
+	if params.Field == nil {
+		return nil, ErrFieldRequired
+	}
+	fld, err := expr.CompileFieldExpr(params.Field)
+	if err != nil {
+		return nil, err
+	}
+	return reducer.NewLagProto(params.Var, fld), nil
+}
@@ -1,14 +1,18 @@
 package reducer
 
 import (
+	"errors"
+
 	"github.com/axiomhq/hyperloglog"
 	"github.com/brimsec/zq/expr"
 	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
 )
 
 type CountDistinctProto struct {
-	target   string
-	resolver expr.FieldExprResolver
+	target    string
+	resolver  expr.FieldExprResolver
+	precision int
 }
 
 func (cdp *CountDistinctProto) Target() string {
@@ -16,14 +20,32 @@ func (cdp *CountDistinctProto) Target() string {
 }
 
 func (cdp *CountDistinctProto) Instantiate() Interface {
+	sketch := hyperloglog.New14()
+	if cdp.precision == 16 {
+		sketch = hyperloglog.New16()
+	}
 	return &CountDistinct{
 		Resolver: cdp.resolver,
-		sketch:   hyperloglog.New(),
+		sketch:   sketch,
 	}
 }
 
-func NewCountDistinctProto(target string, resolver expr.FieldExprResolver) *CountDistinctProto {
-	return &CountDistinctProto{target, resolver}
+// DefaultCountDistinctPrecision is the number of bits of each hash used
+// to index the hyperloglog registers when no precision is specified.
+const DefaultCountDistinctPrecision = 14
+
+var ErrBadPrecision = errors.New("countdistinct precision must be 14 or 16")
+
+// NewCountDistinctProto returns a proto for reducers that approximate the
+// number of distinct values of a field using a hyperloglog sketch with 2^p
+// registers, where p is the given precision.  A precision of 14 gives a
+// typical error of about 0.8% in 16KB of memory per sketch, while 16 gives
+// about 0.4% in 64KB.
+func NewCountDistinctProto(target string, resolver expr.FieldExprResolver, precision int) (*CountDistinctProto, error) {
+	if precision != 14 && precision != 16 {
+		return nil, ErrBadPrecision
+	}
+	return &CountDistinctProto{target, resolver, precision}, nil
 }
 
 // CountDistinct uses hyperloglog to approximate the count of unique values for
@@ -43,6 +65,29 @@ func (c *CountDistinct) Result() zng.Value {
 	return zng.NewUint64(c.sketch.Estimate())
 }
 
+// ConsumePart merges a sketch serialized by ResultPart into this reducer's
+// sketch.  The two sketches must have the same precision.
+func (c *CountDistinct) ConsumePart(p zng.Value) error {
+	if p.Type != zng.TypeBstring || p.Bytes == nil {
+		return ErrBadValue
+	}
+	var sketch hyperloglog.Sketch
+	if err := sketch.UnmarshalBinary(p.Bytes); err != nil {
+		return ErrBadValue
+	}
+	return c.sketch.Merge(&sketch)
+}
+
+// ResultPart returns the binary encoding of the reducer's sketch as a
+// bstring.
+func (c *CountDistinct) ResultPart(*resolver.Context) (zng.Value, error) {
+	b, err := c.sketch.MarshalBinary()
+	if err != nil {
+		return zng.Value{}, err
+	}
+	return zng.Value{Type: zng.TypeBstring, Bytes: b}, nil
+}
+
 // Sketch returns the native structure used to compute the distinct count
 // approixmation. This method is exposed in case someone wants to merge the
 // results with another CountDistinct reducer.
@@ -0,0 +1,55 @@
+package reducer
+
+import (
+	"github.com/brimsec/zq/expr"
+	"github.com/brimsec/zq/zng"
+)
+
+type LagProto struct {
+	target   string
+	resolver expr.FieldExprResolver
+}
+
+func (lp *LagProto) Target() string {
+	return lp.target
+}
+
+func (lp *LagProto) Instantiate() Interface {
+	return &Lag{Resolver: lp.resolver}
+}
+
+func NewLagProto(target string, resolver expr.FieldExprResolver) *LagProto {
+	return &LagProto{target, resolver}
+}
+
+// Lag returns the value of a field in the next-to-last record consumed,
+// which, when used in a running proc, is the value in the record preceding
+// the current one.  If fewer than two records have been consumed or the
+// field is missing from the next-to-last record, the result is unset with
+// the type of the field in the last record, so the result type doesn't
+// change when a partition's first record is followed by its second.
+type Lag struct {
+	Reducer
+	Resolver expr.FieldExprResolver
+	prev     *zng.Value
+	cur      *zng.Value
+}
+
+func (l *Lag) Consume(r *zng.Record) {
+	v := l.Resolver(r)
+	l.prev = l.cur
+	l.cur = nil
+	if v.Type != nil {
+		l.cur = &v
+	}
+}
+
+func (l *Lag) Result() zng.Value {
+	if l.prev != nil {
+		return *l.prev
+	}
+	if l.cur != nil {
+		return zng.Value{Type: l.cur.Type}
+	}
+	return zng.Value{Type: zng.TypeNull}
+}
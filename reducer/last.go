@@ -38,6 +38,9 @@ func (l *Last) Consume(r *zng.Record) {
 }
 
 func (l *Last) ConsumePart(p zng.Value) error {
+	if p.Type == zng.TypeNull {
+		return nil
+	}
 	l.val = &p
 	return nil
 }
@@ -0,0 +1,147 @@
+package reducer
+
+import (
+	"github.com/brimsec/zq/expr"
+	"github.com/brimsec/zq/zcode"
+	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
+	"github.com/brimsec/zq/zngnative"
+	"github.com/influxdata/tdigest"
+)
+
+type PercentileProto struct {
+	target     string
+	resolver   expr.FieldExprResolver
+	percentile float64
+}
+
+func (pp *PercentileProto) Target() string {
+	return pp.target
+}
+
+func (pp *PercentileProto) Instantiate() Interface {
+	return &Percentile{
+		Resolver: pp.resolver,
+		quantile: pp.percentile / 100,
+		digest:   tdigest.New(),
+	}
+}
+
+// NewPercentileProto returns a proto for reducers that approximate the
+// given percentile, which must be in the range [0, 100], of the values of
+// a numeric field.
+func NewPercentileProto(target string, field expr.FieldExprResolver, percentile float64) *PercentileProto {
+	return &PercentileProto{target, field, percentile}
+}
+
+// Percentile uses a t-digest to approximate a percentile of the values of a
+// field.  Since t-digests may be merged, partial results may be combined
+// with ConsumePart.
+type Percentile struct {
+	Reducer
+	Resolver expr.FieldExprResolver
+	quantile float64
+	digest   *tdigest.TDigest
+}
+
+func (p *Percentile) Consume(r *zng.Record) {
+	v := p.Resolver(r)
+	if v.Type == nil {
+		p.FieldNotFound++
+		return
+	}
+	if v.Bytes == nil {
+		return
+	}
+	d, ok := zngnative.CoerceToFloat64(v)
+	if !ok {
+		p.TypeMismatch++
+		return
+	}
+	p.digest.Add(d, 1)
+}
+
+func (p *Percentile) Result() zng.Value {
+	if p.digest.Count() == 0 {
+		return zng.Value{Type: zng.TypeFloat64}
+	}
+	return zng.NewFloat64(p.digest.Quantile(p.quantile))
+}
+
+const (
+	meansName   = "means"
+	weightsName = "weights"
+)
+
+func (p *Percentile) ConsumePart(v zng.Value) error {
+	rType, ok := v.Type.(*zng.TypeRecord)
+	if !ok {
+		return ErrBadValue
+	}
+	rec, err := zng.NewRecord(rType, v.Bytes)
+	if err != nil {
+		return ErrBadValue
+	}
+	means, err := decodeFloat64Array(rec, meansName)
+	if err != nil {
+		return err
+	}
+	weights, err := decodeFloat64Array(rec, weightsName)
+	if err != nil {
+		return err
+	}
+	if len(means) != len(weights) {
+		return ErrBadValue
+	}
+	for k := range means {
+		p.digest.Add(means[k], weights[k])
+	}
+	return nil
+}
+
+func (p *Percentile) ResultPart(zctx *resolver.Context) (zng.Value, error) {
+	var means, weights zcode.Bytes
+	for _, c := range p.digest.Centroids() {
+		means = zcode.AppendPrimitive(means, zng.EncodeFloat64(c.Mean))
+		weights = zcode.AppendPrimitive(weights, zng.EncodeFloat64(c.Weight))
+	}
+	var zv zcode.Bytes
+	zv = zcode.AppendContainer(zv, means)
+	zv = zcode.AppendContainer(zv, weights)
+
+	arrayType := zctx.LookupTypeArray(zng.TypeFloat64)
+	cols := []zng.Column{
+		zng.NewColumn(meansName, arrayType),
+		zng.NewColumn(weightsName, arrayType),
+	}
+	typ, err := zctx.LookupTypeRecord(cols)
+	if err != nil {
+		return zng.Value{}, err
+	}
+	return zng.Value{Type: typ, Bytes: zv}, nil
+}
+
+func decodeFloat64Array(rec *zng.Record, field string) ([]float64, error) {
+	v, err := rec.ValueByField(field)
+	if err != nil {
+		return nil, ErrBadValue
+	}
+	typ, ok := v.Type.(*zng.TypeArray)
+	if !ok || typ.Type != zng.TypeFloat64 {
+		return nil, ErrBadValue
+	}
+	var vals []float64
+	it := v.Bytes.Iter()
+	for !it.Done() {
+		zv, _, err := it.Next()
+		if err != nil {
+			return nil, ErrBadValue
+		}
+		f, err := zng.DecodeFloat64(zv)
+		if err != nil {
+			return nil, ErrBadValue
+		}
+		vals = append(vals, f)
+	}
+	return vals, nil
+}
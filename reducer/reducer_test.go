@@ -48,6 +48,23 @@ func runOne(t *testing.T, zctx *resolver.Context, proto compile.CompiledReducer,
 	return red.Result()
 }
 
+type runner func(*testing.T, *resolver.Context, compile.CompiledReducer, int, []*zng.Record) zng.Value
+
+// runParts merges the partial results of recs[:i] and recs[i:].
+func runParts(t *testing.T, zctx *resolver.Context, proto compile.CompiledReducer, i int, recs []*zng.Record) zng.Value {
+	red := proto.Instantiate().(reducer.Decomposable)
+	for _, chunk := range [][]*zng.Record{recs[:i], recs[i:]} {
+		r := proto.Instantiate().(reducer.Decomposable)
+		for _, rec := range chunk {
+			r.Consume(rec)
+		}
+		part, err := r.ResultPart(zctx)
+		require.NoError(t, err)
+		require.NoError(t, red.ConsumePart(part))
+	}
+	return red.Result()
+}
+
 func TestDecomposableReducers(t *testing.T) {
 	const input = `
 #0:record[n:int32]
@@ -59,68 +76,127 @@ func TestDecomposableReducers(t *testing.T) {
 	b, err := parse(resolver, input)
 	require.NoError(t, err)
 	recs := b.Records()
+	// Each reducer is checked both when consuming a partial result
+	// followed by records and when merging two partial results.
 
 	t.Run("avg", func(t *testing.T) {
 		proto := reducer.NewAvgProto("avg", expr.CompileFieldAccess("n"))
-		for i := 0; i <= len(recs); i++ {
-			res := runOne(t, resolver, proto, i, recs)
-			f, err := zng.DecodeFloat64(res.Bytes)
-			require.NoError(t, err)
-			require.Equal(t, f, 5.)
+		for _, run := range []runner{runOne, runParts} {
+			for i := 0; i <= len(recs); i++ {
+				res := run(t, resolver, proto, i, recs)
+				f, err := zng.DecodeFloat64(res.Bytes)
+				require.NoError(t, err)
+				require.Equal(t, f, 5.)
+			}
 		}
 	})
 	t.Run("count", func(t *testing.T) {
 		proto := reducer.NewCountProto("count", expr.CompileFieldAccess("n"))
-		for i := 0; i <= len(recs); i++ {
-			res := runOne(t, resolver, proto, i, recs)
-			f, err := zng.DecodeUint(res.Bytes)
-			require.NoError(t, err)
-			require.Equal(t, f, uint64(3))
+		for _, run := range []runner{runOne, runParts} {
+			for i := 0; i <= len(recs); i++ {
+				res := run(t, resolver, proto, i, recs)
+				f, err := zng.DecodeUint(res.Bytes)
+				require.NoError(t, err)
+				require.Equal(t, f, uint64(3))
+			}
 		}
 	})
 	t.Run("first", func(t *testing.T) {
 		proto := reducer.NewFirstProto("first", expr.CompileFieldAccess("n"))
-		for i := 0; i <= len(recs); i++ {
-			res := runOne(t, resolver, proto, i, recs)
-			f, err := zng.DecodeInt(res.Bytes)
-			require.NoError(t, err)
-			require.Equal(t, f, int64(0))
+		for _, run := range []runner{runOne, runParts} {
+			for i := 0; i <= len(recs); i++ {
+				res := run(t, resolver, proto, i, recs)
+				f, err := zng.DecodeInt(res.Bytes)
+				require.NoError(t, err)
+				require.Equal(t, f, int64(0))
+			}
 		}
 	})
 	t.Run("last", func(t *testing.T) {
 		proto := reducer.NewLastProto("last", expr.CompileFieldAccess("n"))
-		for i := 0; i <= len(recs); i++ {
-			res := runOne(t, resolver, proto, i, recs)
-			f, err := zng.DecodeInt(res.Bytes)
-			require.NoError(t, err)
-			require.Equal(t, f, int64(10))
+		for _, run := range []runner{runOne, runParts} {
+			for i := 0; i <= len(recs); i++ {
+				res := run(t, resolver, proto, i, recs)
+				f, err := zng.DecodeInt(res.Bytes)
+				require.NoError(t, err)
+				require.Equal(t, f, int64(10))
+			}
+		}
+	})
+	t.Run("percentile", func(t *testing.T) {
+		proto := reducer.NewPercentileProto("median", expr.CompileFieldAccess("n"), 50)
+		for _, run := range []runner{runOne, runParts} {
+			for i := 0; i <= len(recs); i++ {
+				res := run(t, resolver, proto, i, recs)
+				f, err := zng.DecodeFloat64(res.Bytes)
+				require.NoError(t, err)
+				require.Equal(t, f, 5.)
+			}
+		}
+	})
+	t.Run("collect", func(t *testing.T) {
+		proto := reducer.NewCollectProto("collect", expr.CompileFieldAccess("n"), resolver)
+		for _, run := range []runner{runOne, runParts} {
+			for i := 0; i <= len(recs); i++ {
+				res := run(t, resolver, proto, i, recs)
+				require.Equal(t, "array[int32]", res.Type.String())
+				require.Equal(t, "array[0,5,10]", res.String())
+			}
+		}
+	})
+	t.Run("union", func(t *testing.T) {
+		proto := reducer.NewUnionProto("union", expr.CompileFieldAccess("n"), resolver)
+		for _, run := range []runner{runOne, runParts} {
+			for i := 0; i <= len(recs); i++ {
+				res := run(t, resolver, proto, i, append(recs, recs...))
+				require.Equal(t, "set[int32]", res.Type.String())
+				require.Equal(t, "set[0,5,10]", res.String())
+			}
+		}
+	})
+	t.Run("countdistinct", func(t *testing.T) {
+		proto, err := reducer.NewCountDistinctProto("countdistinct", expr.CompileFieldAccess("n"), 16)
+		require.NoError(t, err)
+		for _, run := range []runner{runOne, runParts} {
+			for i := 0; i <= len(recs); i++ {
+				res := run(t, resolver, proto, i, append(recs, recs...))
+				f, err := zng.DecodeUint(res.Bytes)
+				require.NoError(t, err)
+				require.Equal(t, f, uint64(3))
+			}
 		}
 	})
 	t.Run("field-min", func(t *testing.T) {
 		proto := field.NewFieldProto("min", expr.CompileFieldAccess("n"), "Min")
-		for i := 0; i <= len(recs); i++ {
-			res := runOne(t, resolver, proto, i, recs)
-			f, err := zng.DecodeInt(res.Bytes)
-			require.NoError(t, err)
-			require.Equal(t, f, int64(0))
+		for _, run := range []runner{runOne, runParts} {
+			for i := 0; i <= len(recs); i++ {
+				res := run(t, resolver, proto, i, recs)
+				f, err := zng.DecodeInt(res.Bytes)
+				require.NoError(t, err)
+				require.Equal(t, f, int64(0))
+			}
 		}
 	})
 	t.Run("field-max", func(t *testing.T) {
 		proto := field.NewFieldProto("max", expr.CompileFieldAccess("n"), "Max")
-		for i := 0; i <= len(recs); i++ {
-			res := runOne(t, resolver, proto, i, recs)
-			f, err := zng.DecodeInt(res.Bytes)
-			require.NoError(t, err)
-			require.Equal(t, f, int64(10))
+		for _, run := range []runner{runOne, runParts} {
+			for i := 0; i <= len(recs); i++ {
+				res := run(t, resolver, proto, i, recs)
+				f, err := zng.DecodeInt(res.Bytes)
+				require.NoError(t, err)
+				require.Equal(t, f, int64(10))
+			}
 		}
 	})
 	t.Run("field-sum", func(t *testing.T) {
 		proto := field.NewFieldProto("sum", expr.CompileFieldAccess("n"), "Sum")
-		for i := 0; i <= len(recs); i++ {
-			res := runOne(t, resolver, proto, i, recs)
-			f, err := zng.DecodeInt(res.Bytes)
-			require.NoError(t, err)
-			require.Equal(t, f, int64(15))
+		for _, run := range []runner{runOne, runParts} {
+			for i := 0; i <= len(recs); i++ {
+				res := run(t, resolver, proto, i, recs)
+				f, err := zng.DecodeInt(res.Bytes)
+				require.NoError(t, err)
+				require.Equal(t, f, int64(15))
+			}
 		}
 	})
 }
@@ -0,0 +1,99 @@
+package csvio_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zio/csvio"
+	"github.com/brimsec/zq/zio/tzngio"
+	"github.com/brimsec/zq/zng/resolver"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReader(t *testing.T) {
+	cases := []struct {
+		name     string
+		comma    rune
+		input    string
+		expected string
+	}{
+		{
+			name:  "infer",
+			comma: ',',
+			input: "a,b,c,d,e\n1,1.5,true,10.0.0.0/8,foo\n,,,,\n",
+			expected: `#0:record[a:int64,b:float64,c:bool,d:net,e:string]
+0:[1;1.5;T;10.0.0.0/8;foo;]
+0:[-;-;-;-;-;]
+`,
+		},
+		{
+			name:  "leading-zero",
+			comma: ',',
+			input: "a,b,c,d\n007,-01.5,0,0.5\n",
+			expected: `#0:record[a:string,b:string,c:int64,d:float64]
+0:[007;-01.5;0;0.5;]
+`,
+		},
+		{
+			name:  "tsv",
+			comma: '\t',
+			input: "a.x\ta.y\tb\n-1\t::1\tx,y\n",
+			expected: `#0:record[a:record[x:int64,y:ip],b:string]
+0:[[-1;::1;]x,y;]
+`,
+		},
+		{
+			name:  "types",
+			comma: ',',
+			input: "a,b\nuint16,array[ip]\n1,\"10.0.0.1,10.0.0.2\"\n",
+			expected: `#0:record[a:uint16,b:array[ip]]
+0:[1;[10.0.0.1;10.0.0.2;]]
+`,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			r := csvio.NewReader(strings.NewReader(c.input), resolver.NewContext(), c.comma)
+			var out bytes.Buffer
+			require.NoError(t, zbuf.Copy(zbuf.NopFlusher(tzngio.NewWriter(&out)), r))
+			require.Equal(t, c.expected, out.String())
+		})
+	}
+}
+
+func TestReaderErrors(t *testing.T) {
+	cases := []struct {
+		name  string
+		input string
+		err   string
+	}{
+		{"duplicate-field", "a,a\n1,2\n", "line 1: bad header"},
+		{"field-count", "a,b\n1,2\n3\n", "wrong number of fields"},
+		{"bad-value", "a,b\nint64,ip\n1,x\n", `line 3: field "b"`},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			r := csvio.NewReader(strings.NewReader(c.input), resolver.NewContext(), ',')
+			err := zbuf.Copy(zbuf.NopFlusher(tzngio.NewWriter(&bytes.Buffer{})), r)
+			require.Error(t, err)
+			require.Contains(t, err.Error(), c.err)
+		})
+	}
+}
+
+func TestWriter(t *testing.T) {
+	tzng := `#0:record[a:record[b:string,c:set[int64]],d:time]
+0:[["x,y";[1;2;]]1;]
+0:[-;-;]
+`
+	r := tzngio.NewReader(strings.NewReader(tzng), resolver.NewContext())
+	var out bytes.Buffer
+	require.NoError(t, zbuf.Copy(csvio.NewWriter(&out, ','), r))
+	expected := `a.b,a.c,d
+"""x,y""","1,2",1970-01-01T00:00:01Z
+,,
+`
+	require.Equal(t, expected, out.String())
+}
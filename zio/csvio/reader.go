@@ -0,0 +1,309 @@
+package csvio
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/zcode"
+	"github.com/brimsec/zq/zio/zeekio"
+	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
+)
+
+var ErrBadHeader = errors.New("bad header")
+
+// Reader reads CSV or TSV data.  The first line is a header holding the
+// field names, where names of the form "outer.inner" denote fields of
+// nested records, as written by Writer.  The header may be followed by a
+// line holding the ZNG type of each field, e.g., "time,string,ip".
+// Without a type line, the type of each value is inferred from its text:
+// integers become int64, other numbers become float64, "true" and "false"
+// become bool, IP addresses and networks become ip and net, and RFC 3339
+// timestamps become time.  Everything else is a string, including numbers
+// with leading zeros like "007".  Empty values are unset and take the type
+// of the field in the previous line so that an unset value doesn't change
+// the record type.
+type Reader struct {
+	reader *csv.Reader
+	zctx   *resolver.Context
+	line   int
+	names  []string
+	types  []zng.Type
+	// inferred holds the types inferred for the previous line.
+	inferred []zng.Type
+	next     []string
+	builder  *zcode.Builder
+	// nested maps each flat record type to its unflattened equivalent.
+	nested map[*zng.TypeRecord]*zng.TypeRecord
+}
+
+// NewReader returns a Reader for data whose fields are separated by comma,
+// which is ',' for CSV and '\t' for TSV.
+func NewReader(r io.Reader, zctx *resolver.Context, comma rune) *Reader {
+	reader := csv.NewReader(r)
+	reader.Comma = comma
+	reader.ReuseRecord = true
+	return &Reader{
+		reader:  reader,
+		zctx:    zctx,
+		builder: zcode.NewBuilder(),
+		nested:  make(map[*zng.TypeRecord]*zng.TypeRecord),
+	}
+}
+
+func (r *Reader) Read() (*zng.Record, error) {
+	if r.names == nil {
+		if err := r.readHeader(); err != nil {
+			return nil, err
+		}
+	}
+	fields := r.next
+	r.next = nil
+	if fields == nil {
+		var err error
+		fields, err = r.readLine()
+		if err != nil || fields == nil {
+			return nil, err
+		}
+	}
+	rec, err := r.parse(fields)
+	if err != nil {
+		return nil, fmt.Errorf("line %d: %w", r.line, err)
+	}
+	return rec, nil
+}
+
+func (r *Reader) readLine() ([]string, error) {
+	fields, err := r.reader.Read()
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	r.line++
+	return fields, nil
+}
+
+func (r *Reader) readHeader() error {
+	fields, err := r.readLine()
+	if err != nil {
+		return err
+	}
+	if fields == nil {
+		return ErrBadHeader
+	}
+	seen := make(map[string]bool)
+	for _, name := range fields {
+		if name == "" || seen[name] {
+			return fmt.Errorf("line %d: %w", r.line, ErrBadHeader)
+		}
+		seen[name] = true
+	}
+	r.names = append([]string(nil), fields...)
+	// Each line is checked for the same number of fields as the header.
+	r.reader.FieldsPerRecord = len(fields)
+	fields, err = r.readLine()
+	if err != nil || fields == nil {
+		return err
+	}
+	if types, ok := r.parseTypes(fields); ok {
+		r.types = types
+	} else {
+		r.next = fields
+	}
+	return nil
+}
+
+// parseTypes returns the types in fields if every field is the name of a
+// ZNG type other than a record type.
+func (r *Reader) parseTypes(fields []string) ([]zng.Type, bool) {
+	types := make([]zng.Type, len(fields))
+	for k, field := range fields {
+		typ, err := r.zctx.LookupByName(field)
+		if err != nil {
+			return nil, false
+		}
+		if _, ok := zng.AliasedType(typ).(*zng.TypeRecord); ok {
+			return nil, false
+		}
+		types[k] = typ
+	}
+	return types, true
+}
+
+func (r *Reader) parse(fields []string) (*zng.Record, error) {
+	cols := make([]zng.Column, len(fields))
+	r.builder.Reset()
+	for k, field := range fields {
+		typ, zv, err := r.parseValue(k, field)
+		if err != nil {
+			return nil, fmt.Errorf("field %q: %w", r.names[k], err)
+		}
+		cols[k] = zng.NewColumn(r.names[k], typ)
+		if zng.IsContainerType(typ) {
+			r.builder.AppendContainer(zv)
+		} else {
+			r.builder.AppendPrimitive(zv)
+		}
+	}
+	flat, err := r.zctx.LookupTypeRecord(cols)
+	if err != nil {
+		return nil, err
+	}
+	typ, err := r.unflatten(flat)
+	if err != nil {
+		return nil, err
+	}
+	// Since the fields of a nested record are adjacent in the header,
+	// the flat values are in the same order as the nested values
+	// and only the container boundaries need to be added.
+	it := r.builder.Bytes().Iter()
+	b, err := recode(nil, typ, &it)
+	if err != nil {
+		return nil, err
+	}
+	return zng.NewRecord(typ, b)
+}
+
+func (r *Reader) unflatten(flat *zng.TypeRecord) (*zng.TypeRecord, error) {
+	if typ, ok := r.nested[flat]; ok {
+		return typ, nil
+	}
+	cols, _, err := zeekio.Unflatten(r.zctx, flat.Columns, false)
+	if err != nil {
+		return nil, err
+	}
+	typ, err := r.zctx.LookupTypeRecord(cols)
+	if err != nil {
+		return nil, err
+	}
+	r.nested[flat] = typ
+	return typ, nil
+}
+
+func recode(dst zcode.Bytes, typ *zng.TypeRecord, it *zcode.Iter) (zcode.Bytes, error) {
+	for _, col := range typ.Columns {
+		if recType, ok := col.Type.(*zng.TypeRecord); ok {
+			body, err := recode(nil, recType, it)
+			if err != nil {
+				return nil, err
+			}
+			dst = zcode.AppendContainer(dst, body)
+			continue
+		}
+		zv, container, err := it.Next()
+		if err != nil {
+			return nil, err
+		}
+		if container {
+			dst = zcode.AppendContainer(dst, zv)
+		} else {
+			dst = zcode.AppendPrimitive(dst, zv)
+		}
+	}
+	return dst, nil
+}
+
+func (r *Reader) parseValue(k int, field string) (zng.Type, zcode.Bytes, error) {
+	if r.types == nil {
+		if field == "" && r.inferred != nil {
+			return r.inferred[k], nil, nil
+		}
+		typ, zv, err := infer(field)
+		if r.inferred == nil {
+			r.inferred = make([]zng.Type, len(r.names))
+		}
+		r.inferred[k] = typ
+		return typ, zv, err
+	}
+	typ := r.types[k]
+	if field == "" {
+		return typ, nil, nil
+	}
+	zv, err := parseTyped(zng.AliasedType(typ), []byte(field))
+	return typ, zv, err
+}
+
+func parseTyped(typ zng.Type, b []byte) (zcode.Bytes, error) {
+	switch typ := typ.(type) {
+	case *zng.TypeArray, *zng.TypeSet:
+		// Elements are separated by commas as in Zeek logs.
+		inner := zng.InnerType(typ)
+		body := zcode.Bytes{}
+		if string(b) == "(empty)" {
+			return body, nil
+		}
+		for _, elem := range bytes.Split(b, []byte{','}) {
+			if string(elem) == "-" {
+				body = zcode.AppendPrimitive(body, nil)
+				continue
+			}
+			zv, err := inner.Parse(elem)
+			if err != nil {
+				return nil, err
+			}
+			body = zcode.AppendPrimitive(body, zv)
+		}
+		if _, ok := typ.(*zng.TypeSet); ok {
+			body = zng.NormalizeSet(body)
+		}
+		return body, nil
+	}
+	switch typ.ID() {
+	case zng.IdString, zng.IdBstring, zng.IdBytes:
+		// The CSV encoding already takes care of any special
+		// characters so values aren't escaped.
+		return zcode.Bytes(b), nil
+	case zng.IdTime:
+		if ts, err := nano.ParseRFC3339Nano(b); err == nil {
+			return zng.EncodeTime(ts), nil
+		}
+	}
+	return typ.Parse(b)
+}
+
+func infer(field string) (zng.Type, zcode.Bytes, error) {
+	if field == "" {
+		return zng.TypeString, nil, nil
+	}
+	if c := field[0]; (c == '-' || c == '+' || c == '.' || (c >= '0' && c <= '9')) && !hasLeadingZero(field) {
+		if i, err := strconv.ParseInt(field, 10, 64); err == nil {
+			return zng.TypeInt64, zng.EncodeInt(i), nil
+		}
+		if f, err := strconv.ParseFloat(field, 64); err == nil {
+			return zng.TypeFloat64, zng.EncodeFloat64(f), nil
+		}
+	}
+	switch field {
+	case "true":
+		return zng.TypeBool, zng.EncodeBool(true), nil
+	case "false":
+		return zng.TypeBool, zng.EncodeBool(false), nil
+	}
+	if ip := net.ParseIP(field); ip != nil {
+		return zng.TypeIP, zng.EncodeIP(ip), nil
+	}
+	if ip, subnet, err := net.ParseCIDR(field); err == nil && ip.Equal(subnet.IP) {
+		return zng.TypeNet, zng.EncodeNet(subnet), nil
+	}
+	if ts, err := nano.ParseRFC3339Nano([]byte(field)); err == nil {
+		return zng.TypeTime, zng.EncodeTime(ts), nil
+	}
+	return zng.TypeString, zcode.Bytes(field), nil
+}
+
+// hasLeadingZero returns true if field looks like a number with a leading
+// zero, like "007", which is more likely an identifier than a quantity.
+func hasLeadingZero(field string) bool {
+	if field[0] == '-' || field[0] == '+' {
+		field = field[1:]
+	}
+	return len(field) > 1 && field[0] == '0' && field[1] >= '0' && field[1] <= '9'
+}
@@ -0,0 +1,112 @@
+package csvio
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/brimsec/zq/zcode"
+	"github.com/brimsec/zq/zio/zeekio"
+	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
+)
+
+var ErrFieldsChanged = errors.New("csv output requires records with the same fields (use cut to select common fields or -d to write a file for each type)")
+
+// Writer writes records as CSV or TSV with a header line of field names.
+// Nested records are flattened into fields named "outer.inner" as in Zeek
+// logs.  Since the output has a single header, every record must have the
+// same field names after flattening.  Times are written in RFC 3339 format,
+// the elements of arrays and sets are separated by commas, and unset values
+// are empty.
+type Writer struct {
+	writer    *csv.Writer
+	flattener *zeekio.Flattener
+	typ       *zng.TypeRecord
+	fields    []string
+}
+
+// NewWriter returns a Writer that separates fields with comma, which is
+// ',' for CSV and '\t' for TSV.
+func NewWriter(w io.Writer, comma rune) *Writer {
+	writer := csv.NewWriter(w)
+	writer.Comma = comma
+	return &Writer{
+		writer:    writer,
+		flattener: zeekio.NewFlattener(resolver.NewContext()),
+	}
+}
+
+func (w *Writer) Write(rec *zng.Record) error {
+	rec, err := w.flattener.Flatten(rec)
+	if err != nil {
+		return err
+	}
+	if rec.Type != w.typ {
+		if err := w.writeHeader(rec.Type); err != nil {
+			return err
+		}
+	}
+	it := rec.Raw.Iter()
+	for k, col := range rec.Type.Columns {
+		zv, _, err := it.Next()
+		if err != nil {
+			return err
+		}
+		w.fields[k] = formatValue(col.Type, zv)
+	}
+	return w.writer.Write(w.fields)
+}
+
+func (w *Writer) writeHeader(typ *zng.TypeRecord) error {
+	if w.typ != nil {
+		// A record with different types but the same field names
+		// can share the header.
+		if len(typ.Columns) != len(w.typ.Columns) {
+			return ErrFieldsChanged
+		}
+		for k, col := range typ.Columns {
+			if col.Name != w.typ.Columns[k].Name {
+				return ErrFieldsChanged
+			}
+		}
+		w.typ = typ
+		return nil
+	}
+	w.typ = typ
+	w.fields = make([]string, len(typ.Columns))
+	for k, col := range typ.Columns {
+		w.fields[k] = col.Name
+	}
+	return w.writer.Write(w.fields)
+}
+
+func (w *Writer) Flush() error {
+	w.writer.Flush()
+	return w.writer.Error()
+}
+
+func formatValue(typ zng.Type, zv zcode.Bytes) string {
+	if zv == nil {
+		return ""
+	}
+	switch zng.AliasedType(typ).(type) {
+	case *zng.TypeArray, *zng.TypeSet:
+		return typ.StringOf(zv, zng.OutFormatZeek, false)
+	}
+	switch typ.ID() {
+	case zng.IdBool:
+		if b, err := zng.DecodeBool(zv); err == nil && b {
+			return "true"
+		}
+		return "false"
+	case zng.IdTime:
+		ts, err := zng.DecodeTime(zv)
+		if err != nil {
+			return typ.StringOf(zv, zng.OutFormatUnescaped, false)
+		}
+		return ts.Time().Format(time.RFC3339Nano)
+	}
+	return typ.StringOf(zv, zng.OutFormatUnescaped, false)
+}
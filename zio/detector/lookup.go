@@ -6,7 +6,10 @@ import (
 
 	"github.com/brimsec/zq/zbuf"
 	"github.com/brimsec/zq/zio"
+	"github.com/brimsec/zq/zio/csvio"
 	"github.com/brimsec/zq/zio/ndjsonio"
+	"github.com/brimsec/zq/zio/parquetio"
+	"github.com/brimsec/zq/zio/syslogio"
 	"github.com/brimsec/zq/zio/tableio"
 	"github.com/brimsec/zq/zio/textio"
 	"github.com/brimsec/zq/zio/tzngio"
@@ -48,6 +51,12 @@ func LookupWriter(w io.WriteCloser, wflags *zio.WriterFlags) *zio.Writer {
 		f = zbuf.NopFlusher(textio.NewWriter(w, flags))
 	case "table":
 		f = tableio.NewWriter(w, flags)
+	case "parquet":
+		f = parquetio.NewWriter(w)
+	case "csv":
+		f = csvio.NewWriter(w, ',')
+	case "tsv":
+		f = csvio.NewWriter(w, '\t')
 	}
 	return &zio.Writer{
 		WriteFlusher: f,
@@ -63,10 +72,20 @@ func lookupReader(r io.Reader, zctx *resolver.Context, path string, cfg OpenConf
 		return zeekio.NewReader(r, zctx)
 	case "ndjson":
 		return ndjsonio.NewReader(r, zctx, cfg.JSONTypeConfig, cfg.JSONPathRegex, path)
+	case "eve":
+		return ndjsonio.NewEVEReader(r, zctx)
+	case "zeekjson":
+		return ndjsonio.NewZeekReader(r, zctx, cfg.JSONPathRegex, path)
 	case "zjson":
 		return zjsonio.NewReader(r, zctx), nil
 	case "zng":
 		return zngio.NewReader(r, zctx), nil
+	case "syslog":
+		return syslogio.NewReader(r, zctx), nil
+	case "csv":
+		return csvio.NewReader(r, zctx, ','), nil
+	case "tsv":
+		return csvio.NewReader(r, zctx, '\t'), nil
 	}
 	return nil, fmt.Errorf("no such reader type: \"%s\"", cfg.Format)
 }
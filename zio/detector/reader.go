@@ -6,7 +6,9 @@ import (
 	"io"
 
 	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zio/csvio"
 	"github.com/brimsec/zq/zio/ndjsonio"
+	"github.com/brimsec/zq/zio/syslogio"
 	"github.com/brimsec/zq/zio/tzngio"
 	"github.com/brimsec/zq/zio/zeekio"
 	"github.com/brimsec/zq/zio/zjsonio"
@@ -42,7 +44,18 @@ func NewReaderWithConfig(r io.Reader, zctx *resolver.Context, path string, cfg O
 	}
 	track.Reset()
 
-	// ndjson must come after zjson since zjson is a subset of ndjson
+	// eve must come before ndjson since eve is a subset of ndjson
+	er, err := ndjsonio.NewEVEReader(track, resolver.NewContext())
+	if err != nil {
+		return nil, err
+	}
+	eveErr := match(er, "eve")
+	if eveErr == nil {
+		return ndjsonio.NewEVEReader(recorder, zctx)
+	}
+	track.Reset()
+
+	// ndjson must come after zjson and eve since they are subsets of ndjson
 	nr, err := ndjsonio.NewReader(track, resolver.NewContext(), cfg.JSONTypeConfig, cfg.JSONPathRegex, path)
 	if err != nil {
 		return nil, err
@@ -57,8 +70,28 @@ func NewReaderWithConfig(r io.Reader, zctx *resolver.Context, path string, cfg O
 	if zngErr == nil {
 		return zngio.NewReader(recorder, zctx), nil
 	}
+	track.Reset()
+
+	syslogErr := match(syslogio.NewReader(track, resolver.NewContext()), "syslog")
+	if syslogErr == nil {
+		return syslogio.NewReader(recorder, zctx), nil
+	}
+	track.Reset()
+
+	// csv and tsv come last since almost any text parses as a single
+	// column of either.
+	csvErr := matchCSV(csvio.NewReader(track, resolver.NewContext(), ','), "csv")
+	if csvErr == nil {
+		return csvio.NewReader(recorder, zctx, ','), nil
+	}
+	track.Reset()
+
+	tsvErr := matchCSV(csvio.NewReader(track, resolver.NewContext(), '\t'), "tsv")
+	if tsvErr == nil {
+		return csvio.NewReader(recorder, zctx, '\t'), nil
+	}
 	parquetErr := errors.New("parquet: auto-detection not supported")
-	return nil, joinErrs([]error{tzngErr, zeekErr, ndjsonErr, zjsonErr, zngErr, parquetErr})
+	return nil, joinErrs([]error{tzngErr, zeekErr, eveErr, ndjsonErr, zjsonErr, zngErr, syslogErr, csvErr, tsvErr, parquetErr})
 }
 
 func NewReader(r io.Reader, zctx *resolver.Context) (zbuf.Reader, error) {
@@ -79,3 +112,30 @@ func match(r zbuf.Reader, name string) error {
 	}
 	return nil
 }
+
+// csvMatchLines is the number of records matchCSV reads to check that the
+// input has a consistent number of fields.
+const csvMatchLines = 10
+
+func matchCSV(r zbuf.Reader, name string) error {
+	rec, err := r.Read()
+	if err != nil {
+		return fmt.Errorf("%s: %s", name, err)
+	}
+	if rec == nil || len(rec.Type.Columns) < 2 {
+		return fmt.Errorf("%s: auto-detection requires a header and at least two fields", name)
+	}
+	// The reader fails on any line whose field count differs from the
+	// header's, so reading more lines rejects text that merely happens
+	// to contain a separator on its first two.
+	for i := 1; i < csvMatchLines; i++ {
+		rec, err := r.Read()
+		if err != nil {
+			return fmt.Errorf("%s: %s", name, err)
+		}
+		if rec == nil {
+			break
+		}
+	}
+	return nil
+}
@@ -215,3 +215,12 @@ func TestTypeConfigValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestEVETypeConfigValidate(t *testing.T) {
+	tc := EVETypeConfig()
+	require.NoError(t, tc.Validate())
+	for _, rule := range tc.Rules {
+		require.Equal(t, "event_type", rule.Name)
+		require.Equal(t, rule.Value, rule.Descriptor)
+	}
+}
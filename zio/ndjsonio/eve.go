@@ -0,0 +1,45 @@
+package ndjsonio
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/brimsec/zq/zng/resolver"
+)
+
+// eveJSONNames maps EVE fields to the columns of the eveTypes descriptors
+// that hold them.
+var eveJSONNames = map[string]string{
+	"timestamp": "ts",
+}
+
+// EVETypeConfig returns a TypeConfig for Suricata EVE JSON logs with a
+// descriptor for each common event_type.  Since EVE objects have neither
+// _path nor ts fields, it is meant for use by NewEVEReader.
+func EVETypeConfig() TypeConfig {
+	var tc TypeConfig
+	if err := json.Unmarshal([]byte(eveTypes), &tc); err != nil {
+		panic(err)
+	}
+	return tc
+}
+
+// NewEVEReader returns a Reader for Suricata EVE JSON logs.  Each object
+// is typed by its event_type using EVETypeConfig, with its event_type as
+// its _path and its timestamp as its ts.  Objects of other event types or
+// with fields not in their descriptor are parsed as if no TypeConfig had
+// been given, and objects without an event_type are an error.
+func NewEVEReader(reader io.Reader, zctx *resolver.Context) (*Reader, error) {
+	r, err := NewReader(reader, zctx, nil, "", "")
+	if err != nil {
+		return nil, err
+	}
+	if err := r.configureTypes(EVETypeConfig(), ""); err != nil {
+		return nil, err
+	}
+	r.typ.tr.pathField = "event_type"
+	r.typ.tr.jsonNames = eveJSONNames
+	r.inferUnknown = true
+	r.requirePath = true
+	return r, nil
+}
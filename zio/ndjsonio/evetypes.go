@@ -0,0 +1,1309 @@
+package ndjsonio
+
+// eveTypes is a TypeConfig for the Suricata EVE JSON event types most
+// commonly found in a default Suricata configuration, with one descriptor
+// for each event_type.  Its descriptors put the EVE "timestamp" field in
+// the ts column (see eveJSONNames).
+const eveTypes = `{
+  "descriptors": {
+    "alert": [
+      {
+        "name": "_path",
+        "type": "string"
+      },
+      {
+        "name": "ts",
+        "type": "time"
+      },
+      {
+        "name": "flow_id",
+        "type": "uint64"
+      },
+      {
+        "name": "pcap_cnt",
+        "type": "uint64"
+      },
+      {
+        "name": "event_type",
+        "type": "bstring"
+      },
+      {
+        "name": "in_iface",
+        "type": "bstring"
+      },
+      {
+        "name": "vlan",
+        "type": "array[uint16]"
+      },
+      {
+        "name": "src_ip",
+        "type": "ip"
+      },
+      {
+        "name": "src_port",
+        "type": "port"
+      },
+      {
+        "name": "dest_ip",
+        "type": "ip"
+      },
+      {
+        "name": "dest_port",
+        "type": "port"
+      },
+      {
+        "name": "proto",
+        "type": "bstring"
+      },
+      {
+        "name": "app_proto",
+        "type": "bstring"
+      },
+      {
+        "name": "community_id",
+        "type": "bstring"
+      },
+      {
+        "name": "tx_id",
+        "type": "uint64"
+      },
+      {
+        "name": "icmp_type",
+        "type": "uint64"
+      },
+      {
+        "name": "icmp_code",
+        "type": "uint64"
+      },
+      {
+        "name": "alert",
+        "type": [
+          {
+            "name": "action",
+            "type": "bstring"
+          },
+          {
+            "name": "gid",
+            "type": "uint64"
+          },
+          {
+            "name": "signature_id",
+            "type": "uint64"
+          },
+          {
+            "name": "rev",
+            "type": "uint64"
+          },
+          {
+            "name": "signature",
+            "type": "bstring"
+          },
+          {
+            "name": "category",
+            "type": "bstring"
+          },
+          {
+            "name": "severity",
+            "type": "uint64"
+          }
+        ]
+      },
+      {
+        "name": "flow",
+        "type": [
+          {
+            "name": "pkts_toserver",
+            "type": "uint64"
+          },
+          {
+            "name": "pkts_toclient",
+            "type": "uint64"
+          },
+          {
+            "name": "bytes_toserver",
+            "type": "uint64"
+          },
+          {
+            "name": "bytes_toclient",
+            "type": "uint64"
+          },
+          {
+            "name": "start",
+            "type": "time"
+          }
+        ]
+      },
+      {
+        "name": "payload",
+        "type": "bstring"
+      },
+      {
+        "name": "payload_printable",
+        "type": "bstring"
+      },
+      {
+        "name": "packet",
+        "type": "bstring"
+      },
+      {
+        "name": "stream",
+        "type": "uint64"
+      },
+      {
+        "name": "packet_info",
+        "type": [
+          {
+            "name": "linktype",
+            "type": "uint64"
+          }
+        ]
+      }
+    ],
+    "anomaly": [
+      {
+        "name": "_path",
+        "type": "string"
+      },
+      {
+        "name": "ts",
+        "type": "time"
+      },
+      {
+        "name": "flow_id",
+        "type": "uint64"
+      },
+      {
+        "name": "pcap_cnt",
+        "type": "uint64"
+      },
+      {
+        "name": "event_type",
+        "type": "bstring"
+      },
+      {
+        "name": "in_iface",
+        "type": "bstring"
+      },
+      {
+        "name": "vlan",
+        "type": "array[uint16]"
+      },
+      {
+        "name": "src_ip",
+        "type": "ip"
+      },
+      {
+        "name": "src_port",
+        "type": "port"
+      },
+      {
+        "name": "dest_ip",
+        "type": "ip"
+      },
+      {
+        "name": "dest_port",
+        "type": "port"
+      },
+      {
+        "name": "proto",
+        "type": "bstring"
+      },
+      {
+        "name": "app_proto",
+        "type": "bstring"
+      },
+      {
+        "name": "community_id",
+        "type": "bstring"
+      },
+      {
+        "name": "tx_id",
+        "type": "uint64"
+      },
+      {
+        "name": "icmp_type",
+        "type": "uint64"
+      },
+      {
+        "name": "icmp_code",
+        "type": "uint64"
+      },
+      {
+        "name": "anomaly",
+        "type": [
+          {
+            "name": "type",
+            "type": "bstring"
+          },
+          {
+            "name": "event",
+            "type": "bstring"
+          },
+          {
+            "name": "layer",
+            "type": "bstring"
+          },
+          {
+            "name": "code",
+            "type": "uint64"
+          }
+        ]
+      }
+    ],
+    "dhcp": [
+      {
+        "name": "_path",
+        "type": "string"
+      },
+      {
+        "name": "ts",
+        "type": "time"
+      },
+      {
+        "name": "flow_id",
+        "type": "uint64"
+      },
+      {
+        "name": "pcap_cnt",
+        "type": "uint64"
+      },
+      {
+        "name": "event_type",
+        "type": "bstring"
+      },
+      {
+        "name": "in_iface",
+        "type": "bstring"
+      },
+      {
+        "name": "vlan",
+        "type": "array[uint16]"
+      },
+      {
+        "name": "src_ip",
+        "type": "ip"
+      },
+      {
+        "name": "src_port",
+        "type": "port"
+      },
+      {
+        "name": "dest_ip",
+        "type": "ip"
+      },
+      {
+        "name": "dest_port",
+        "type": "port"
+      },
+      {
+        "name": "proto",
+        "type": "bstring"
+      },
+      {
+        "name": "app_proto",
+        "type": "bstring"
+      },
+      {
+        "name": "community_id",
+        "type": "bstring"
+      },
+      {
+        "name": "tx_id",
+        "type": "uint64"
+      },
+      {
+        "name": "icmp_type",
+        "type": "uint64"
+      },
+      {
+        "name": "icmp_code",
+        "type": "uint64"
+      },
+      {
+        "name": "dhcp",
+        "type": [
+          {
+            "name": "type",
+            "type": "bstring"
+          },
+          {
+            "name": "id",
+            "type": "uint64"
+          },
+          {
+            "name": "client_mac",
+            "type": "bstring"
+          },
+          {
+            "name": "assigned_ip",
+            "type": "ip"
+          },
+          {
+            "name": "client_ip",
+            "type": "ip"
+          },
+          {
+            "name": "relay_ip",
+            "type": "ip"
+          },
+          {
+            "name": "next_server_ip",
+            "type": "ip"
+          },
+          {
+            "name": "dhcp_type",
+            "type": "bstring"
+          },
+          {
+            "name": "lease_time",
+            "type": "uint64"
+          },
+          {
+            "name": "rebinding_time",
+            "type": "uint64"
+          },
+          {
+            "name": "renewal_time",
+            "type": "uint64"
+          },
+          {
+            "name": "subnet_mask",
+            "type": "ip"
+          },
+          {
+            "name": "routers",
+            "type": "array[ip]"
+          },
+          {
+            "name": "hostname",
+            "type": "bstring"
+          },
+          {
+            "name": "dns_servers",
+            "type": "array[ip]"
+          },
+          {
+            "name": "params",
+            "type": "array[bstring]"
+          }
+        ]
+      }
+    ],
+    "dns": [
+      {
+        "name": "_path",
+        "type": "string"
+      },
+      {
+        "name": "ts",
+        "type": "time"
+      },
+      {
+        "name": "flow_id",
+        "type": "uint64"
+      },
+      {
+        "name": "pcap_cnt",
+        "type": "uint64"
+      },
+      {
+        "name": "event_type",
+        "type": "bstring"
+      },
+      {
+        "name": "in_iface",
+        "type": "bstring"
+      },
+      {
+        "name": "vlan",
+        "type": "array[uint16]"
+      },
+      {
+        "name": "src_ip",
+        "type": "ip"
+      },
+      {
+        "name": "src_port",
+        "type": "port"
+      },
+      {
+        "name": "dest_ip",
+        "type": "ip"
+      },
+      {
+        "name": "dest_port",
+        "type": "port"
+      },
+      {
+        "name": "proto",
+        "type": "bstring"
+      },
+      {
+        "name": "app_proto",
+        "type": "bstring"
+      },
+      {
+        "name": "community_id",
+        "type": "bstring"
+      },
+      {
+        "name": "tx_id",
+        "type": "uint64"
+      },
+      {
+        "name": "icmp_type",
+        "type": "uint64"
+      },
+      {
+        "name": "icmp_code",
+        "type": "uint64"
+      },
+      {
+        "name": "dns",
+        "type": [
+          {
+            "name": "version",
+            "type": "uint64"
+          },
+          {
+            "name": "type",
+            "type": "bstring"
+          },
+          {
+            "name": "id",
+            "type": "uint64"
+          },
+          {
+            "name": "flags",
+            "type": "bstring"
+          },
+          {
+            "name": "qr",
+            "type": "bool"
+          },
+          {
+            "name": "rd",
+            "type": "bool"
+          },
+          {
+            "name": "ra",
+            "type": "bool"
+          },
+          {
+            "name": "aa",
+            "type": "bool"
+          },
+          {
+            "name": "tc",
+            "type": "bool"
+          },
+          {
+            "name": "rrname",
+            "type": "bstring"
+          },
+          {
+            "name": "rrtype",
+            "type": "bstring"
+          },
+          {
+            "name": "rcode",
+            "type": "bstring"
+          },
+          {
+            "name": "ttl",
+            "type": "uint64"
+          },
+          {
+            "name": "tx_id",
+            "type": "uint64"
+          },
+          {
+            "name": "opcode",
+            "type": "uint64"
+          },
+          {
+            "name": "grouped",
+            "type": [
+              {
+                "name": "A",
+                "type": "array[ip]"
+              },
+              {
+                "name": "AAAA",
+                "type": "array[ip]"
+              },
+              {
+                "name": "CNAME",
+                "type": "array[bstring]"
+              },
+              {
+                "name": "MX",
+                "type": "array[bstring]"
+              },
+              {
+                "name": "NS",
+                "type": "array[bstring]"
+              },
+              {
+                "name": "PTR",
+                "type": "array[bstring]"
+              },
+              {
+                "name": "TXT",
+                "type": "array[bstring]"
+              }
+            ]
+          }
+        ]
+      }
+    ],
+    "fileinfo": [
+      {
+        "name": "_path",
+        "type": "string"
+      },
+      {
+        "name": "ts",
+        "type": "time"
+      },
+      {
+        "name": "flow_id",
+        "type": "uint64"
+      },
+      {
+        "name": "pcap_cnt",
+        "type": "uint64"
+      },
+      {
+        "name": "event_type",
+        "type": "bstring"
+      },
+      {
+        "name": "in_iface",
+        "type": "bstring"
+      },
+      {
+        "name": "vlan",
+        "type": "array[uint16]"
+      },
+      {
+        "name": "src_ip",
+        "type": "ip"
+      },
+      {
+        "name": "src_port",
+        "type": "port"
+      },
+      {
+        "name": "dest_ip",
+        "type": "ip"
+      },
+      {
+        "name": "dest_port",
+        "type": "port"
+      },
+      {
+        "name": "proto",
+        "type": "bstring"
+      },
+      {
+        "name": "app_proto",
+        "type": "bstring"
+      },
+      {
+        "name": "community_id",
+        "type": "bstring"
+      },
+      {
+        "name": "tx_id",
+        "type": "uint64"
+      },
+      {
+        "name": "icmp_type",
+        "type": "uint64"
+      },
+      {
+        "name": "icmp_code",
+        "type": "uint64"
+      },
+      {
+        "name": "http",
+        "type": [
+          {
+            "name": "hostname",
+            "type": "bstring"
+          },
+          {
+            "name": "url",
+            "type": "bstring"
+          },
+          {
+            "name": "http_user_agent",
+            "type": "bstring"
+          },
+          {
+            "name": "http_content_type",
+            "type": "bstring"
+          },
+          {
+            "name": "http_refer",
+            "type": "bstring"
+          },
+          {
+            "name": "http_method",
+            "type": "bstring"
+          },
+          {
+            "name": "protocol",
+            "type": "bstring"
+          },
+          {
+            "name": "status",
+            "type": "uint64"
+          },
+          {
+            "name": "redirect",
+            "type": "bstring"
+          },
+          {
+            "name": "length",
+            "type": "uint64"
+          },
+          {
+            "name": "xff",
+            "type": "bstring"
+          },
+          {
+            "name": "http_port",
+            "type": "port"
+          }
+        ]
+      },
+      {
+        "name": "fileinfo",
+        "type": [
+          {
+            "name": "filename",
+            "type": "bstring"
+          },
+          {
+            "name": "magic",
+            "type": "bstring"
+          },
+          {
+            "name": "gaps",
+            "type": "bool"
+          },
+          {
+            "name": "state",
+            "type": "bstring"
+          },
+          {
+            "name": "md5",
+            "type": "bstring"
+          },
+          {
+            "name": "sha1",
+            "type": "bstring"
+          },
+          {
+            "name": "sha256",
+            "type": "bstring"
+          },
+          {
+            "name": "stored",
+            "type": "bool"
+          },
+          {
+            "name": "file_id",
+            "type": "uint64"
+          },
+          {
+            "name": "size",
+            "type": "uint64"
+          },
+          {
+            "name": "tx_id",
+            "type": "uint64"
+          }
+        ]
+      }
+    ],
+    "flow": [
+      {
+        "name": "_path",
+        "type": "string"
+      },
+      {
+        "name": "ts",
+        "type": "time"
+      },
+      {
+        "name": "flow_id",
+        "type": "uint64"
+      },
+      {
+        "name": "pcap_cnt",
+        "type": "uint64"
+      },
+      {
+        "name": "event_type",
+        "type": "bstring"
+      },
+      {
+        "name": "in_iface",
+        "type": "bstring"
+      },
+      {
+        "name": "vlan",
+        "type": "array[uint16]"
+      },
+      {
+        "name": "src_ip",
+        "type": "ip"
+      },
+      {
+        "name": "src_port",
+        "type": "port"
+      },
+      {
+        "name": "dest_ip",
+        "type": "ip"
+      },
+      {
+        "name": "dest_port",
+        "type": "port"
+      },
+      {
+        "name": "proto",
+        "type": "bstring"
+      },
+      {
+        "name": "app_proto",
+        "type": "bstring"
+      },
+      {
+        "name": "community_id",
+        "type": "bstring"
+      },
+      {
+        "name": "tx_id",
+        "type": "uint64"
+      },
+      {
+        "name": "icmp_type",
+        "type": "uint64"
+      },
+      {
+        "name": "icmp_code",
+        "type": "uint64"
+      },
+      {
+        "name": "flow",
+        "type": [
+          {
+            "name": "pkts_toserver",
+            "type": "uint64"
+          },
+          {
+            "name": "pkts_toclient",
+            "type": "uint64"
+          },
+          {
+            "name": "bytes_toserver",
+            "type": "uint64"
+          },
+          {
+            "name": "bytes_toclient",
+            "type": "uint64"
+          },
+          {
+            "name": "start",
+            "type": "time"
+          },
+          {
+            "name": "end",
+            "type": "time"
+          },
+          {
+            "name": "age",
+            "type": "uint64"
+          },
+          {
+            "name": "state",
+            "type": "bstring"
+          },
+          {
+            "name": "reason",
+            "type": "bstring"
+          },
+          {
+            "name": "alerted",
+            "type": "bool"
+          }
+        ]
+      },
+      {
+        "name": "tcp",
+        "type": [
+          {
+            "name": "tcp_flags",
+            "type": "bstring"
+          },
+          {
+            "name": "tcp_flags_ts",
+            "type": "bstring"
+          },
+          {
+            "name": "tcp_flags_tc",
+            "type": "bstring"
+          },
+          {
+            "name": "syn",
+            "type": "bool"
+          },
+          {
+            "name": "fin",
+            "type": "bool"
+          },
+          {
+            "name": "rst",
+            "type": "bool"
+          },
+          {
+            "name": "psh",
+            "type": "bool"
+          },
+          {
+            "name": "ack",
+            "type": "bool"
+          },
+          {
+            "name": "urg",
+            "type": "bool"
+          },
+          {
+            "name": "ecn",
+            "type": "bool"
+          },
+          {
+            "name": "cwr",
+            "type": "bool"
+          },
+          {
+            "name": "state",
+            "type": "bstring"
+          }
+        ]
+      }
+    ],
+    "http": [
+      {
+        "name": "_path",
+        "type": "string"
+      },
+      {
+        "name": "ts",
+        "type": "time"
+      },
+      {
+        "name": "flow_id",
+        "type": "uint64"
+      },
+      {
+        "name": "pcap_cnt",
+        "type": "uint64"
+      },
+      {
+        "name": "event_type",
+        "type": "bstring"
+      },
+      {
+        "name": "in_iface",
+        "type": "bstring"
+      },
+      {
+        "name": "vlan",
+        "type": "array[uint16]"
+      },
+      {
+        "name": "src_ip",
+        "type": "ip"
+      },
+      {
+        "name": "src_port",
+        "type": "port"
+      },
+      {
+        "name": "dest_ip",
+        "type": "ip"
+      },
+      {
+        "name": "dest_port",
+        "type": "port"
+      },
+      {
+        "name": "proto",
+        "type": "bstring"
+      },
+      {
+        "name": "app_proto",
+        "type": "bstring"
+      },
+      {
+        "name": "community_id",
+        "type": "bstring"
+      },
+      {
+        "name": "tx_id",
+        "type": "uint64"
+      },
+      {
+        "name": "icmp_type",
+        "type": "uint64"
+      },
+      {
+        "name": "icmp_code",
+        "type": "uint64"
+      },
+      {
+        "name": "http",
+        "type": [
+          {
+            "name": "hostname",
+            "type": "bstring"
+          },
+          {
+            "name": "url",
+            "type": "bstring"
+          },
+          {
+            "name": "http_user_agent",
+            "type": "bstring"
+          },
+          {
+            "name": "http_content_type",
+            "type": "bstring"
+          },
+          {
+            "name": "http_refer",
+            "type": "bstring"
+          },
+          {
+            "name": "http_method",
+            "type": "bstring"
+          },
+          {
+            "name": "protocol",
+            "type": "bstring"
+          },
+          {
+            "name": "status",
+            "type": "uint64"
+          },
+          {
+            "name": "redirect",
+            "type": "bstring"
+          },
+          {
+            "name": "length",
+            "type": "uint64"
+          },
+          {
+            "name": "xff",
+            "type": "bstring"
+          },
+          {
+            "name": "http_port",
+            "type": "port"
+          }
+        ]
+      }
+    ],
+    "ssh": [
+      {
+        "name": "_path",
+        "type": "string"
+      },
+      {
+        "name": "ts",
+        "type": "time"
+      },
+      {
+        "name": "flow_id",
+        "type": "uint64"
+      },
+      {
+        "name": "pcap_cnt",
+        "type": "uint64"
+      },
+      {
+        "name": "event_type",
+        "type": "bstring"
+      },
+      {
+        "name": "in_iface",
+        "type": "bstring"
+      },
+      {
+        "name": "vlan",
+        "type": "array[uint16]"
+      },
+      {
+        "name": "src_ip",
+        "type": "ip"
+      },
+      {
+        "name": "src_port",
+        "type": "port"
+      },
+      {
+        "name": "dest_ip",
+        "type": "ip"
+      },
+      {
+        "name": "dest_port",
+        "type": "port"
+      },
+      {
+        "name": "proto",
+        "type": "bstring"
+      },
+      {
+        "name": "app_proto",
+        "type": "bstring"
+      },
+      {
+        "name": "community_id",
+        "type": "bstring"
+      },
+      {
+        "name": "tx_id",
+        "type": "uint64"
+      },
+      {
+        "name": "icmp_type",
+        "type": "uint64"
+      },
+      {
+        "name": "icmp_code",
+        "type": "uint64"
+      },
+      {
+        "name": "ssh",
+        "type": [
+          {
+            "name": "client",
+            "type": [
+              {
+                "name": "proto_version",
+                "type": "bstring"
+              },
+              {
+                "name": "software_version",
+                "type": "bstring"
+              }
+            ]
+          },
+          {
+            "name": "server",
+            "type": [
+              {
+                "name": "proto_version",
+                "type": "bstring"
+              },
+              {
+                "name": "software_version",
+                "type": "bstring"
+              }
+            ]
+          }
+        ]
+      }
+    ],
+    "tls": [
+      {
+        "name": "_path",
+        "type": "string"
+      },
+      {
+        "name": "ts",
+        "type": "time"
+      },
+      {
+        "name": "flow_id",
+        "type": "uint64"
+      },
+      {
+        "name": "pcap_cnt",
+        "type": "uint64"
+      },
+      {
+        "name": "event_type",
+        "type": "bstring"
+      },
+      {
+        "name": "in_iface",
+        "type": "bstring"
+      },
+      {
+        "name": "vlan",
+        "type": "array[uint16]"
+      },
+      {
+        "name": "src_ip",
+        "type": "ip"
+      },
+      {
+        "name": "src_port",
+        "type": "port"
+      },
+      {
+        "name": "dest_ip",
+        "type": "ip"
+      },
+      {
+        "name": "dest_port",
+        "type": "port"
+      },
+      {
+        "name": "proto",
+        "type": "bstring"
+      },
+      {
+        "name": "app_proto",
+        "type": "bstring"
+      },
+      {
+        "name": "community_id",
+        "type": "bstring"
+      },
+      {
+        "name": "tx_id",
+        "type": "uint64"
+      },
+      {
+        "name": "icmp_type",
+        "type": "uint64"
+      },
+      {
+        "name": "icmp_code",
+        "type": "uint64"
+      },
+      {
+        "name": "tls",
+        "type": [
+          {
+            "name": "subject",
+            "type": "bstring"
+          },
+          {
+            "name": "issuerdn",
+            "type": "bstring"
+          },
+          {
+            "name": "serial",
+            "type": "bstring"
+          },
+          {
+            "name": "fingerprint",
+            "type": "bstring"
+          },
+          {
+            "name": "sni",
+            "type": "bstring"
+          },
+          {
+            "name": "version",
+            "type": "bstring"
+          },
+          {
+            "name": "notbefore",
+            "type": "time"
+          },
+          {
+            "name": "notafter",
+            "type": "time"
+          },
+          {
+            "name": "session_resumed",
+            "type": "bool"
+          },
+          {
+            "name": "ja3",
+            "type": [
+              {
+                "name": "hash",
+                "type": "bstring"
+              },
+              {
+                "name": "string",
+                "type": "bstring"
+              }
+            ]
+          },
+          {
+            "name": "ja3s",
+            "type": [
+              {
+                "name": "hash",
+                "type": "bstring"
+              },
+              {
+                "name": "string",
+                "type": "bstring"
+              }
+            ]
+          }
+        ]
+      }
+    ]
+  },
+  "rules": [
+    {
+      "name": "event_type",
+      "value": "alert",
+      "descriptor": "alert"
+    },
+    {
+      "name": "event_type",
+      "value": "anomaly",
+      "descriptor": "anomaly"
+    },
+    {
+      "name": "event_type",
+      "value": "dhcp",
+      "descriptor": "dhcp"
+    },
+    {
+      "name": "event_type",
+      "value": "dns",
+      "descriptor": "dns"
+    },
+    {
+      "name": "event_type",
+      "value": "fileinfo",
+      "descriptor": "fileinfo"
+    },
+    {
+      "name": "event_type",
+      "value": "flow",
+      "descriptor": "flow"
+    },
+    {
+      "name": "event_type",
+      "value": "http",
+      "descriptor": "http"
+    },
+    {
+      "name": "event_type",
+      "value": "ssh",
+      "descriptor": "ssh"
+    },
+    {
+      "name": "event_type",
+      "value": "tls",
+      "descriptor": "tls"
+    }
+  ]
+}`
@@ -11,6 +11,7 @@ import (
 	"regexp"
 
 	"github.com/brimsec/zq/pkg/skim"
+	"github.com/brimsec/zq/zio/zeekio"
 	"github.com/brimsec/zq/zio/zjsonio"
 	"github.com/brimsec/zq/zng"
 	"github.com/brimsec/zq/zng/resolver"
@@ -33,6 +34,11 @@ type Reader struct {
 	typ     *typeParser
 	zctx    *resolver.Context
 	stats   ReadStats
+	// inferUnknown is true if objects that don't match a type rule
+	// are parsed by inf instead of being an error.  If requirePath is
+	// also true, objects without a path field are still an error.
+	inferUnknown bool
+	requirePath  bool
 }
 
 func NewReader(reader io.Reader, zctx *resolver.Context, tc *TypeConfig, JSONPathRegex string, filepath string) (*Reader, error) {
@@ -49,26 +55,70 @@ func NewReader(reader io.Reader, zctx *resolver.Context, tc *TypeConfig, JSONPat
 		zctx:    zctx,
 	}
 	if tc != nil {
-		var path string
-		re, err := regexp.Compile(JSONPathRegex)
+		path, err := pathFromFile(JSONPathRegex, filepath)
 		if err != nil {
 			return nil, err
 		}
-		match := re.FindStringSubmatch(filepath)
-		if len(match) == 2 {
-			path = match[1]
-		}
 		r.configureTypes(*tc, path)
 	}
 	return r, nil
 }
 
+// NewZeekReader returns a Reader for Zeek logs written in JSON format.
+// Rather than requiring a TypeConfig, each object is typed according to
+// its _path using the logs of a default Zeek installation as described
+// by zeekio.LogType.  Objects from other logs or with fields not known to
+// be in their log are parsed as if no TypeConfig had been given.
+func NewZeekReader(reader io.Reader, zctx *resolver.Context, JSONPathRegex string, filepath string) (*Reader, error) {
+	r, err := NewReader(reader, zctx, nil, "", "")
+	if err != nil {
+		return nil, err
+	}
+	path, err := pathFromFile(JSONPathRegex, filepath)
+	if err != nil {
+		return nil, err
+	}
+	tr := typeRules{descriptors: make(map[string]*zng.TypeRecord)}
+	for _, logPath := range zeekio.LogPaths() {
+		typ, err := zeekio.LogType(zctx, logPath)
+		if err != nil {
+			return nil, err
+		}
+		tr.descriptors[logPath] = typ
+		tr.rules = append(tr.rules, Rule{Name: "_path", Value: logPath, Descriptor: logPath})
+	}
+	r.setTypeRules(tr, path)
+	r.inferUnknown = true
+	return r, nil
+}
+
+// pathFromFile returns the _path extracted from filepath by the first
+// subexpression of the regular expression JSONPathRegex, or an empty string
+// if it doesn't match.
+func pathFromFile(JSONPathRegex string, filepath string) (string, error) {
+	re, err := regexp.Compile(JSONPathRegex)
+	if err != nil {
+		return "", err
+	}
+	match := re.FindStringSubmatch(filepath)
+	if len(match) == 2 {
+		return match[1], nil
+	}
+	return "", nil
+}
+
 // typeRules is used internally and is derived from TypeConfig by
 // converting its descriptors into *zng.TypeRecord s for use by the
 // ndjson typed parser.
 type typeRules struct {
 	descriptors map[string]*zng.TypeRecord
 	rules       []Rule
+	// pathField is the field whose value is used as the _path of an
+	// object.  If empty, it is "_path".
+	pathField string
+	// jsonNames maps the names of JSON fields to the names of the
+	// descriptor columns holding their values where the two differ.
+	jsonNames map[string]string
 }
 
 // configureTypes adds a TypeConfig to the reader. Its should be
@@ -98,6 +148,11 @@ func (r *Reader) configureTypes(tc TypeConfig, defaultPath string) error {
 		}
 		tr.descriptors[key] = recType
 	}
+	r.setTypeRules(tr, defaultPath)
+	return nil
+}
+
+func (r *Reader) setTypeRules(tr typeRules, defaultPath string) {
 	r.typ = &typeParser{
 		zctx:          r.zctx,
 		tr:            tr,
@@ -105,7 +160,6 @@ func (r *Reader) configureTypes(tc TypeConfig, defaultPath string) error {
 		typeInfoCache: make(map[int]*typeInfo),
 		defaultPath:   defaultPath,
 	}
-	return nil
 }
 
 // Parse returns a zng.Value from the provided JSON input. The
@@ -120,11 +174,27 @@ func (r *Reader) Parse(b []byte) (zng.Value, error) {
 		return zng.Value{}, fmt.Errorf("expected JSON type to be Object but got %s", typ)
 	}
 	if r.typ != nil {
-		return r.typ.parseObject(val)
+		zv, err := r.typ.parseObject(val)
+		if r.inferable(err) {
+			return r.inf.parseObject(val)
+		}
+		return zv, err
 	}
 	return r.inf.parseObject(val)
 }
 
+// inferable returns true if an object whose typed parse failed with err
+// should be parsed by the inferParser instead.
+func (r *Reader) inferable(err error) bool {
+	switch err {
+	case ErrDescriptorNotFound, ErrIncompleteDescriptor:
+		return r.inferUnknown
+	case ErrMissingPath:
+		return r.inferUnknown && !r.requirePath
+	}
+	return false
+}
+
 func (r *Reader) Read() (*zng.Record, error) {
 again:
 	line, err := r.scanner.ScanLine()
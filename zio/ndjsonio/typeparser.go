@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/brimsec/zq/pkg/byteconv"
 	"github.com/brimsec/zq/pkg/nano"
@@ -47,6 +48,7 @@ type typeInfo struct {
 	descriptor *zng.TypeRecord
 	flatDesc   *zng.TypeRecord
 	path       []byte
+	jsonNames  map[string]string
 	jsonVals   []jsonVal
 }
 type jsonVal struct {
@@ -66,13 +68,13 @@ func getUnsafeDefault(data []byte, defaultValue string, key string) (string, err
 	return val, nil
 }
 
-func newTypeInfo(zctx *resolver.Context, desc *zng.TypeRecord, path string) (*typeInfo, error) {
+func newTypeInfo(zctx *resolver.Context, desc *zng.TypeRecord, path string, jsonNames map[string]string) (*typeInfo, error) {
 	flatCols := zeekio.FlattenColumns(desc.Columns)
 	flatDesc, err := zctx.LookupTypeRecord(flatCols)
 	if err != nil {
 		return nil, err
 	}
-	info := typeInfo{desc, flatDesc, []byte(path), make([]jsonVal, len(flatDesc.Columns))}
+	info := typeInfo{desc, flatDesc, []byte(path), jsonNames, make([]jsonVal, len(flatDesc.Columns))}
 	return &info, nil
 }
 
@@ -101,6 +103,9 @@ func (info *typeInfo) makeViews(data []byte) (int, error) {
 		}
 
 		fullkey := strings.Join(append(prefix, skey), ".")
+		if name, ok := info.jsonNames[fullkey]; ok {
+			fullkey = name
+		}
 
 		if col, ok := info.flatDesc.ColumnOfField(fullkey); ok {
 			info.jsonVals[col] = jsonVal{val, typ}
@@ -217,7 +222,13 @@ func (info *typeInfo) newRawFromJSON(data []byte) (zcode.Bytes, int, error) {
 // is not empty, it is used as the default _path if the object has no
 // such field. (we could at some point make this a bit more generic by
 // passing in a "defaultFieldValues" map... but not needed now).
+// The _path of the returned typeInfo is the value of the object's
+// tr.pathField field.
 func (p *typeParser) findTypeInfo(zctx *resolver.Context, jobj []byte, tr typeRules, defaultPath string) (*typeInfo, error) {
+	pathField := tr.pathField
+	if pathField == "" {
+		pathField = "_path"
+	}
 	var fieldName, fieldVal, path string
 	for _, r := range tr.rules {
 		// we keep track of the last field value we extracted
@@ -230,7 +241,6 @@ func (p *typeParser) findTypeInfo(zctx *resolver.Context, jobj []byte, tr typeRu
 			var err error
 			if r.Name == "_path" {
 				fieldVal, err = getUnsafeDefault(jobj, defaultPath, r.Name)
-				path = fieldVal
 			} else {
 				// jsonparser.Get will return the key even for
 				// some invalid json. For example Get('x{"a":
@@ -241,13 +251,16 @@ func (p *typeParser) findTypeInfo(zctx *resolver.Context, jobj []byte, tr typeRu
 			if err != nil {
 				continue
 			}
+			if r.Name == pathField {
+				path = fieldVal
+			}
 		}
 		if fieldVal == r.Value {
 			desc := tr.descriptors[r.Descriptor]
 			if ti, ok := p.typeInfoCache[desc.ID()]; ok {
 				return ti, nil
 			}
-			ti, err := newTypeInfo(zctx, desc, path)
+			ti, err := newTypeInfo(zctx, desc, path, tr.jsonNames)
 			if err != nil {
 				return nil, err
 			}
@@ -365,6 +378,15 @@ func parseSimpleType(value []byte, typ zng.Type) ([]byte, error) {
 	}
 }
 
+// isoLayouts are the layouts other than RFC 3339 accepted for ISO 8601
+// timestamps.  Suricata writes numeric zone offsets without a colon and
+// some times (e.g., of certificates) without a zone, which is taken to be
+// UTC.
+var isoLayouts = []string{
+	"2006-01-02T15:04:05.999999999Z0700",
+	"2006-01-02T15:04:05.999999999",
+}
+
 // parseJSONTimestamp interprets data as a timestamp and returns its value as
 // both a nano.Ts and the standard Zeek format (a decimal floating-point number
 // representing seconds since the Unix epoch).
@@ -374,10 +396,20 @@ func parseSimpleType(value []byte, typ zng.Type) ([]byte, error) {
 // correspond to the three possible values for LogAscii::json_timestamps:
 // JSON::TS_EPOCH, JSON::TS_ISO8601, and JSON::TS_MILLIS.  For descriptions, see
 // https://docs.zeek.org/en/stable/scripts/base/init-bare.zeek.html#type-JSON::TimestampFormat.
+// It also understands the ISO 8601 variants in isoLayouts.
 func parseJSONTimestamp(data []byte) (nano.Ts, error) {
 	switch {
 	case bytes.Contains(data, []byte{'-'}): // JSON::TS_ISO8601
-		return nano.ParseRFC3339Nano(data)
+		ts, err := nano.ParseRFC3339Nano(data)
+		if err == nil {
+			return ts, nil
+		}
+		for _, layout := range isoLayouts {
+			if t, err := time.Parse(layout, string(data)); err == nil {
+				return nano.TimeToTs(t), nil
+			}
+		}
+		return 0, err
 	case bytes.Contains(data, []byte{'.'}): // JSON::TS_EPOCH
 		return nano.Parse(data)
 	default: // JSON::TS_MILLIS
@@ -0,0 +1,323 @@
+package parquetio
+
+import (
+	encjson "encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/brimsec/zq/zcode"
+	"github.com/brimsec/zq/zng"
+	"github.com/xitongsys/parquet-go-source/writerfile"
+	"github.com/xitongsys/parquet-go/common"
+	"github.com/xitongsys/parquet-go/layout"
+	"github.com/xitongsys/parquet-go/schema"
+	"github.com/xitongsys/parquet-go/writer"
+)
+
+var ErrMultipleTypes = errors.New("parquet output requires records of a single type (use -d to write a file for each type)")
+
+// Writer writes records of a single type to a Parquet file.  The Parquet
+// schema is derived from the type of the first record written.  Every
+// field is optional since any ZNG value may be unset.  Records become
+// groups and arrays and sets become LIST structures.  The mapping of
+// primitive types is described in types.md.  The footer of the file is
+// written by Flush, after which the Writer may not be used.
+type Writer struct {
+	writer io.Writer
+	typ    *zng.TypeRecord
+	pw     *writer.ParquetWriter
+	done   bool
+}
+
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{writer: w}
+}
+
+func (w *Writer) Write(rec *zng.Record) error {
+	if w.done {
+		return errors.New("parquet writer: write after flush")
+	}
+	if w.pw == nil {
+		if err := w.init(rec.Type); err != nil {
+			return err
+		}
+	} else if rec.Type != w.typ {
+		return ErrMultipleTypes
+	}
+	// The ParquetWriter holds on to the records it's given until it
+	// writes a page, so it gets a copy of the record body.
+	body := make(zcode.Bytes, len(rec.Raw))
+	copy(body, rec.Raw)
+	return w.pw.Write(body)
+}
+
+func (w *Writer) init(typ *zng.TypeRecord) error {
+	root := &schema.JSONSchemaItemType{Tag: "name=zng, repetitiontype=REQUIRED"}
+	for _, col := range typ.Columns {
+		item, err := newSchemaItem(col.Name, col.Type)
+		if err != nil {
+			return err
+		}
+		root.Fields = append(root.Fields, item)
+	}
+	b, err := encjson.Marshal(root)
+	if err != nil {
+		return err
+	}
+	jw, err := writer.NewJSONWriter(string(b), writerfile.NewWriterFile(w.writer), 1)
+	if err != nil {
+		return err
+	}
+	w.pw = &jw.ParquetWriter
+	w.pw.MarshalFunc = func(src []interface{}, bgn, end int, sh *schema.SchemaHandler) (*map[string]*layout.Table, error) {
+		return marshal(typ, src[bgn:end], sh)
+	}
+	w.typ = typ
+	return nil
+}
+
+// MixedTypes returns false since a Parquet file has a single schema.
+func (w *Writer) MixedTypes() bool {
+	return false
+}
+
+// Flush writes the footer of the Parquet file.  Nothing is written if no
+// records were written.
+func (w *Writer) Flush() error {
+	if w.pw == nil || w.done {
+		return nil
+	}
+	w.done = true
+	return w.pw.WriteStop()
+}
+
+// newSchemaItem returns the item of a parquet-go JSON schema describing a
+// field with the given name and type.
+func newSchemaItem(name string, typ zng.Type) (*schema.JSONSchemaItemType, error) {
+	if name == "" || strings.ContainsAny(name, ",=\t") {
+		return nil, fmt.Errorf("parquet writer: field name %q not supported", name)
+	}
+	tag := func(ptype string) string {
+		return fmt.Sprintf("name=%s, type=%s, repetitiontype=OPTIONAL", name, ptype)
+	}
+	switch typ := zng.AliasedType(typ).(type) {
+	case *zng.TypeRecord:
+		item := &schema.JSONSchemaItemType{Tag: fmt.Sprintf("name=%s, repetitiontype=OPTIONAL", name)}
+		for _, col := range typ.Columns {
+			child, err := newSchemaItem(col.Name, col.Type)
+			if err != nil {
+				return nil, err
+			}
+			item.Fields = append(item.Fields, child)
+		}
+		return item, nil
+	case *zng.TypeArray, *zng.TypeSet:
+		elem, err := newSchemaItem("element", zng.InnerType(typ))
+		if err != nil {
+			return nil, err
+		}
+		return &schema.JSONSchemaItemType{
+			Tag:    tag("LIST"),
+			Fields: []*schema.JSONSchemaItemType{elem},
+		}, nil
+	}
+	ptype, err := primitiveType(typ)
+	if err != nil {
+		return nil, err
+	}
+	return &schema.JSONSchemaItemType{Tag: tag(ptype)}, nil
+}
+
+// primitiveType returns the parquet-go name of the Parquet type (or
+// converted type, which implies a primitive type) for a ZNG primitive type.
+func primitiveType(typ zng.Type) (string, error) {
+	switch typ.ID() {
+	case zng.IdBool:
+		return "BOOLEAN", nil
+	case zng.IdByte:
+		return "UINT_8", nil
+	case zng.IdInt16:
+		return "INT_16", nil
+	case zng.IdUint16, zng.IdPort:
+		return "UINT_16", nil
+	case zng.IdInt32:
+		return "INT32", nil
+	case zng.IdUint32:
+		return "UINT_32", nil
+	case zng.IdInt64, zng.IdDuration:
+		return "INT64", nil
+	case zng.IdUint64:
+		return "UINT_64", nil
+	case zng.IdFloat64:
+		return "DOUBLE", nil
+	case zng.IdTime:
+		return "TIMESTAMP_MICROS", nil
+	case zng.IdString, zng.IdEnum, zng.IdIP, zng.IdNet:
+		return "UTF8", nil
+	case zng.IdBytes, zng.IdBstring:
+		return "BYTE_ARRAY", nil
+	}
+	return "", fmt.Errorf("parquet writer: type %s not supported", typ)
+}
+
+// marshal converts the record bodies in src, all of type typ, into the
+// tables of values and levels from which parquet-go builds column chunks.
+// It replaces parquet-go's JSON marshaler.
+func marshal(typ *zng.TypeRecord, src []interface{}, sh *schema.SchemaHandler) (*map[string]*layout.Table, error) {
+	tables := make(map[string]*layout.Table)
+	// The leaves of the schema are in the same order as the primitive
+	// values found by a depth-first walk of typ.
+	var leaves []*layout.Table
+	for k, el := range sh.SchemaElements {
+		if el.GetNumChildren() != 0 {
+			continue
+		}
+		path := sh.IndexMap[int32(k)]
+		t := layout.NewEmptyTable()
+		t.Path = common.StrToPath(path)
+		t.MaxDefinitionLevel, _ = sh.MaxDefinitionLevel(t.Path)
+		t.MaxRepetitionLevel, _ = sh.MaxRepetitionLevel(t.Path)
+		t.RepetitionType = el.GetRepetitionType()
+		t.Schema = el
+		t.Info = sh.Infos[k]
+		tables[path] = t
+		leaves = append(leaves, t)
+	}
+	m := &marshaler{leaves: leaves, nleaves: make(map[zng.Type]int)}
+	for _, s := range src {
+		if err := m.record(typ, s.(zcode.Bytes), 0, 0, 0, 0); err != nil {
+			return nil, err
+		}
+	}
+	return &tables, nil
+}
+
+type marshaler struct {
+	leaves  []*layout.Table
+	nleaves map[zng.Type]int
+}
+
+// value appends a value of type typ to the leaf tables starting with
+// m.leaves[leaf].  rl is the repetition level of the value, dl is the
+// definition level of its parent, and depth is the number of lists
+// containing it.
+func (m *marshaler) value(typ zng.Type, zv zcode.Bytes, leaf int, rl, dl, depth int32) error {
+	if zv == nil {
+		m.null(typ, leaf, rl, dl)
+		return nil
+	}
+	switch typ := zng.AliasedType(typ).(type) {
+	case *zng.TypeRecord:
+		return m.record(typ, zv, leaf, rl, dl+1, depth)
+	case *zng.TypeArray, *zng.TypeSet:
+		inner := zng.InnerType(typ)
+		if len(zv) == 0 {
+			// An empty list is defined but has no elements.
+			m.null(inner, leaf, rl, dl+1)
+			return nil
+		}
+		r := rl
+		for it := zv.Iter(); !it.Done(); {
+			elem, _, err := it.Next()
+			if err != nil {
+				return err
+			}
+			if err := m.value(inner, elem, leaf, r, dl+2, depth+1); err != nil {
+				return err
+			}
+			r = depth + 1
+		}
+		return nil
+	}
+	v, err := primitiveValue(typ, zv)
+	if err != nil {
+		return err
+	}
+	m.append(m.leaves[leaf], v, rl, dl+1)
+	return nil
+}
+
+func (m *marshaler) record(typ *zng.TypeRecord, zv zcode.Bytes, leaf int, rl, dl, depth int32) error {
+	it := zv.Iter()
+	for _, col := range typ.Columns {
+		v, _, err := it.Next()
+		if err != nil {
+			return err
+		}
+		if err := m.value(col.Type, v, leaf, rl, dl, depth); err != nil {
+			return err
+		}
+		leaf += m.count(col.Type)
+	}
+	return nil
+}
+
+// null appends a null to each of the leaves of a value of type typ.
+func (m *marshaler) null(typ zng.Type, leaf int, rl, dl int32) {
+	n := m.count(typ)
+	for k := leaf; k < leaf+n; k++ {
+		m.append(m.leaves[k], nil, rl, dl)
+	}
+}
+
+func (m *marshaler) append(t *layout.Table, v interface{}, rl, dl int32) {
+	t.Values = append(t.Values, v)
+	t.RepetitionLevels = append(t.RepetitionLevels, rl)
+	t.DefinitionLevels = append(t.DefinitionLevels, dl)
+}
+
+// count returns the number of leaves in the schema of a value of type typ.
+func (m *marshaler) count(typ zng.Type) int {
+	if n, ok := m.nleaves[typ]; ok {
+		return n
+	}
+	n := 1
+	switch typ := zng.AliasedType(typ).(type) {
+	case *zng.TypeRecord:
+		n = 0
+		for _, col := range typ.Columns {
+			n += m.count(col.Type)
+		}
+	case *zng.TypeArray, *zng.TypeSet:
+		n = m.count(zng.InnerType(typ))
+	}
+	m.nleaves[typ] = n
+	return n
+}
+
+// primitiveValue returns the value parquet-go expects for the Parquet
+// type of a ZNG primitive value.
+func primitiveValue(typ zng.Type, zv zcode.Bytes) (interface{}, error) {
+	switch typ.ID() {
+	case zng.IdBool:
+		return zng.DecodeBool(zv)
+	case zng.IdByte, zng.IdUint16, zng.IdUint32:
+		v, err := zng.DecodeUint(zv)
+		return int32(v), err
+	case zng.IdPort:
+		v, err := zng.DecodePort(zv)
+		return int32(v), err
+	case zng.IdInt16, zng.IdInt32:
+		v, err := zng.DecodeInt(zv)
+		return int32(v), err
+	case zng.IdInt64:
+		return zng.DecodeInt(zv)
+	case zng.IdDuration:
+		return zng.DecodeDuration(zv)
+	case zng.IdUint64:
+		v, err := zng.DecodeUint(zv)
+		return int64(v), err
+	case zng.IdFloat64:
+		return zng.DecodeFloat64(zv)
+	case zng.IdTime:
+		ts, err := zng.DecodeTime(zv)
+		return int64(ts) / 1000, err
+	case zng.IdString, zng.IdBytes, zng.IdBstring, zng.IdEnum:
+		return string(zv), nil
+	case zng.IdIP, zng.IdNet:
+		return typ.StringOf(zv, zng.OutFormatUnescaped, false), nil
+	}
+	return nil, fmt.Errorf("parquet writer: type %s not supported", typ)
+}
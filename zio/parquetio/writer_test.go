@@ -0,0 +1,68 @@
+package parquetio_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zio/parquetio"
+	"github.com/brimsec/zq/zio/tzngio"
+	"github.com/brimsec/zq/zng/resolver"
+	"github.com/stretchr/testify/require"
+	"github.com/xitongsys/parquet-go-source/buffer"
+	"github.com/xitongsys/parquet-go/reader"
+)
+
+func TestWriter(t *testing.T) {
+	t.Run("primitives", func(t *testing.T) {
+		tzng := `#0:record[s:string,n:int64,p:port,u:uint64,b:bool,ts:time,d:duration,a:ip,sn:net]
+0:[hello;-5;80;7;T;1425565514.419939;1.5;10.0.0.1;10.0.0.0/8;]
+0:[-;-;-;-;-;-;-;-;-;]`
+		expected := `[{"S":"hello","N":-5,"P":80,"U":7,"B":true,"Ts":1425565514419939,"D":1500000000,"A":"10.0.0.1","Sn":"10.0.0.0/8"},{"S":null,"N":null,"P":null,"U":null,"B":null,"Ts":null,"D":null,"A":null,"Sn":null}]`
+		runcase(t, tzng, expected)
+	})
+	t.Run("records", func(t *testing.T) {
+		tzng := `#0:record[id:record[orig_h:ip,resp:record[h:ip,p:port]]]
+0:[[10.0.0.1;[10.0.0.2;53;]]]
+0:[[10.0.0.1;-;]]
+0:[-;]`
+		expected := `[{"Id":{"Orig_h":"10.0.0.1","Resp":{"H":"10.0.0.2","P":53}}},{"Id":{"Orig_h":"10.0.0.1","Resp":null}},{"Id":null}]`
+		runcase(t, tzng, expected)
+	})
+	t.Run("lists", func(t *testing.T) {
+		tzng := `#0:record[v:array[record[x:int32,y:set[string]]],w:array[array[int64]]]
+0:[[[1;[a;b;]][-;[]][2;-;]][[1;2;][][3;]]]
+0:[[][-;]]
+0:[-;-;]`
+		expected := `[{"V":[{"X":1,"Y":["a","b"]},{"X":null,"Y":[]},{"X":2,"Y":null}],"W":[[1,2],[],[3]]},{"V":[],"W":[null]},{"V":null,"W":null}]`
+		runcase(t, tzng, expected)
+	})
+}
+
+func TestWriterMultipleTypes(t *testing.T) {
+	tzng := `#0:record[a:string]
+#1:record[a:int64]
+0:[foo;]
+1:[1;]`
+	r := tzngio.NewReader(strings.NewReader(tzng), resolver.NewContext())
+	w := parquetio.NewWriter(&bytes.Buffer{})
+	require.Equal(t, parquetio.ErrMultipleTypes, zbuf.Copy(w, r))
+}
+
+func runcase(t *testing.T, tzng, expected string) {
+	var out bytes.Buffer
+	r := tzngio.NewReader(strings.NewReader(tzng), resolver.NewContext())
+	require.NoError(t, zbuf.Copy(parquetio.NewWriter(&out), r))
+
+	pf, err := buffer.NewBufferFile(out.Bytes())
+	require.NoError(t, err)
+	pr, err := reader.NewParquetReader(pf, nil, 1)
+	require.NoError(t, err)
+	rows, err := pr.ReadByNumber(int(pr.GetNumRows()))
+	require.NoError(t, err)
+	b, err := json.Marshal(rows)
+	require.NoError(t, err)
+	require.JSONEq(t, expected, string(b))
+}
@@ -0,0 +1,379 @@
+// Package syslogio reads syslog messages in the formats described by
+// RFC 3164 (the traditional BSD format) and RFC 5424, one message per line.
+package syslogio
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/pkg/skim"
+	"github.com/brimsec/zq/zcode"
+	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
+)
+
+const (
+	ReadSize    = 64 * 1024
+	MaxLineSize = 50 * 1024 * 1024
+)
+
+var (
+	ErrBadPriority       = errors.New("bad priority")
+	ErrBadTimestamp      = errors.New("bad timestamp")
+	ErrBadStructuredData = errors.New("bad structured data")
+	ErrTruncated         = errors.New("message truncated")
+)
+
+var facilities = []string{
+	"kern", "user", "mail", "daemon", "auth", "syslog", "lpr", "news",
+	"uucp", "cron", "authpriv", "ftp", "ntp", "security", "console", "solaris-cron",
+	"local0", "local1", "local2", "local3", "local4", "local5", "local6", "local7",
+}
+
+var severities = []string{
+	"emerg", "alert", "crit", "err", "warning", "notice", "info", "debug",
+}
+
+// Reader reads syslog messages, each of which becomes a record with the
+// fields ts, facility, severity, host, app, procid, and message.  RFC 5424
+// messages also have the field msgid and, if the message has structured
+// data, the field structured_data, a record with a record field for each
+// structured data element, e.g., the element [origin ip="10.0.0.1"]
+// becomes the field origin of type record[ip:string].  Characters in
+// element IDs and parameter names other than letters, digits, and
+// underscores are replaced with underscores.  Facility and severity are
+// the keywords used by syslog.conf, e.g., "auth" and "err".
+//
+// Since RFC 3164 timestamps have no year, the year is assumed to be the one
+// that puts the timestamp no more than a day past the current time.
+// Fields that are missing, or nil in an RFC 5424 message, are unset.
+type Reader struct {
+	scanner *skim.Scanner
+	zctx    *resolver.Context
+	builder *zcode.Builder
+	now     func() time.Time
+	bsdType *zng.TypeRecord
+	// rfc5424Type is the type of RFC 5424 messages without
+	// structured data.
+	rfc5424Type *zng.TypeRecord
+}
+
+func NewReader(reader io.Reader, zctx *resolver.Context) *Reader {
+	buffer := make([]byte, ReadSize)
+	return &Reader{
+		scanner: skim.NewScanner(reader, buffer, MaxLineSize),
+		zctx:    zctx,
+		builder: zcode.NewBuilder(),
+		now:     time.Now,
+	}
+}
+
+func (r *Reader) Read() (*zng.Record, error) {
+again:
+	line, err := r.scanner.ScanLine()
+	if line == nil {
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", r.scanner.Stats.Lines, err)
+		}
+		return nil, nil
+	}
+	line = bytes.TrimRight(line, "\r\n")
+	if len(line) == 0 {
+		goto again
+	}
+	rec, err := r.parse(line)
+	if err != nil {
+		return nil, fmt.Errorf("line %d: %w", r.scanner.Stats.Lines, err)
+	}
+	return rec, nil
+}
+
+func (r *Reader) parse(line []byte) (*zng.Record, error) {
+	r.builder.Reset()
+	var facility, severity zcode.Bytes
+	if len(line) > 0 && line[0] == '<' {
+		end := bytes.IndexByte(line, '>')
+		if end < 2 || end > 4 {
+			return nil, ErrBadPriority
+		}
+		pri, err := strconv.Atoi(string(line[1:end]))
+		if err != nil || pri < 0 || pri >= len(facilities)*8 {
+			return nil, ErrBadPriority
+		}
+		facility = zcode.Bytes(facilities[pri/8])
+		severity = zcode.Bytes(severities[pri%8])
+		line = line[end+1:]
+		if len(line) > 1 && line[0] == '1' && line[1] == ' ' {
+			return r.parseRFC5424(facility, severity, line[2:])
+		}
+	}
+	return r.parseRFC3164(facility, severity, line)
+}
+
+// parseRFC3164 parses the remainder of an RFC 3164 message following the
+// priority, which looks like "Oct 11 22:14:15 host app[123]: message".
+func (r *Reader) parseRFC3164(facility, severity zcode.Bytes, line []byte) (*zng.Record, error) {
+	ts, line, err := r.parseBSDTimestamp(line)
+	if err != nil {
+		return nil, err
+	}
+	host, line := nextField(line)
+	if host == nil {
+		return nil, ErrTruncated
+	}
+	var app, procid zcode.Bytes
+	// The tag is the app name followed by an optional process ID in
+	// brackets and a colon.  If there's no such tag, the entire remainder
+	// is the message.
+	if end := bytes.IndexAny(line, "[: "); end > 0 {
+		tag, rest := line[:end], line[end:]
+		if rest[0] == '[' {
+			if rbrack := bytes.IndexByte(rest, ']'); rbrack > 0 {
+				procid, rest = rest[1:rbrack], rest[rbrack+1:]
+			} else {
+				rest = nil
+			}
+		}
+		if len(rest) > 0 && rest[0] == ':' {
+			app, line = tag, bytes.TrimPrefix(rest[1:], []byte{' '})
+		} else {
+			procid = nil
+		}
+	}
+	if r.bsdType == nil {
+		r.bsdType, err = r.zctx.LookupTypeRecord([]zng.Column{
+			zng.NewColumn("ts", zng.TypeTime),
+			zng.NewColumn("facility", zng.TypeString),
+			zng.NewColumn("severity", zng.TypeString),
+			zng.NewColumn("host", zng.TypeString),
+			zng.NewColumn("app", zng.TypeString),
+			zng.NewColumn("procid", zng.TypeString),
+			zng.NewColumn("message", zng.TypeString),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	r.builder.AppendPrimitive(zng.EncodeTime(ts))
+	r.builder.AppendPrimitive(facility)
+	r.builder.AppendPrimitive(severity)
+	r.builder.AppendPrimitive(host)
+	r.builder.AppendPrimitive(app)
+	r.builder.AppendPrimitive(procid)
+	r.builder.AppendPrimitive(line)
+	return zng.NewRecord(r.bsdType, r.builder.Bytes())
+}
+
+// parseBSDTimestamp parses a timestamp of the form "Oct 11 22:14:15".  Some
+// senders use an RFC 3339 timestamp instead so that is accepted too.
+func (r *Reader) parseBSDTimestamp(line []byte) (nano.Ts, []byte, error) {
+	if len(line) > 0 && line[0] >= '0' && line[0] <= '9' {
+		field, rest := nextField(line)
+		ts, err := nano.ParseRFC3339Nano(field)
+		if err != nil {
+			return 0, nil, ErrBadTimestamp
+		}
+		return ts, rest, nil
+	}
+	const layout = "Jan _2 15:04:05"
+	if len(line) < len(layout) || (len(line) > len(layout) && line[len(layout)] != ' ') {
+		return 0, nil, ErrBadTimestamp
+	}
+	t, err := time.ParseInLocation(layout, string(line[:len(layout)]), time.Local)
+	if err != nil {
+		return 0, nil, ErrBadTimestamp
+	}
+	now := r.now()
+	t = t.AddDate(now.Year(), 0, 0)
+	if t.After(now.Add(24 * time.Hour)) {
+		t = t.AddDate(-1, 0, 0)
+	}
+	return nano.TimeToTs(t), bytes.TrimPrefix(line[len(layout):], []byte{' '}), nil
+}
+
+// parseRFC5424 parses the remainder of an RFC 5424 message following the
+// version, which looks like
+// "2003-10-11T22:14:15.003Z host app 123 ID47 [id param="value"] message".
+func (r *Reader) parseRFC5424(facility, severity zcode.Bytes, line []byte) (*zng.Record, error) {
+	var fields [5]zcode.Bytes
+	for k := range fields {
+		var field zcode.Bytes
+		field, line = nextField(line)
+		if field == nil {
+			return nil, ErrTruncated
+		}
+		if string(field) != "-" {
+			fields[k] = field
+		}
+	}
+	var ts zcode.Bytes
+	if fields[0] != nil {
+		t, err := nano.ParseRFC3339Nano(fields[0])
+		if err != nil {
+			return nil, ErrBadTimestamp
+		}
+		ts = zng.EncodeTime(t)
+	}
+	cols := []zng.Column{
+		zng.NewColumn("ts", zng.TypeTime),
+		zng.NewColumn("facility", zng.TypeString),
+		zng.NewColumn("severity", zng.TypeString),
+		zng.NewColumn("host", zng.TypeString),
+		zng.NewColumn("app", zng.TypeString),
+		zng.NewColumn("procid", zng.TypeString),
+		zng.NewColumn("msgid", zng.TypeString),
+	}
+	r.builder.AppendPrimitive(ts)
+	r.builder.AppendPrimitive(facility)
+	r.builder.AppendPrimitive(severity)
+	for _, field := range fields[1:] {
+		r.builder.AppendPrimitive(field)
+	}
+	typ := r.rfc5424Type
+	if len(line) > 0 && line[0] == '[' {
+		var sdType *zng.TypeRecord
+		var err error
+		sdType, line, err = r.parseStructuredData(line)
+		if err != nil {
+			return nil, err
+		}
+		cols = append(cols, zng.NewColumn("structured_data", sdType))
+		typ = nil
+	} else {
+		sd, rest := nextField(line)
+		if string(sd) != "-" {
+			return nil, ErrBadStructuredData
+		}
+		line = rest
+	}
+	// The message may begin with a byte order mark to indicate that
+	// it's UTF-8.
+	line = bytes.TrimPrefix(line, []byte("\xef\xbb\xbf"))
+	cols = append(cols, zng.NewColumn("message", zng.TypeString))
+	if len(line) > 0 {
+		r.builder.AppendPrimitive(line)
+	} else {
+		r.builder.AppendPrimitive(nil)
+	}
+	if typ == nil {
+		var err error
+		typ, err = r.zctx.LookupTypeRecord(cols)
+		if err != nil {
+			return nil, err
+		}
+		if len(cols) == 8 {
+			r.rfc5424Type = typ
+		}
+	}
+	return zng.NewRecord(typ, r.builder.Bytes())
+}
+
+// parseStructuredData parses one or more structured data elements, appends
+// them to r.builder as a record, and returns the type of the record and the
+// remainder of line.
+func (r *Reader) parseStructuredData(line []byte) (*zng.TypeRecord, []byte, error) {
+	var elemCols []zng.Column
+	r.builder.BeginContainer()
+	for len(line) > 0 && line[0] == '[' {
+		line = line[1:]
+		end := bytes.IndexAny(line, " ]")
+		if end <= 0 {
+			return nil, nil, ErrBadStructuredData
+		}
+		id := fieldName(line[:end])
+		line = line[end:]
+		var paramCols []zng.Column
+		r.builder.BeginContainer()
+		for len(line) > 0 && line[0] == ' ' {
+			line = line[1:]
+			eq := bytes.IndexByte(line, '=')
+			if eq <= 0 || len(line) < eq+2 || line[eq+1] != '"' {
+				return nil, nil, ErrBadStructuredData
+			}
+			name := fieldName(line[:eq])
+			var val []byte
+			var ok bool
+			val, line, ok = parseParamValue(line[eq+2:])
+			if !ok {
+				return nil, nil, ErrBadStructuredData
+			}
+			paramCols = append(paramCols, zng.NewColumn(name, zng.TypeString))
+			r.builder.AppendPrimitive(val)
+		}
+		if len(line) == 0 || line[0] != ']' {
+			return nil, nil, ErrBadStructuredData
+		}
+		line = line[1:]
+		r.builder.EndContainer()
+		typ, err := r.zctx.LookupTypeRecord(paramCols)
+		if err != nil {
+			return nil, nil, fmt.Errorf("structured data element %s: %w", id, err)
+		}
+		elemCols = append(elemCols, zng.NewColumn(id, typ))
+	}
+	r.builder.EndContainer()
+	if len(line) > 0 {
+		if line[0] != ' ' {
+			return nil, nil, ErrBadStructuredData
+		}
+		line = line[1:]
+	}
+	typ, err := r.zctx.LookupTypeRecord(elemCols)
+	if err != nil {
+		return nil, nil, fmt.Errorf("structured data: %w", err)
+	}
+	return typ, line, nil
+}
+
+// parseParamValue parses a parameter value following its opening quote,
+// in which '"', '\', and ']' may be escaped with a backslash.  It returns
+// the value and the remainder of in following the closing quote.
+func parseParamValue(in []byte) ([]byte, []byte, bool) {
+	var val []byte
+	for k := 0; k < len(in); k++ {
+		switch c := in[k]; c {
+		case '"':
+			return val, in[k+1:], true
+		case '\\':
+			if k+1 < len(in) {
+				if next := in[k+1]; next == '"' || next == '\\' || next == ']' {
+					c = next
+					k++
+				}
+			}
+			val = append(val, c)
+		default:
+			val = append(val, c)
+		}
+	}
+	return nil, nil, false
+}
+
+// fieldName returns name with any character other than a letter, digit, or
+// underscore replaced by an underscore.
+func fieldName(name []byte) string {
+	out := []byte(string(name))
+	for k, c := range out {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_') {
+			out[k] = '_'
+		}
+	}
+	return string(out)
+}
+
+// nextField returns the bytes of line up to the first space and the bytes
+// following the space.  It returns nil if line is empty.
+func nextField(line []byte) ([]byte, []byte) {
+	if len(line) == 0 {
+		return nil, nil
+	}
+	if k := bytes.IndexByte(line, ' '); k >= 0 {
+		return line[:k], line[k+1:]
+	}
+	return line, nil
+}
@@ -0,0 +1,59 @@
+package syslogio
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/zng/resolver"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBSDTimestampYear(t *testing.T) {
+	cases := []struct {
+		line string
+		now  time.Time
+		ts   time.Time
+	}{
+		{
+			line: "<13>Oct 11 22:14:15 host app: msg",
+			now:  time.Date(2020, 11, 1, 0, 0, 0, 0, time.Local),
+			ts:   time.Date(2020, 10, 11, 22, 14, 15, 0, time.Local),
+		},
+		{
+			// A December timestamp read in early January belongs
+			// to the previous year.
+			line: "<13>Dec 31 23:59:59 host app: msg",
+			now:  time.Date(2021, 1, 1, 0, 5, 0, 0, time.Local),
+			ts:   time.Date(2020, 12, 31, 23, 59, 59, 0, time.Local),
+		},
+	}
+	for _, c := range cases {
+		r := NewReader(strings.NewReader(c.line), resolver.NewContext())
+		r.now = func() time.Time { return c.now }
+		rec, err := r.Read()
+		require.NoError(t, err)
+		ts, err := rec.AccessTime("ts")
+		require.NoError(t, err)
+		require.Equal(t, nano.TimeToTs(c.ts), ts, c.line)
+	}
+}
+
+func TestReadErrors(t *testing.T) {
+	cases := []struct {
+		line string
+		err  error
+	}{
+		{"<x>Oct 11 22:14:15 host app: msg", ErrBadPriority},
+		{"<13>Oct 99 22:14:15 host app: msg", ErrBadTimestamp},
+		{"<13>1 2003-10-11T22:14:15.003Z host app", ErrTruncated},
+		{"<13>1 2003-10-11T22:14:15.003Z host app - ID47 [id a=\"b\" msg", ErrBadStructuredData},
+	}
+	for _, c := range cases {
+		r := NewReader(strings.NewReader(c.line), resolver.NewContext())
+		_, err := r.Read()
+		require.True(t, errors.Is(err, c.err), "%s: %v", c.line, err)
+	}
+}
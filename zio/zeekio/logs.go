@@ -0,0 +1,867 @@
+// Code generated by mklogs.go from zeek/types.json. DO NOT EDIT.
+
+package zeekio
+
+// logFields holds the fields of each log written by a default installation
+// of Zeek, keyed by _path.  The _path field is not included since every
+// log has one.
+var logFields = map[string][]logField{
+	"broker": {
+		{"ts", "time"},
+		{"ty", "zenum"},
+		{"ev", "bstring"},
+		{"peer.address", "bstring"},
+		{"peer.bound_port", "port"},
+		{"message", "bstring"},
+		{"_write_ts", "time"},
+	},
+	"capture_loss": {
+		{"ts", "time"},
+		{"ts_delta", "duration"},
+		{"peer", "bstring"},
+		{"gaps", "uint64"},
+		{"acks", "uint64"},
+		{"percent_lost", "float64"},
+		{"_write_ts", "time"},
+	},
+	"cluster": {
+		{"ts", "time"},
+		{"node", "bstring"},
+		{"message", "bstring"},
+		{"_write_ts", "time"},
+	},
+	"config": {
+		{"ts", "time"},
+		{"id", "bstring"},
+		{"old_value", "bstring"},
+		{"new_value", "bstring"},
+		{"location", "bstring"},
+		{"_write_ts", "time"},
+	},
+	"conn": {
+		{"ts", "time"},
+		{"uid", "bstring"},
+		{"id.orig_h", "ip"},
+		{"id.orig_p", "port"},
+		{"id.resp_h", "ip"},
+		{"id.resp_p", "port"},
+		{"proto", "zenum"},
+		{"service", "bstring"},
+		{"duration", "duration"},
+		{"orig_bytes", "uint64"},
+		{"resp_bytes", "uint64"},
+		{"conn_state", "bstring"},
+		{"local_orig", "bool"},
+		{"local_resp", "bool"},
+		{"missed_bytes", "uint64"},
+		{"history", "bstring"},
+		{"orig_pkts", "uint64"},
+		{"orig_ip_bytes", "uint64"},
+		{"resp_pkts", "uint64"},
+		{"resp_ip_bytes", "uint64"},
+		{"tunnel_parents", "set[bstring]"},
+		{"_write_ts", "time"},
+	},
+	"dce_rpc": {
+		{"ts", "time"},
+		{"uid", "bstring"},
+		{"id.orig_h", "ip"},
+		{"id.orig_p", "port"},
+		{"id.resp_h", "ip"},
+		{"id.resp_p", "port"},
+		{"rtt", "duration"},
+		{"named_pipe", "bstring"},
+		{"endpoint", "bstring"},
+		{"operation", "bstring"},
+		{"_write_ts", "time"},
+	},
+	"dhcp": {
+		{"ts", "time"},
+		{"uids", "set[bstring]"},
+		{"client_addr", "ip"},
+		{"server_addr", "ip"},
+		{"mac", "bstring"},
+		{"host_name", "bstring"},
+		{"client_fqdn", "bstring"},
+		{"domain", "bstring"},
+		{"requested_addr", "ip"},
+		{"assigned_addr", "ip"},
+		{"lease_time", "duration"},
+		{"client_message", "bstring"},
+		{"server_message", "bstring"},
+		{"msg_types", "array[bstring]"},
+		{"duration", "duration"},
+		{"_write_ts", "time"},
+	},
+	"dnp3": {
+		{"ts", "time"},
+		{"uid", "bstring"},
+		{"id.orig_h", "ip"},
+		{"id.orig_p", "port"},
+		{"id.resp_h", "ip"},
+		{"id.resp_p", "port"},
+		{"fc_request", "bstring"},
+		{"fc_reply", "bstring"},
+		{"iin", "uint64"},
+		{"_write_ts", "time"},
+	},
+	"dns": {
+		{"ts", "time"},
+		{"uid", "bstring"},
+		{"id.orig_h", "ip"},
+		{"id.orig_p", "port"},
+		{"id.resp_h", "ip"},
+		{"id.resp_p", "port"},
+		{"proto", "zenum"},
+		{"trans_id", "uint64"},
+		{"rtt", "duration"},
+		{"query", "bstring"},
+		{"qclass", "uint64"},
+		{"qclass_name", "bstring"},
+		{"qtype", "uint64"},
+		{"qtype_name", "bstring"},
+		{"rcode", "uint64"},
+		{"rcode_name", "bstring"},
+		{"AA", "bool"},
+		{"TC", "bool"},
+		{"RD", "bool"},
+		{"RA", "bool"},
+		{"Z", "uint64"},
+		{"answers", "array[bstring]"},
+		{"TTLs", "array[duration]"},
+		{"rejected", "bool"},
+		{"_write_ts", "time"},
+	},
+	"dpd": {
+		{"ts", "time"},
+		{"uid", "bstring"},
+		{"id.orig_h", "ip"},
+		{"id.orig_p", "port"},
+		{"id.resp_h", "ip"},
+		{"id.resp_p", "port"},
+		{"proto", "zenum"},
+		{"analyzer", "bstring"},
+		{"failure_reason", "bstring"},
+		{"_write_ts", "time"},
+	},
+	"files": {
+		{"ts", "time"},
+		{"fuid", "bstring"},
+		{"tx_hosts", "set[ip]"},
+		{"rx_hosts", "set[ip]"},
+		{"conn_uids", "set[bstring]"},
+		{"source", "bstring"},
+		{"depth", "uint64"},
+		{"analyzers", "set[bstring]"},
+		{"mime_type", "bstring"},
+		{"filename", "bstring"},
+		{"duration", "duration"},
+		{"local_orig", "bool"},
+		{"is_orig", "bool"},
+		{"seen_bytes", "uint64"},
+		{"total_bytes", "uint64"},
+		{"missing_bytes", "uint64"},
+		{"overflow_bytes", "uint64"},
+		{"timedout", "bool"},
+		{"parent_fuid", "bstring"},
+		{"md5", "bstring"},
+		{"sha1", "bstring"},
+		{"sha256", "bstring"},
+		{"extracted", "bstring"},
+		{"extracted_cutoff", "bool"},
+		{"extracted_size", "uint64"},
+		{"_write_ts", "time"},
+	},
+	"ftp": {
+		{"ts", "time"},
+		{"uid", "bstring"},
+		{"id.orig_h", "ip"},
+		{"id.orig_p", "port"},
+		{"id.resp_h", "ip"},
+		{"id.resp_p", "port"},
+		{"user", "bstring"},
+		{"password", "bstring"},
+		{"command", "bstring"},
+		{"arg", "bstring"},
+		{"mime_type", "bstring"},
+		{"file_size", "uint64"},
+		{"reply_code", "uint64"},
+		{"reply_msg", "bstring"},
+		{"data_channel.passive", "bool"},
+		{"data_channel.orig_h", "ip"},
+		{"data_channel.resp_h", "ip"},
+		{"data_channel.resp_p", "port"},
+		{"fuid", "bstring"},
+		{"_write_ts", "time"},
+	},
+	"http": {
+		{"ts", "time"},
+		{"uid", "bstring"},
+		{"id.orig_h", "ip"},
+		{"id.orig_p", "port"},
+		{"id.resp_h", "ip"},
+		{"id.resp_p", "port"},
+		{"trans_depth", "uint64"},
+		{"method", "bstring"},
+		{"host", "bstring"},
+		{"uri", "bstring"},
+		{"referrer", "bstring"},
+		{"version", "bstring"},
+		{"user_agent", "bstring"},
+		{"origin", "bstring"},
+		{"request_body_len", "uint64"},
+		{"response_body_len", "uint64"},
+		{"status_code", "uint64"},
+		{"status_msg", "bstring"},
+		{"info_code", "uint64"},
+		{"info_msg", "bstring"},
+		{"tags", "set[zenum]"},
+		{"username", "bstring"},
+		{"password", "bstring"},
+		{"proxied", "set[bstring]"},
+		{"orig_fuids", "array[bstring]"},
+		{"orig_filenames", "array[bstring]"},
+		{"orig_mime_types", "array[bstring]"},
+		{"resp_fuids", "array[bstring]"},
+		{"resp_filenames", "array[bstring]"},
+		{"resp_mime_types", "array[bstring]"},
+		{"_write_ts", "time"},
+	},
+	"intel": {
+		{"ts", "time"},
+		{"uid", "bstring"},
+		{"id.orig_h", "ip"},
+		{"id.orig_p", "port"},
+		{"id.resp_h", "ip"},
+		{"id.resp_p", "port"},
+		{"seen.indicator", "bstring"},
+		{"seen.indicator_type", "zenum"},
+		{"seen.where", "zenum"},
+		{"seen.node", "bstring"},
+		{"matched", "set[zenum]"},
+		{"sources", "set[bstring]"},
+		{"fuid", "bstring"},
+		{"file_mime_type", "bstring"},
+		{"file_desc", "bstring"},
+		{"_write_ts", "time"},
+	},
+	"irc": {
+		{"ts", "time"},
+		{"uid", "bstring"},
+		{"id.orig_h", "ip"},
+		{"id.orig_p", "port"},
+		{"id.resp_h", "ip"},
+		{"id.resp_p", "port"},
+		{"nick", "bstring"},
+		{"user", "bstring"},
+		{"command", "bstring"},
+		{"value", "bstring"},
+		{"addl", "bstring"},
+		{"dcc_file_name", "bstring"},
+		{"dcc_file_size", "uint64"},
+		{"dcc_mime_type", "bstring"},
+		{"fuid", "bstring"},
+		{"_write_ts", "time"},
+	},
+	"kerberos": {
+		{"ts", "time"},
+		{"uid", "bstring"},
+		{"id.orig_h", "ip"},
+		{"id.orig_p", "port"},
+		{"id.resp_h", "ip"},
+		{"id.resp_p", "port"},
+		{"request_type", "bstring"},
+		{"client", "bstring"},
+		{"service", "bstring"},
+		{"success", "bool"},
+		{"error_msg", "bstring"},
+		{"from", "time"},
+		{"till", "time"},
+		{"cipher", "bstring"},
+		{"forwardable", "bool"},
+		{"renewable", "bool"},
+		{"client_cert_subject", "bstring"},
+		{"client_cert_fuid", "bstring"},
+		{"server_cert_subject", "bstring"},
+		{"server_cert_fuid", "bstring"},
+		{"_write_ts", "time"},
+	},
+	"known_certs": {
+		{"ts", "time"},
+		{"host", "ip"},
+		{"port_num", "port"},
+		{"subject", "bstring"},
+		{"issuer_subject", "bstring"},
+		{"serial", "bstring"},
+		{"_write_ts", "time"},
+	},
+	"known_hosts": {
+		{"ts", "time"},
+		{"host", "ip"},
+		{"_write_ts", "time"},
+	},
+	"known_services": {
+		{"ts", "time"},
+		{"host", "ip"},
+		{"port_num", "port"},
+		{"port_proto", "zenum"},
+		{"service", "set[bstring]"},
+		{"_write_ts", "time"},
+	},
+	"loaded_scripts": {
+		{"name", "bstring"},
+		{"_write_ts", "time"},
+	},
+	"modbus": {
+		{"ts", "time"},
+		{"uid", "bstring"},
+		{"id.orig_h", "ip"},
+		{"id.orig_p", "port"},
+		{"id.resp_h", "ip"},
+		{"id.resp_p", "port"},
+		{"func", "bstring"},
+		{"exception", "bstring"},
+		{"_write_ts", "time"},
+	},
+	"mysql": {
+		{"ts", "time"},
+		{"uid", "bstring"},
+		{"id.orig_h", "ip"},
+		{"id.orig_p", "port"},
+		{"id.resp_h", "ip"},
+		{"id.resp_p", "port"},
+		{"cmd", "bstring"},
+		{"arg", "bstring"},
+		{"success", "bool"},
+		{"rows", "uint64"},
+		{"response", "bstring"},
+		{"_write_ts", "time"},
+	},
+	"netcontrol": {
+		{"ts", "time"},
+		{"rule_id", "bstring"},
+		{"category", "zenum"},
+		{"cmd", "bstring"},
+		{"state", "zenum"},
+		{"action", "bstring"},
+		{"target", "zenum"},
+		{"entity_type", "bstring"},
+		{"entity", "bstring"},
+		{"mod", "bstring"},
+		{"msg", "bstring"},
+		{"priority", "int64"},
+		{"expire", "duration"},
+		{"location", "bstring"},
+		{"plugin", "bstring"},
+		{"_write_ts", "time"},
+	},
+	"netcontrol_drop": {
+		{"ts", "time"},
+		{"rule_id", "bstring"},
+		{"orig_h", "ip"},
+		{"orig_p", "port"},
+		{"resp_h", "ip"},
+		{"resp_p", "port"},
+		{"expire", "duration"},
+		{"location", "bstring"},
+		{"_write_ts", "time"},
+	},
+	"netcontrol_shunt": {
+		{"ts", "time"},
+		{"rule_id", "bstring"},
+		{"f.src_h", "ip"},
+		{"f.src_p", "port"},
+		{"f.dst_h", "ip"},
+		{"f.dst_p", "port"},
+		{"expire", "duration"},
+		{"location", "bstring"},
+		{"_write_ts", "time"},
+	},
+	"notice": {
+		{"ts", "time"},
+		{"uid", "bstring"},
+		{"id.orig_h", "ip"},
+		{"id.orig_p", "port"},
+		{"id.resp_h", "ip"},
+		{"id.resp_p", "port"},
+		{"fuid", "bstring"},
+		{"file_mime_type", "bstring"},
+		{"file_desc", "bstring"},
+		{"proto", "zenum"},
+		{"note", "zenum"},
+		{"msg", "bstring"},
+		{"sub", "bstring"},
+		{"src", "ip"},
+		{"dst", "ip"},
+		{"p", "port"},
+		{"n", "uint64"},
+		{"peer_descr", "bstring"},
+		{"actions", "set[zenum]"},
+		{"suppress_for", "duration"},
+		{"remote_location.country_code", "bstring"},
+		{"remote_location.region", "bstring"},
+		{"remote_location.city", "bstring"},
+		{"remote_location.latitude", "float64"},
+		{"remote_location.longitude", "float64"},
+		{"_write_ts", "time"},
+	},
+	"notice_alarm": {
+		{"ts", "time"},
+		{"uid", "bstring"},
+		{"id.orig_h", "ip"},
+		{"id.orig_p", "port"},
+		{"id.resp_h", "ip"},
+		{"id.resp_p", "port"},
+		{"fuid", "bstring"},
+		{"file_mime_type", "bstring"},
+		{"file_desc", "bstring"},
+		{"proto", "zenum"},
+		{"note", "zenum"},
+		{"msg", "bstring"},
+		{"sub", "bstring"},
+		{"src", "ip"},
+		{"dst", "ip"},
+		{"p", "port"},
+		{"n", "uint64"},
+		{"peer_descr", "bstring"},
+		{"actions", "set[zenum]"},
+		{"suppress_for", "duration"},
+		{"remote_location.country_code", "bstring"},
+		{"remote_location.region", "bstring"},
+		{"remote_location.city", "bstring"},
+		{"remote_location.latitude", "float64"},
+		{"remote_location.longitude", "float64"},
+		{"_write_ts", "time"},
+	},
+	"ntlm": {
+		{"ts", "time"},
+		{"uid", "bstring"},
+		{"id.orig_h", "ip"},
+		{"id.orig_p", "port"},
+		{"id.resp_h", "ip"},
+		{"id.resp_p", "port"},
+		{"username", "bstring"},
+		{"hostname", "bstring"},
+		{"domainname", "bstring"},
+		{"server_nb_computer_name", "bstring"},
+		{"server_dns_computer_name", "bstring"},
+		{"server_tree_name", "bstring"},
+		{"success", "bool"},
+		{"_write_ts", "time"},
+	},
+	"ntp": {
+		{"ts", "time"},
+		{"uid", "bstring"},
+		{"id.orig_h", "ip"},
+		{"id.orig_p", "port"},
+		{"id.resp_h", "ip"},
+		{"id.resp_p", "port"},
+		{"version", "uint64"},
+		{"mode", "uint64"},
+		{"stratum", "uint64"},
+		{"poll", "duration"},
+		{"precision", "duration"},
+		{"root_delay", "duration"},
+		{"root_disp", "duration"},
+		{"ref_id", "bstring"},
+		{"ref_time", "time"},
+		{"org_time", "time"},
+		{"rec_time", "time"},
+		{"xmt_time", "time"},
+		{"num_exts", "uint64"},
+		{"_write_ts", "time"},
+	},
+	"packet_filter": {
+		{"ts", "time"},
+		{"node", "bstring"},
+		{"filter", "bstring"},
+		{"init", "bool"},
+		{"success", "bool"},
+		{"_write_ts", "time"},
+	},
+	"pe": {
+		{"ts", "time"},
+		{"id", "bstring"},
+		{"machine", "bstring"},
+		{"compile_ts", "time"},
+		{"os", "bstring"},
+		{"subsystem", "bstring"},
+		{"is_exe", "bool"},
+		{"is_64bit", "bool"},
+		{"uses_aslr", "bool"},
+		{"uses_dep", "bool"},
+		{"uses_code_integrity", "bool"},
+		{"uses_seh", "bool"},
+		{"has_import_table", "bool"},
+		{"has_export_table", "bool"},
+		{"has_cert_table", "bool"},
+		{"has_debug_data", "bool"},
+		{"section_names", "array[bstring]"},
+		{"_write_ts", "time"},
+	},
+	"radius": {
+		{"ts", "time"},
+		{"uid", "bstring"},
+		{"id.orig_h", "ip"},
+		{"id.orig_p", "port"},
+		{"id.resp_h", "ip"},
+		{"id.resp_p", "port"},
+		{"username", "bstring"},
+		{"mac", "bstring"},
+		{"framed_addr", "ip"},
+		{"tunnel_client", "bstring"},
+		{"connect_info", "bstring"},
+		{"reply_msg", "bstring"},
+		{"result", "bstring"},
+		{"ttl", "duration"},
+		{"_write_ts", "time"},
+	},
+	"rdp": {
+		{"ts", "time"},
+		{"uid", "bstring"},
+		{"id.orig_h", "ip"},
+		{"id.orig_p", "port"},
+		{"id.resp_h", "ip"},
+		{"id.resp_p", "port"},
+		{"cookie", "bstring"},
+		{"result", "bstring"},
+		{"security_protocol", "bstring"},
+		{"client_channels", "array[bstring]"},
+		{"keyboard_layout", "bstring"},
+		{"client_build", "bstring"},
+		{"client_name", "bstring"},
+		{"client_dig_product_id", "bstring"},
+		{"desktop_width", "uint64"},
+		{"desktop_height", "uint64"},
+		{"requested_color_depth", "bstring"},
+		{"cert_type", "bstring"},
+		{"cert_count", "uint64"},
+		{"cert_permanent", "bool"},
+		{"encryption_level", "bstring"},
+		{"encryption_method", "bstring"},
+		{"_write_ts", "time"},
+	},
+	"reporter": {
+		{"ts", "time"},
+		{"level", "zenum"},
+		{"message", "bstring"},
+		{"location", "bstring"},
+		{"_write_ts", "time"},
+	},
+	"rfb": {
+		{"ts", "time"},
+		{"uid", "bstring"},
+		{"id.orig_h", "ip"},
+		{"id.orig_p", "port"},
+		{"id.resp_h", "ip"},
+		{"id.resp_p", "port"},
+		{"client_major_version", "bstring"},
+		{"client_minor_version", "bstring"},
+		{"server_major_version", "bstring"},
+		{"server_minor_version", "bstring"},
+		{"authentication_method", "bstring"},
+		{"auth", "bool"},
+		{"share_flag", "bool"},
+		{"desktop_name", "bstring"},
+		{"width", "uint64"},
+		{"height", "uint64"},
+		{"_write_ts", "time"},
+	},
+	"signatures": {
+		{"ts", "time"},
+		{"uid", "bstring"},
+		{"src_addr", "ip"},
+		{"src_port", "port"},
+		{"dst_addr", "ip"},
+		{"dst_port", "port"},
+		{"note", "zenum"},
+		{"sig_id", "bstring"},
+		{"event_msg", "bstring"},
+		{"sub_msg", "bstring"},
+		{"sig_count", "uint64"},
+		{"host_count", "uint64"},
+		{"_write_ts", "time"},
+	},
+	"sip": {
+		{"ts", "time"},
+		{"uid", "bstring"},
+		{"id.orig_h", "ip"},
+		{"id.orig_p", "port"},
+		{"id.resp_h", "ip"},
+		{"id.resp_p", "port"},
+		{"trans_depth", "uint64"},
+		{"method", "bstring"},
+		{"uri", "bstring"},
+		{"date", "bstring"},
+		{"request_from", "bstring"},
+		{"request_to", "bstring"},
+		{"response_from", "bstring"},
+		{"response_to", "bstring"},
+		{"reply_to", "bstring"},
+		{"call_id", "bstring"},
+		{"seq", "bstring"},
+		{"subject", "bstring"},
+		{"request_path", "array[bstring]"},
+		{"response_path", "array[bstring]"},
+		{"user_agent", "bstring"},
+		{"status_code", "uint64"},
+		{"status_msg", "bstring"},
+		{"warning", "bstring"},
+		{"request_body_len", "uint64"},
+		{"response_body_len", "uint64"},
+		{"content_type", "bstring"},
+		{"_write_ts", "time"},
+	},
+	"smb_files": {
+		{"ts", "time"},
+		{"uid", "bstring"},
+		{"id.orig_h", "ip"},
+		{"id.orig_p", "port"},
+		{"id.resp_h", "ip"},
+		{"id.resp_p", "port"},
+		{"fuid", "bstring"},
+		{"action", "zenum"},
+		{"path", "bstring"},
+		{"name", "bstring"},
+		{"size", "uint64"},
+		{"prev_name", "bstring"},
+		{"times.modified", "time"},
+		{"times.accessed", "time"},
+		{"times.created", "time"},
+		{"times.changed", "time"},
+		{"_write_ts", "time"},
+	},
+	"smb_mapping": {
+		{"ts", "time"},
+		{"uid", "bstring"},
+		{"id.orig_h", "ip"},
+		{"id.orig_p", "port"},
+		{"id.resp_h", "ip"},
+		{"id.resp_p", "port"},
+		{"path", "bstring"},
+		{"service", "bstring"},
+		{"native_file_system", "bstring"},
+		{"share_type", "bstring"},
+		{"_write_ts", "time"},
+	},
+	"smtp": {
+		{"ts", "time"},
+		{"uid", "bstring"},
+		{"id.orig_h", "ip"},
+		{"id.orig_p", "port"},
+		{"id.resp_h", "ip"},
+		{"id.resp_p", "port"},
+		{"trans_depth", "uint64"},
+		{"helo", "bstring"},
+		{"mailfrom", "bstring"},
+		{"rcptto", "set[bstring]"},
+		{"date", "bstring"},
+		{"from", "bstring"},
+		{"to", "set[bstring]"},
+		{"cc", "set[bstring]"},
+		{"reply_to", "bstring"},
+		{"msg_id", "bstring"},
+		{"in_reply_to", "bstring"},
+		{"subject", "bstring"},
+		{"x_originating_ip", "ip"},
+		{"first_received", "bstring"},
+		{"second_received", "bstring"},
+		{"last_reply", "bstring"},
+		{"path", "array[ip]"},
+		{"user_agent", "bstring"},
+		{"tls", "bool"},
+		{"fuids", "array[bstring]"},
+		{"is_webmail", "bool"},
+		{"_write_ts", "time"},
+	},
+	"snmp": {
+		{"ts", "time"},
+		{"uid", "bstring"},
+		{"id.orig_h", "ip"},
+		{"id.orig_p", "port"},
+		{"id.resp_h", "ip"},
+		{"id.resp_p", "port"},
+		{"duration", "duration"},
+		{"version", "bstring"},
+		{"community", "bstring"},
+		{"get_requests", "uint64"},
+		{"get_bulk_requests", "uint64"},
+		{"get_responses", "uint64"},
+		{"set_requests", "uint64"},
+		{"display_string", "bstring"},
+		{"up_since", "time"},
+		{"_write_ts", "time"},
+	},
+	"socks": {
+		{"ts", "time"},
+		{"uid", "bstring"},
+		{"id.orig_h", "ip"},
+		{"id.orig_p", "port"},
+		{"id.resp_h", "ip"},
+		{"id.resp_p", "port"},
+		{"version", "uint64"},
+		{"user", "bstring"},
+		{"password", "bstring"},
+		{"status", "bstring"},
+		{"request.host", "ip"},
+		{"request.name", "bstring"},
+		{"request_p", "port"},
+		{"bound.host", "ip"},
+		{"bound.name", "bstring"},
+		{"bound_p", "port"},
+		{"_write_ts", "time"},
+	},
+	"software": {
+		{"ts", "time"},
+		{"host", "ip"},
+		{"host_p", "port"},
+		{"software_type", "zenum"},
+		{"name", "bstring"},
+		{"version.major", "uint64"},
+		{"version.minor", "uint64"},
+		{"version.minor2", "uint64"},
+		{"version.minor3", "uint64"},
+		{"version.addl", "bstring"},
+		{"unparsed_version", "bstring"},
+		{"_write_ts", "time"},
+	},
+	"ssh": {
+		{"ts", "time"},
+		{"uid", "bstring"},
+		{"id.orig_h", "ip"},
+		{"id.orig_p", "port"},
+		{"id.resp_h", "ip"},
+		{"id.resp_p", "port"},
+		{"version", "uint64"},
+		{"auth_success", "bool"},
+		{"auth_attempts", "uint64"},
+		{"direction", "zenum"},
+		{"client", "bstring"},
+		{"server", "bstring"},
+		{"cipher_alg", "bstring"},
+		{"mac_alg", "bstring"},
+		{"compression_alg", "bstring"},
+		{"kex_alg", "bstring"},
+		{"host_key_alg", "bstring"},
+		{"host_key", "bstring"},
+		{"remote_location.country_code", "bstring"},
+		{"remote_location.region", "bstring"},
+		{"remote_location.city", "bstring"},
+		{"remote_location.latitude", "float64"},
+		{"remote_location.longitude", "float64"},
+		{"_write_ts", "time"},
+	},
+	"ssl": {
+		{"ts", "time"},
+		{"uid", "bstring"},
+		{"id.orig_h", "ip"},
+		{"id.orig_p", "port"},
+		{"id.resp_h", "ip"},
+		{"id.resp_p", "port"},
+		{"version", "bstring"},
+		{"cipher", "bstring"},
+		{"curve", "bstring"},
+		{"server_name", "bstring"},
+		{"resumed", "bool"},
+		{"last_alert", "bstring"},
+		{"next_protocol", "bstring"},
+		{"established", "bool"},
+		{"cert_chain_fuids", "array[bstring]"},
+		{"client_cert_chain_fuids", "array[bstring]"},
+		{"subject", "bstring"},
+		{"issuer", "bstring"},
+		{"client_subject", "bstring"},
+		{"client_issuer", "bstring"},
+		{"validation_status", "bstring"},
+		{"_write_ts", "time"},
+	},
+	"stats": {
+		{"ts", "time"},
+		{"peer", "bstring"},
+		{"mem", "uint64"},
+		{"pkts_proc", "uint64"},
+		{"bytes_recv", "uint64"},
+		{"pkts_dropped", "uint64"},
+		{"pkts_link", "uint64"},
+		{"pkt_lag", "duration"},
+		{"events_proc", "uint64"},
+		{"events_queued", "uint64"},
+		{"active_tcp_conns", "uint64"},
+		{"active_udp_conns", "uint64"},
+		{"active_icmp_conns", "uint64"},
+		{"tcp_conns", "uint64"},
+		{"udp_conns", "uint64"},
+		{"icmp_conns", "uint64"},
+		{"timers", "uint64"},
+		{"active_timers", "uint64"},
+		{"files", "uint64"},
+		{"active_files", "uint64"},
+		{"dns_requests", "uint64"},
+		{"active_dns_requests", "uint64"},
+		{"reassem_tcp_size", "uint64"},
+		{"reassem_file_size", "uint64"},
+		{"reassem_frag_size", "uint64"},
+		{"reassem_unknown_size", "uint64"},
+		{"_write_ts", "time"},
+	},
+	"syslog": {
+		{"ts", "time"},
+		{"uid", "bstring"},
+		{"id.orig_h", "ip"},
+		{"id.orig_p", "port"},
+		{"id.resp_h", "ip"},
+		{"id.resp_p", "port"},
+		{"proto", "zenum"},
+		{"facility", "bstring"},
+		{"severity", "bstring"},
+		{"message", "bstring"},
+		{"_write_ts", "time"},
+	},
+	"tunnel": {
+		{"ts", "time"},
+		{"uid", "bstring"},
+		{"id.orig_h", "ip"},
+		{"id.orig_p", "port"},
+		{"id.resp_h", "ip"},
+		{"id.resp_p", "port"},
+		{"tunnel_type", "zenum"},
+		{"action", "zenum"},
+		{"_write_ts", "time"},
+	},
+	"weird": {
+		{"ts", "time"},
+		{"uid", "bstring"},
+		{"id.orig_h", "ip"},
+		{"id.orig_p", "port"},
+		{"id.resp_h", "ip"},
+		{"id.resp_p", "port"},
+		{"name", "bstring"},
+		{"addl", "bstring"},
+		{"notice", "bool"},
+		{"peer", "bstring"},
+		{"_write_ts", "time"},
+	},
+	"x509": {
+		{"ts", "time"},
+		{"id", "bstring"},
+		{"certificate.version", "uint64"},
+		{"certificate.serial", "bstring"},
+		{"certificate.subject", "bstring"},
+		{"certificate.issuer", "bstring"},
+		{"certificate.not_valid_before", "time"},
+		{"certificate.not_valid_after", "time"},
+		{"certificate.key_alg", "bstring"},
+		{"certificate.sig_alg", "bstring"},
+		{"certificate.key_type", "bstring"},
+		{"certificate.key_length", "uint64"},
+		{"certificate.exponent", "bstring"},
+		{"certificate.curve", "bstring"},
+		{"san.dns", "array[bstring]"},
+		{"san.uri", "array[bstring]"},
+		{"san.email", "array[bstring]"},
+		{"san.ip", "array[ip]"},
+		{"basic_constraints.ca", "bool"},
+		{"basic_constraints.path_len", "uint64"},
+		{"_write_ts", "time"},
+	},
+}
@@ -0,0 +1,105 @@
+// +build ignore
+
+// This program generates logs.go from the log descriptors in
+// zeek/types.json.  Run it with "go generate".
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"sort"
+)
+
+type column struct {
+	Name string          `json:"name"`
+	Type json.RawMessage `json:"type"`
+}
+
+type rule struct {
+	Descriptor string `json:"descriptor"`
+	Name       string `json:"name"`
+	Value      string `json:"value"`
+}
+
+type typeConfig struct {
+	Descriptors map[string][]column `json:"descriptors"`
+	Rules       []rule              `json:"rules"`
+}
+
+// flatten appends the fields of cols to out with the names of the fields
+// of nested records joined by dots, as in the header of a Zeek log.
+func flatten(out [][2]string, prefix string, cols []column) ([][2]string, error) {
+	for _, c := range cols {
+		var typ string
+		if err := json.Unmarshal(c.Type, &typ); err == nil {
+			out = append(out, [2]string{prefix + c.Name, typ})
+			continue
+		}
+		var inner []column
+		if err := json.Unmarshal(c.Type, &inner); err != nil {
+			return nil, fmt.Errorf("field %s: %w", prefix+c.Name, err)
+		}
+		var err error
+		out, err = flatten(out, prefix+c.Name+".", inner)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+func main() {
+	b, err := ioutil.ReadFile("../../zeek/types.json")
+	if err != nil {
+		log.Fatal(err)
+	}
+	var tc typeConfig
+	if err := json.Unmarshal(b, &tc); err != nil {
+		log.Fatal(err)
+	}
+	sort.Slice(tc.Rules, func(i, j int) bool {
+		return tc.Rules[i].Value < tc.Rules[j].Value
+	})
+	var out bytes.Buffer
+	out.WriteString(`// Code generated by mklogs.go from zeek/types.json. DO NOT EDIT.
+
+package zeekio
+
+// logFields holds the fields of each log written by a default installation
+// of Zeek, keyed by _path.  The _path field is not included since every
+// log has one.
+var logFields = map[string][]logField{
+`)
+	for _, r := range tc.Rules {
+		if r.Name != "_path" {
+			log.Fatalf("rule for descriptor %s: unexpected field %s", r.Descriptor, r.Name)
+		}
+		cols, ok := tc.Descriptors[r.Descriptor]
+		if !ok {
+			log.Fatalf("no such descriptor: %s", r.Descriptor)
+		}
+		fields, err := flatten(nil, "", cols)
+		if err != nil {
+			log.Fatalf("descriptor %s: %s", r.Descriptor, err)
+		}
+		fmt.Fprintf(&out, "%q: {\n", r.Value)
+		for _, f := range fields {
+			if f[0] != "_path" {
+				fmt.Fprintf(&out, "{%q, %q},\n", f[0], f[1])
+			}
+		}
+		out.WriteString("},\n")
+	}
+	out.WriteString("}\n")
+	src, err := format.Source(out.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile("logs.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
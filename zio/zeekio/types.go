@@ -3,6 +3,7 @@ package zeekio
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/brimsec/zq/zng"
@@ -91,3 +92,49 @@ func zngTypeToZeek(typ zng.Type) (string, error) {
 		return "", fmt.Errorf("type %s: %w", typ, ErrIncompatibleZeekType)
 	}
 }
+
+//go:generate go run mklogs.go
+
+// logField is a field of a Zeek log as described in zeek/types.json, where
+// typ is the name of a ZNG type and name is dotted for nested fields.
+type logField struct {
+	name string
+	typ  string
+}
+
+// LogPaths returns the _path of each log whose type is known to LogType.
+func LogPaths() []string {
+	paths := make([]string, 0, len(logFields))
+	for path := range logFields {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// LogType returns the type of the records in the log with the given _path
+// as written by a default installation of Zeek, or nil if the log isn't
+// known.  As when reading a Zeek log, the type has a leading _path field
+// and fields with dotted names are unflattened into nested records.
+func LogType(zctx *resolver.Context, path string) (*zng.TypeRecord, error) {
+	fields, ok := logFields[path]
+	if !ok {
+		return nil, nil
+	}
+	if _, err := zctx.LookupTypeAlias("zenum", zng.TypeString); err != nil {
+		return nil, err
+	}
+	cols := make([]zng.Column, 0, len(fields))
+	for _, f := range fields {
+		typ, err := zctx.LookupByName(f.typ)
+		if err != nil {
+			return nil, fmt.Errorf("%s log: field %s: %w", path, f.name, err)
+		}
+		cols = append(cols, zng.NewColumn(f.name, typ))
+	}
+	cols, _, err := Unflatten(zctx, cols, true)
+	if err != nil {
+		return nil, err
+	}
+	return zctx.LookupTypeRecord(cols)
+}
@@ -0,0 +1,25 @@
+package zeekio
+
+import (
+	"testing"
+
+	"github.com/brimsec/zq/zng/resolver"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogType(t *testing.T) {
+	zctx := resolver.NewContext()
+	for _, path := range LogPaths() {
+		typ, err := LogType(zctx, path)
+		require.NoError(t, err, path)
+		require.NotNil(t, typ, path)
+		require.Equal(t, "_path", typ.Columns[0].Name, path)
+	}
+	typ, err := LogType(zctx, "conn")
+	require.NoError(t, err)
+	expected := "record[_path:string,ts:time,uid:bstring,id:record[orig_h:ip,orig_p:port,resp_h:ip,resp_p:port],proto:zenum,service:bstring,duration:duration,orig_bytes:uint64,resp_bytes:uint64,conn_state:bstring,local_orig:bool,local_resp:bool,missed_bytes:uint64,history:bstring,orig_pkts:uint64,orig_ip_bytes:uint64,resp_pkts:uint64,resp_ip_bytes:uint64,tunnel_parents:set[bstring],_write_ts:time]"
+	require.Equal(t, expected, typ.String())
+	typ, err = LogType(zctx, "nosuchlog")
+	require.NoError(t, err)
+	require.Nil(t, typ)
+}
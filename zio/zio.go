@@ -14,7 +14,7 @@ type ReaderFlags struct {
 }
 
 func (f *ReaderFlags) SetFlags(fs *flag.FlagSet) {
-	fs.StringVar(&f.Format, "i", "auto", "format of input data [auto,zng,ndjson,zeek,zjson,tzng,parquet]")
+	fs.StringVar(&f.Format, "i", "auto", "format of input data [auto,zng,ndjson,zeek,zeekjson,eve,zjson,tzng,parquet,syslog,csv,tsv]")
 }
 
 // WriterFlags has the union of the flags accepted by all the different
@@ -29,7 +29,7 @@ type WriterFlags struct {
 }
 
 func (f *WriterFlags) SetFlags(fs *flag.FlagSet) {
-	fs.StringVar(&f.Format, "f", "zng", "format for output data [zng,ndjson,table,text,types,zeek,zjson,tzng]")
+	fs.StringVar(&f.Format, "f", "zng", "format for output data [zng,ndjson,parquet,csv,tsv,table,text,types,zeek,zjson,tzng]")
 	fs.BoolVar(&f.ShowTypes, "T", false, "display field types in text output")
 	fs.BoolVar(&f.ShowFields, "F", false, "display field names in text output")
 	fs.BoolVar(&f.EpochDates, "E", false, "display epoch timestamps in text output")
@@ -49,6 +49,22 @@ func NewWriter(writer zbuf.WriteFlusher, closer io.Closer) *Writer {
 	}
 }
 
+// A MixedTyper is a writer that can report whether its output can hold
+// records of more than one type.  Writers that don't implement MixedTyper
+// are assumed to handle any mix of types.
+type MixedTyper interface {
+	MixedTypes() bool
+}
+
+// MixedTypes returns true if records of different types may be written to
+// w's output.
+func (w *Writer) MixedTypes() bool {
+	if m, ok := w.WriteFlusher.(MixedTyper); ok {
+		return m.MixedTypes()
+	}
+	return true
+}
+
 func (w *Writer) Close() error {
 	err := w.Flush()
 	cerr := w.Closer.Close()
@@ -74,6 +90,12 @@ func Extension(format string) string {
 		return ".tbl"
 	case "zng":
 		return ".zng"
+	case "parquet":
+		return ".parquet"
+	case "csv":
+		return ".csv"
+	case "tsv":
+		return ".tsv"
 	default:
 		return ""
 	}
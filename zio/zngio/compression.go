@@ -0,0 +1,6 @@
+package zngio
+
+// CompressionFormat identifies the algorithm of a compressed frame.
+type CompressionFormat int
+
+const CompressionFormatLZ4 CompressionFormat = 0x00
@@ -0,0 +1,85 @@
+package zngio
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/brimsec/zq/zio"
+	"github.com/brimsec/zq/zio/tzngio"
+	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
+	"github.com/stretchr/testify/require"
+)
+
+func writeTzng(t *testing.T, tzng string, flags zio.WriterFlags, lz4BlockSize int) []byte {
+	reader := tzngio.NewReader(strings.NewReader(tzng), resolver.NewContext())
+	var buf bytes.Buffer
+	writer := NewCompressedWriter(&buf, flags, lz4BlockSize)
+	for {
+		rec, err := reader.Read()
+		require.NoError(t, err)
+		if rec == nil {
+			break
+		}
+		require.NoError(t, writer.Write(rec))
+	}
+	require.NoError(t, writer.Flush())
+	return buf.Bytes()
+}
+
+func readTzng(t *testing.T, r *Reader) string {
+	var out bytes.Buffer
+	writer := tzngio.NewWriter(&out)
+	for {
+		rec, err := r.Read()
+		require.NoError(t, err)
+		if rec == nil {
+			break
+		}
+		require.NoError(t, writer.Write(rec))
+	}
+	return out.String()
+}
+
+func TestCompression(t *testing.T) {
+	var lines []string
+	for i := 0; i < 1000; i++ {
+		lines = append(lines, fmt.Sprintf("0:[%d;value-%d;%d;]", 1586886160+i, i%10, i))
+	}
+	tzng := "#0:record[ts:time,s:string,v:int64]\n" + strings.Join(lines, "\n") + "\n"
+
+	flags := zio.WriterFlags{StreamRecordsMax: 100}
+	uncompressed := writeTzng(t, tzng, flags, 0)
+	compressed := writeTzng(t, tzng, flags, 1024)
+	require.Less(t, len(compressed), len(uncompressed))
+	require.Equal(t, byte(zng.CtrlCompressed), compressed[0])
+
+	r := NewReader(bytes.NewReader(compressed), resolver.NewContext())
+	require.Equal(t, tzng, readTzng(t, r))
+
+	// Record the first record of each stream then verify that seeking
+	// to the start of each stream finds the same record.
+	r = NewReader(bytes.NewReader(compressed), resolver.NewContext())
+	sos := make(map[int64]string)
+	for {
+		rec, err := r.Read()
+		require.NoError(t, err)
+		if rec == nil {
+			break
+		}
+		if _, ok := sos[r.LastSOS()]; !ok {
+			sos[r.LastSOS()] = rec.String()
+		}
+	}
+	require.Len(t, sos, 10)
+	seeker := NewSeeker(bytes.NewReader(compressed), resolver.NewContext())
+	for off, expected := range sos {
+		_, err := seeker.Seek(off)
+		require.NoError(t, err)
+		rec, err := seeker.Read()
+		require.NoError(t, err)
+		require.Equal(t, expected, rec.String())
+	}
+}
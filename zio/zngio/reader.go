@@ -10,6 +10,7 @@ import (
 	"github.com/brimsec/zq/pkg/peeker"
 	"github.com/brimsec/zq/zng"
 	"github.com/brimsec/zq/zng/resolver"
+	"github.com/pierrec/lz4"
 )
 
 const (
@@ -27,6 +28,9 @@ type Reader struct {
 	mapper   *resolver.Mapper
 	position int64
 	sos      int64
+	// frame holds the unread messages of the current compressed frame.
+	frame []byte
+	ubuf  []byte
 }
 
 func NewReader(reader io.Reader, sctx *resolver.Context) *Reader {
@@ -43,11 +47,33 @@ func NewReaderWithSize(reader io.Reader, sctx *resolver.Context, size int) *Read
 }
 
 func (r *Reader) read(n int) ([]byte, error) {
+	if r.frame != nil {
+		if n > len(r.frame) {
+			// Messages may not span frames.
+			return nil, zng.ErrBadFormat
+		}
+		b := r.frame[:n]
+		r.frame = r.frame[n:]
+		if len(r.frame) == 0 {
+			r.frame = nil
+		}
+		return b, nil
+	}
 	b, err := r.peeker.Read(n)
 	r.position += int64(len(b))
 	return b, err
 }
 
+func (r *Reader) peek(n int) ([]byte, error) {
+	if r.frame != nil {
+		if n > len(r.frame) {
+			return r.frame, peeker.ErrTruncated
+		}
+		return r.frame[:n], nil
+	}
+	return r.peeker.Peek(n)
+}
+
 func (r *Reader) Position() int64 {
 	return r.position
 }
@@ -96,6 +122,7 @@ func (r *Reader) LastSOS() int64 {
 }
 
 func (r *Reader) reset() {
+	r.frame = nil
 	r.zctx.Reset()
 	r.mapper = resolver.NewMapper(r.sctx)
 	r.sos = r.position
@@ -130,6 +157,8 @@ again:
 			err = r.readTypeAlias()
 		case zng.CtrlEOS:
 			r.reset()
+		case zng.CtrlCompressed:
+			err = r.readCompressed()
 		default:
 			// XXX we should return the control code
 			len, err := r.readUvarint()
@@ -171,8 +200,55 @@ again:
 	return rec, nil, nil
 }
 
+// readCompressed decompresses a frame whose messages are then read in
+// place of the underlying input.  Positions in the input, including
+// start-of-stream positions, are unaffected by the frames.
+func (r *Reader) readCompressed() error {
+	if r.frame != nil {
+		return errors.New("zng compressed frame inside compressed frame")
+	}
+	b, err := r.read(1)
+	if err != nil {
+		return zng.ErrBadFormat
+	}
+	format := CompressionFormat(b[0])
+	if format != CompressionFormatLZ4 {
+		return fmt.Errorf("zng compression format %d not supported", format)
+	}
+	ulen, err := r.readUvarint()
+	if err != nil {
+		return zng.ErrBadFormat
+	}
+	zlen, err := r.readUvarint()
+	if err != nil {
+		return zng.ErrBadFormat
+	}
+	if ulen > MaxSize {
+		return peeker.ErrBufferOverflow
+	}
+	zbuf, err := r.read(zlen)
+	if err != nil {
+		return zng.ErrBadFormat
+	}
+	if cap(r.ubuf) < ulen {
+		r.ubuf = make([]byte, ulen)
+	}
+	ubuf := r.ubuf[:ulen]
+	n, err := lz4.UncompressBlock(zbuf, ubuf)
+	if err != nil {
+		return err
+	}
+	if n != ulen {
+		return fmt.Errorf("zng compressed frame: got %d uncompressed bytes, expected %d", n, ulen)
+	}
+	if n > 0 {
+		r.frame = ubuf
+	}
+	return nil
+}
+
 func (r *Reader) readUvarint() (int, error) {
-	b, err := r.peeker.Peek(binary.MaxVarintLen64)
+	b, err := r.peek(binary.MaxVarintLen64)
 	if err != nil && err != io.EOF && err != peeker.ErrTruncated {
 		return 0, zng.ErrBadFormat
 	}
@@ -7,6 +7,7 @@ import (
 	"github.com/brimsec/zq/zio"
 	"github.com/brimsec/zq/zng"
 	"github.com/brimsec/zq/zng/resolver"
+	"github.com/pierrec/lz4"
 )
 
 type Writer struct {
@@ -16,33 +17,95 @@ type Writer struct {
 	streamRecords    int
 	streamRecordsMax int
 	position         int64
+
+	// When lz4BlockSize is positive, messages are buffered in ubuf and
+	// written as LZ4-compressed frames of about lz4BlockSize bytes.
+	lz4BlockSize int
+	ubuf         []byte
+	zbuf         []byte
 }
 
 func NewWriter(w io.Writer, flags zio.WriterFlags) *Writer {
+	return NewCompressedWriter(w, flags, 0)
+}
+
+// NewCompressedWriter returns a Writer that compresses its output into LZ4
+// frames of about lz4BlockSize bytes.  An lz4BlockSize of 0 disables
+// compression.
+func NewCompressedWriter(w io.Writer, flags zio.WriterFlags, lz4BlockSize int) *Writer {
 	return &Writer{
 		Writer:           w,
 		encoder:          resolver.NewEncoder(),
 		buffer:           make([]byte, 0, 128),
 		streamRecordsMax: flags.StreamRecordsMax,
+		lz4BlockSize:     lz4BlockSize,
 	}
 }
 
 func (w *Writer) write(b []byte) error {
+	if w.lz4BlockSize > 0 {
+		w.ubuf = append(w.ubuf, b...)
+		return nil
+	}
+	return w.writeUncompressed(b)
+}
+
+func (w *Writer) writeUncompressed(b []byte) error {
 	n, err := w.Writer.Write(b)
 	w.position += int64(n)
 	return err
 }
 
+// Position returns the offset in the output of the next message.  When
+// compressing, messages are buffered until a frame is written, so the
+// offset is exact only at stream boundaries, e.g., after EndStream.
 func (w *Writer) Position() int64 {
-	return w.position
+	return w.position + int64(len(w.ubuf))
 }
 
 func (w *Writer) EndStream() error {
+	if err := w.writeCompressed(); err != nil {
+		return err
+	}
 	w.encoder.Reset()
 	w.streamRecords = 0
 
 	marker := []byte{zng.CtrlEOS}
-	return w.write(marker)
+	return w.writeUncompressed(marker)
+}
+
+// writeCompressed writes any buffered messages as a compressed frame.
+// Frames always hold whole messages and never span streams so stream
+// boundaries are the same as they would be without compression.
+func (w *Writer) writeCompressed() error {
+	if len(w.ubuf) == 0 {
+		return nil
+	}
+	if n := lz4.CompressBlockBound(len(w.ubuf)); cap(w.zbuf) < n {
+		w.zbuf = make([]byte, n)
+	}
+	zbuf := w.zbuf[:cap(w.zbuf)]
+	// The high compression variant is used since it compresses ZNG
+	// noticeably better and archive data is written once and read many
+	// times.
+	n, err := lz4.CompressBlockHC(w.ubuf, zbuf, 0)
+	if err != nil {
+		return err
+	}
+	if n == 0 || n >= len(w.ubuf) {
+		// The messages aren't compressible so write them as is.
+		err = w.writeUncompressed(w.ubuf)
+	} else {
+		hdr := append(w.buffer[:0], zng.CtrlCompressed, byte(CompressionFormatLZ4))
+		hdr = zcode.AppendUvarint(hdr, uint64(len(w.ubuf)))
+		hdr = zcode.AppendUvarint(hdr, uint64(n))
+		w.buffer = hdr
+		if err = w.writeUncompressed(hdr); err == nil {
+			err = w.writeUncompressed(zbuf[:n])
+		}
+	}
+	w.ubuf = w.ubuf[:0]
+	return err
 }
 
 func (w *Writer) Write(r *zng.Record) error {
@@ -80,26 +143,35 @@ func (w *Writer) Write(r *zng.Record) error {
 	w.streamRecords++
 	if w.streamRecordsMax > 0 && w.streamRecords >= w.streamRecordsMax {
 		w.EndStream()
+	} else if w.lz4BlockSize > 0 && len(w.ubuf) >= w.lz4BlockSize {
+		if err := w.writeCompressed(); err != nil {
+			return err
+		}
 	}
 
 	return err
 }
 
 func (w *Writer) WriteControl(b []byte) error {
+	// Control payloads aren't compressed but they must follow any
+	// buffered messages.
+	if err := w.writeCompressed(); err != nil {
+		return err
+	}
 	dst := w.buffer[:0]
 	//XXX 0xff for now.  need to pass through control codes?
 	dst = append(dst, 0xff)
 	dst = zcode.AppendUvarint(dst, uint64(len(b)))
-	err := w.write(dst)
+	err := w.writeUncompressed(dst)
 	if err != nil {
 		return err
 	}
-	return w.write(b)
+	return w.writeUncompressed(b)
 }
 
 func (w *Writer) Flush() error {
 	if w.streamRecords > 0 {
 		return w.EndStream()
 	}
-	return nil
+	return w.writeCompressed()
 }
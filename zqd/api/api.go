@@ -26,6 +26,11 @@ func (e Error) Error() string {
 	return e.Message
 }
 
+// ErrorTrailer is the HTTP trailer holding the JSON-encoded Error that
+// ended a search whose response format has no place for a TaskEnd
+// message, such as csv or zeek.
+const ErrorTrailer = "Zq-Error"
+
 type TaskStart struct {
 	Type   string `json:"type"`
 	TaskID int64  `json:"task_id"`
@@ -68,6 +73,30 @@ type SearchStats struct {
 	ScannerStats
 }
 
+// SearchCacheStats describes the contents and use of the zqd search
+// result cache.
+type SearchCacheStats struct {
+	Entries       int   `json:"entries"`
+	Bytes         int64 `json:"bytes"`
+	MaxBytes      int64 `json:"max_bytes"`
+	Hits          int64 `json:"hits"`
+	Misses        int64 `json:"misses"`
+	Evictions     int64 `json:"evictions"`
+	Invalidations int64 `json:"invalidations"`
+}
+
+// TaskInfo describes a running search task.  TaskID is the ID sent in the
+// TaskStart and TaskEnd messages of the task's response stream.
+type TaskInfo struct {
+	TaskID     int64           `json:"task_id"`
+	Kind       string          `json:"kind"`
+	Space      SpaceID         `json:"space"`
+	Proc       json.RawMessage `json:"proc"`
+	StartTime  nano.Ts         `json:"start_time"`
+	UpdateTime nano.Ts         `json:"update_time"`
+	ScannerStats
+}
+
 type ScannerStats struct {
 	BytesRead      int64 `json:"bytes_read"`
 	BytesMatched   int64 `json:"bytes_matched"`
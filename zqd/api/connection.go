@@ -29,6 +29,8 @@ var (
 	ErrSpaceNotFound = errors.New("space not found")
 	// ErrSpaceExists returns when specified the space already exists.
 	ErrSpaceExists = errors.New("space exists")
+	// ErrTaskNotFound returns when specified task is not running.
+	ErrTaskNotFound = errors.New("task not found")
 	// ErrNoPcapResultsFound returns when a pcap search yields no results
 	ErrNoPcapResultsFound = errors.New("no pcap results found for search")
 )
@@ -89,6 +91,9 @@ func (c *Connection) stream(req *resty.Request) (io.ReadCloser, error) {
 	}
 	r := resp.RawBody()
 	if resp.IsSuccess() {
+		if _, ok := resp.RawResponse.Trailer[ErrorTrailer]; ok {
+			return &trailerReader{r, resp.RawResponse}, nil
+		}
 		return r, nil
 	}
 	defer r.Close()
@@ -109,6 +114,27 @@ func (c *Connection) stream(req *resty.Request) (io.ReadCloser, error) {
 	return nil, resErr
 }
 
+// trailerReader reads a response body and returns the error reported in
+// its ErrorTrailer, if any, in place of io.EOF.
+type trailerReader struct {
+	io.ReadCloser
+	resp *http.Response
+}
+
+func (t *trailerReader) Read(b []byte) (int, error) {
+	n, err := t.ReadCloser.Read(b)
+	if err == io.EOF {
+		if v := t.resp.Trailer.Get(ErrorTrailer); v != "" {
+			var apierr Error
+			if err := json.Unmarshal([]byte(v), &apierr); err != nil {
+				return n, errors.New(v)
+			}
+			return n, &apierr
+		}
+	}
+	return n, err
+}
+
 // SetTimeout sets the underlying http request timeout to the given duration
 func (c *Connection) SetTimeout(to time.Duration) {
 	c.client.SetTimeout(to)
@@ -224,6 +250,50 @@ func (c *Connection) Search(ctx context.Context, search SearchRequest, params ma
 	return NewZngSearch(r), nil
 }
 
+// SearchCacheStats returns the stats of the search result cache.
+func (c *Connection) SearchCacheStats(ctx context.Context) (*SearchCacheStats, error) {
+	resp, err := c.Request(ctx).
+		SetResult(&SearchCacheStats{}).
+		Get("/search/cache")
+	if err != nil {
+		return nil, err
+	}
+	return resp.Result().(*SearchCacheStats), nil
+}
+
+// TaskList returns the search tasks currently running on the server.
+func (c *Connection) TaskList(ctx context.Context) ([]TaskInfo, error) {
+	var res []TaskInfo
+	_, err := c.Request(ctx).
+		SetResult(&res).
+		Get("/task")
+	return res, err
+}
+
+func (c *Connection) TaskInfo(ctx context.Context, id int64) (*TaskInfo, error) {
+	path := path.Join("/task", strconv.FormatInt(id, 10))
+	resp, err := c.Request(ctx).
+		SetResult(&TaskInfo{}).
+		Get(path)
+	if err != nil {
+		if r, ok := err.(*ErrorResponse); ok && r.StatusCode() == http.StatusNotFound {
+			return nil, ErrTaskNotFound
+		}
+		return nil, err
+	}
+	return resp.Result().(*TaskInfo), nil
+}
+
+// TaskCancel cancels the running task with the given ID.
+func (c *Connection) TaskCancel(ctx context.Context, id int64) error {
+	path := path.Join("/task", strconv.FormatInt(id, 10))
+	_, err := c.Request(ctx).Delete(path)
+	if r, ok := err.(*ErrorResponse); ok && r.StatusCode() == http.StatusNotFound {
+		return ErrTaskNotFound
+	}
+	return err
+}
+
 func (c *Connection) IndexSearch(ctx context.Context, space SpaceID, search IndexSearchRequest, params map[string]string) (Search, error) {
 	req := c.Request(ctx).
 		SetBody(search).
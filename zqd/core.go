@@ -1,9 +1,12 @@
 package zqd
 
 import (
+	"io/ioutil"
 	"net/http"
+	"runtime"
 	"sync/atomic"
 
+	"github.com/brimsec/zq/zqd/search"
 	"github.com/brimsec/zq/zqd/space"
 	"github.com/brimsec/zq/zqd/zeek"
 	"go.uber.org/zap"
@@ -13,7 +16,17 @@ type Config struct {
 	Root string
 	// ZeekLauncher is the interface for launching zeek processes.
 	ZeekLauncher zeek.Launcher
-	Logger       *zap.Logger
+	// SuricataLauncher, if not nil, is the interface for launching
+	// suricata processes alongside zeek for pcap posts.
+	SuricataLauncher zeek.Launcher
+	// SearchCacheMaxBytes is the size limit of the search result cache.
+	// If zero, search results are not cached.
+	SearchCacheMaxBytes int64
+	// SearchParallelism is the number of inputs, such as groups of
+	// archive logs, that a search reads at once.  If zero, it is the
+	// number of CPUs.
+	SearchParallelism int
+	Logger            *zap.Logger
 }
 
 type VersionMessage struct {
@@ -25,11 +38,15 @@ type VersionMessage struct {
 var Version VersionMessage
 
 type Core struct {
-	Root         string
-	ZeekLauncher zeek.Launcher
-	spaces       *space.Manager
-	taskCount    int64
-	logger       *zap.Logger
+	Root             string
+	ZeekLauncher     zeek.Launcher
+	SuricataLauncher zeek.Launcher
+	spaces           *space.Manager
+	searchCache      *search.Cache
+	parallelism      int
+	tasks            *taskRegistry
+	taskCount        int64
+	logger           *zap.Logger
 }
 
 func NewCore(conf Config) (*Core, error) {
@@ -37,15 +54,34 @@ func NewCore(conf Config) (*Core, error) {
 	if logger == nil {
 		logger = zap.NewNop()
 	}
-	spaces, err := space.NewManager(conf.Root, conf.Logger)
+	spaces, err := space.NewManager(conf.Root, logger)
 	if err != nil {
 		return nil, err
 	}
+	var cache *search.Cache
+	if conf.SearchCacheMaxBytes > 0 {
+		dir, err := ioutil.TempDir("", "zqd-search-cache-")
+		if err != nil {
+			return nil, err
+		}
+		cache, err = search.NewCache(dir, conf.SearchCacheMaxBytes)
+		if err != nil {
+			return nil, err
+		}
+	}
+	parallelism := conf.SearchParallelism
+	if parallelism == 0 {
+		parallelism = runtime.GOMAXPROCS(0)
+	}
 	return &Core{
-		Root:         conf.Root,
-		ZeekLauncher: conf.ZeekLauncher,
-		spaces:       spaces,
-		logger:       logger,
+		Root:             conf.Root,
+		ZeekLauncher:     conf.ZeekLauncher,
+		SuricataLauncher: conf.SuricataLauncher,
+		spaces:           spaces,
+		searchCache:      cache,
+		parallelism:      parallelism,
+		tasks:            newTaskRegistry(),
+		logger:           logger,
 	}, nil
 }
 
@@ -53,6 +89,10 @@ func (c *Core) HasZeek() bool {
 	return c.ZeekLauncher != nil
 }
 
+func (c *Core) HasSuricata() bool {
+	return c.SuricataLauncher != nil
+}
+
 func (c *Core) requestLogger(r *http.Request) *zap.Logger {
 	return c.logger.With(zap.String("request_id", getRequestID(r.Context())))
 }
@@ -38,6 +38,10 @@ func NewHandler(core *Core, logger *zap.Logger) http.Handler {
 	h.Handle("/space/{space}/indexsearch", handleIndexSearch).Methods("POST")
 	h.Handle("/space/{space}/subspace", handleSubspacePost).Methods("POST")
 	h.Handle("/search", handleSearch).Methods("POST")
+	h.Handle("/search/cache", handleSearchCacheGet).Methods("GET")
+	h.Handle("/task", handleTaskList).Methods("GET")
+	h.Handle("/task/{task}", handleTaskGet).Methods("GET")
+	h.Handle("/task/{task}", handleTaskDelete).Methods("DELETE")
 	h.HandleFunc("/version", func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Add("Content-Type", "application/json")
 		json.NewEncoder(w).Encode(&Version)
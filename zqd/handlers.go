@@ -1,15 +1,18 @@
 package zqd
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/brimsec/zq/pcap"
 	"github.com/brimsec/zq/pkg/ctxio"
 	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/zio"
 	"github.com/brimsec/zq/zqd/api"
 	"github.com/brimsec/zq/zqd/ingest"
 	"github.com/brimsec/zq/zqd/search"
@@ -88,7 +91,14 @@ func handleSearch(c *Core, w http.ResponseWriter, r *http.Request) {
 	}
 	defer cancel()
 
-	srch, err := search.NewSearchOp(ctx, s.Storage(), req)
+	// The search runs as a task that other clients may cancel.
+	ctx, cancelTask := context.WithCancel(ctx)
+	defer cancelTask()
+	taskID := c.getTaskID()
+	c.tasks.add(newSearchTaskInfo(taskID, req), cancelTask)
+	defer c.tasks.remove(taskID)
+
+	srch, err := search.NewSearchOp(ctx, s.Storage(), req, c.searchCache, c.parallelism)
 	if err != nil {
 		// XXX This always returns bad request but should return status codes
 		// that reflect the nature of the returned error.
@@ -104,11 +114,59 @@ func handleSearch(c *Core, w http.ResponseWriter, r *http.Request) {
 	}
 
 	w.Header().Set("Content-Type", out.ContentType())
-	if err := srch.Run(out); err != nil {
+	out = &taskOutput{Output: out, tasks: c.tasks, id: taskID}
+	if err := srch.Run(taskID, out); err != nil {
 		c.requestLogger(r).Warn("Error writing response", zap.Error(err))
 	}
 }
 
+func handleSearchCacheGet(c *Core, w http.ResponseWriter, r *http.Request) {
+	if c.searchCache == nil {
+		respondError(c, w, r, zqe.E(zqe.NotFound, "search cache not enabled"))
+		return
+	}
+	respond(c, w, r, http.StatusOK, c.searchCache.Stats())
+}
+
+func handleTaskList(c *Core, w http.ResponseWriter, r *http.Request) {
+	respond(c, w, r, http.StatusOK, c.tasks.list())
+}
+
+func handleTaskGet(c *Core, w http.ResponseWriter, r *http.Request) {
+	id, ok := extractTaskID(c, w, r)
+	if !ok {
+		return
+	}
+	info, ok := c.tasks.get(id)
+	if !ok {
+		respondError(c, w, r, zqe.E(zqe.NotFound, "task %d not found", id))
+		return
+	}
+	respond(c, w, r, http.StatusOK, info)
+}
+
+func handleTaskDelete(c *Core, w http.ResponseWriter, r *http.Request) {
+	id, ok := extractTaskID(c, w, r)
+	if !ok {
+		return
+	}
+	if !c.tasks.cancel(id) {
+		respondError(c, w, r, zqe.E(zqe.NotFound, "task %d not found", id))
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func extractTaskID(c *Core, w http.ResponseWriter, r *http.Request) (int64, bool) {
+	v := mux.Vars(r)["task"]
+	id, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		respondError(c, w, r, zqe.E(zqe.Invalid, "invalid task id: %s", v))
+		return 0, false
+	}
+	return id, true
+}
+
 func getSearchOutput(w http.ResponseWriter, r *http.Request) (search.Output, error) {
 	ctrl := true
 	if r.URL.Query().Get("noctrl") != "" {
@@ -121,7 +179,11 @@ func getSearchOutput(w http.ResponseWriter, r *http.Request) (search.Output, err
 	case "zng":
 		return search.NewZngOutput(w, ctrl), nil
 	default:
-		return nil, zqe.E(zqe.Invalid, "unsupported search format: %s", format)
+		out, err := search.NewZioOutput(w, zio.WriterFlags{Format: format})
+		if err != nil {
+			return nil, zqe.E(zqe.Invalid, "unsupported search format: %s", format)
+		}
+		return out, nil
 	}
 }
 
@@ -323,7 +385,7 @@ func handlePcapPost(c *Core, w http.ResponseWriter, r *http.Request) {
 		respondError(c, w, r, zqe.E(zqe.Invalid, "storage does not support pcap import"))
 		return
 	}
-	op, err := ingest.NewPcapOp(ctx, pspace, pstore, req.Path, c.ZeekLauncher)
+	op, err := ingest.NewPcapOp(ctx, pspace, pstore, req.Path, c.ZeekLauncher, c.SuricataLauncher)
 	if err != nil {
 		respondError(c, w, r, err)
 		return
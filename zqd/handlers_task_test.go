@@ -0,0 +1,111 @@
+// +build !windows
+
+package zqd_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zio"
+	"github.com/brimsec/zq/zio/tzngio"
+	"github.com/brimsec/zq/zio/zngio"
+	"github.com/brimsec/zq/zng/resolver"
+	"github.com/brimsec/zq/zqd/api"
+	"github.com/brimsec/zq/zql"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSearchTaskCancel runs a search over a space whose data file is a
+// named pipe, so that the search blocks until the test writes to the pipe,
+// and cancels the search from a second client.
+func TestSearchTaskCancel(t *testing.T) {
+	src := `
+#0:record[_path:string,ts:time,uid:bstring]
+0:[conn;1521911723.205187;CBrzd94qfowOqJwCHa;]
+0:[conn;1521911721.255387;C8Tful1TvM3Zf5x8fl;]
+`
+	_, client, done := newCore(t)
+	defer done()
+	ctx := context.Background()
+	sp, err := client.SpacePost(ctx, api.SpacePostRequest{Name: "test"})
+	require.NoError(t, err)
+	fifo := filepath.Join(sp.DataPath, "all.zng")
+	require.NoError(t, syscall.Mkfifo(fifo, 0600))
+	// Opening the pipe for reading and writing doesn't block waiting for
+	// the search to open it.
+	pipe, err := os.OpenFile(fifo, os.O_RDWR, 0)
+	require.NoError(t, err)
+	defer pipe.Close()
+
+	parsed, err := zql.ParseProc("*")
+	require.NoError(t, err)
+	proc, err := json.Marshal(parsed)
+	require.NoError(t, err)
+	req := api.SearchRequest{
+		Space: sp.ID,
+		Proc:  proc,
+		Span:  nano.MaxSpan,
+		Dir:   -1,
+	}
+	msgsCh := make(chan []interface{})
+	go func() {
+		var msgs []interface{}
+		defer func() { msgsCh <- msgs }()
+		r, err := client.Search(ctx, req, nil)
+		if err != nil {
+			return
+		}
+		r.SetOnCtrl(func(i interface{}) {
+			msgs = append(msgs, i)
+		})
+		zbuf.Copy(zbuf.NopFlusher(tzngio.NewWriter(&bytes.Buffer{})), r)
+	}()
+
+	var tasks []api.TaskInfo
+	require.Eventually(t, func() bool {
+		tasks, err = client.TaskList(ctx)
+		return err == nil && len(tasks) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	info := tasks[0]
+	require.Equal(t, "search", info.Kind)
+	require.Equal(t, sp.ID, info.Space)
+	require.JSONEq(t, string(proc), string(info.Proc))
+
+	got, err := client.TaskInfo(ctx, info.TaskID)
+	require.NoError(t, err)
+	require.Equal(t, info.TaskID, got.TaskID)
+
+	require.NoError(t, client.TaskCancel(ctx, info.TaskID))
+
+	// Unblock the search so that it notices it was canceled.
+	var buf bytes.Buffer
+	w := zngio.NewWriter(&buf, zio.WriterFlags{})
+	require.NoError(t, zbuf.Copy(w, tzngio.NewReader(strings.NewReader(src), resolver.NewContext())))
+	_, err = pipe.Write(buf.Bytes())
+	require.NoError(t, err)
+	require.NoError(t, pipe.Close())
+
+	msgs := <-msgsCh
+	require.NotEmpty(t, msgs)
+	require.Equal(t, &api.TaskStart{Type: "TaskStart", TaskID: info.TaskID}, msgs[0])
+	taskEnd, ok := msgs[len(msgs)-1].(*api.TaskEnd)
+	require.True(t, ok)
+	require.Equal(t, info.TaskID, taskEnd.TaskID)
+	require.NotNil(t, taskEnd.Error)
+	require.Equal(t, "context canceled", taskEnd.Error.Message)
+
+	tasks, err = client.TaskList(ctx)
+	require.NoError(t, err)
+	require.Len(t, tasks, 0)
+	_, err = client.TaskInfo(ctx, info.TaskID)
+	require.Equal(t, api.ErrTaskNotFound, err)
+}
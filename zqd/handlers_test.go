@@ -16,6 +16,7 @@ import (
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/brimsec/zq/archive"
 	"github.com/brimsec/zq/pkg/fs"
@@ -87,6 +88,181 @@ func TestSearchNoCtrl(t *testing.T) {
 	require.Equal(t, 0, len(msgs))
 }
 
+func TestSearchFormats(t *testing.T) {
+	src := `
+#0:record[_path:string,ts:time,uid:bstring]
+0:[conn;1521911723.205187;CBrzd94qfowOqJwCHa;]
+0:[conn;1521911721.255387;C8Tful1TvM3Zf5x8fl;]
+`
+	_, client, done := newCore(t)
+	defer done()
+	sp, err := client.SpacePost(context.Background(), api.SpacePostRequest{Name: "test"})
+	require.NoError(t, err)
+	_ = postSpaceLogs(t, client, sp.ID, nil, src)
+
+	parsed, err := zql.ParseProc("*")
+	require.NoError(t, err)
+	proc, err := json.Marshal(parsed)
+	require.NoError(t, err)
+	req := api.SearchRequest{
+		Space: sp.ID,
+		Proc:  proc,
+		Span:  nano.MaxSpan,
+		Dir:   -1,
+	}
+	cases := []struct {
+		format   string
+		expected string
+	}{
+		{"csv", `
+_path,ts,uid
+conn,2018-03-24T17:15:23.205187Z,CBrzd94qfowOqJwCHa
+conn,2018-03-24T17:15:21.255387Z,C8Tful1TvM3Zf5x8fl
+`},
+		{"json", `
+{"_path":"conn","ts":"1521911723.205187","uid":"CBrzd94qfowOqJwCHa"}
+{"_path":"conn","ts":"1521911721.255387","uid":"C8Tful1TvM3Zf5x8fl"}
+`},
+		{"tzng", src},
+	}
+	for _, c := range cases {
+		t.Run(c.format, func(t *testing.T) {
+			r, err := client.SearchRaw(context.Background(), req, map[string]string{"format": c.format})
+			require.NoError(t, err)
+			defer r.Close()
+			b, err := ioutil.ReadAll(r)
+			require.NoError(t, err)
+			require.Equal(t, test.Trim(c.expected), string(b))
+		})
+	}
+
+	_, err = client.SearchRaw(context.Background(), req, map[string]string{"format": "parquet"})
+	require.Error(t, err)
+	require.Regexp(t, "unsupported search format: parquet", err.Error())
+
+	// ndjson selects the JSON protocol rather than plain records.
+	r, err := client.SearchRaw(context.Background(), req, map[string]string{"format": "ndjson"})
+	require.NoError(t, err)
+	defer r.Close()
+	b, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+	require.Contains(t, string(b), `"type":"TaskStart"`)
+}
+
+func TestSearchFormatError(t *testing.T) {
+	src := `
+#0:record[_path:string,ts:time,uid:bstring]
+0:[conn;1521911723.205187;CBrzd94qfowOqJwCHa;]
+`
+	_, client, done := newCore(t)
+	defer done()
+	sp, err := client.SpacePost(context.Background(), api.SpacePostRequest{Name: "test"})
+	require.NoError(t, err)
+	_ = postSpaceLogs(t, client, sp.ID, nil, src)
+	f, err := os.OpenFile(filepath.Join(sp.DataPath, "all.zng"), os.O_APPEND|os.O_WRONLY, 0)
+	require.NoError(t, err)
+	_, err = f.WriteString("garbage")
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	parsed, err := zql.ParseProc("*")
+	require.NoError(t, err)
+	proc, err := json.Marshal(parsed)
+	require.NoError(t, err)
+	req := api.SearchRequest{
+		Space: sp.ID,
+		Proc:  proc,
+		Span:  nano.MaxSpan,
+		Dir:   -1,
+	}
+	r, err := client.SearchRaw(context.Background(), req, map[string]string{"format": "csv"})
+	require.NoError(t, err)
+	defer r.Close()
+	_, err = ioutil.ReadAll(r)
+	require.Error(t, err)
+	require.IsType(t, &api.Error{}, err)
+	require.Regexp(t, "malformed zng record", err.Error())
+}
+
+func TestSearchCache(t *testing.T) {
+	src1 := `
+#0:record[_path:string,ts:time,uid:bstring]
+0:[conn;1521911723.205187;CBrzd94qfowOqJwCHa;]
+`
+	src2 := `
+#0:record[_path:string,ts:time,uid:bstring]
+0:[conn;1521911721.255387;C8Tful1TvM3Zf5x8fl;]
+`
+	_, client, done := newCoreWithConfig(t, zqd.Config{SearchCacheMaxBytes: 1024 * 1024})
+	defer done()
+	sp, err := client.SpacePost(context.Background(), api.SpacePostRequest{Name: "test"})
+	require.NoError(t, err)
+	_ = postSpaceLogs(t, client, sp.ID, nil, src1)
+
+	expectStats := func(expected api.SearchCacheStats) {
+		stats, err := client.SearchCacheStats(context.Background())
+		require.NoError(t, err)
+		expected.Bytes = stats.Bytes
+		expected.MaxBytes = 1024 * 1024
+		require.Equal(t, expected, *stats)
+	}
+
+	// A search repeated after it completes comes from the cache.
+	for i := 0; i < 2; i++ {
+		res, msgs := search(t, client, sp.ID, "*")
+		require.Equal(t, test.Trim(src1), res)
+		var stats *api.SearchStats
+		for _, m := range msgs {
+			if s, ok := m.(*api.SearchStats); ok {
+				stats = s
+			}
+		}
+		require.NotNil(t, stats)
+		require.Equal(t, int64(1), stats.RecordsMatched)
+	}
+	expectStats(api.SearchCacheStats{Entries: 1, Hits: 1, Misses: 1})
+
+	// A write to storage invalidates the cached results.
+	_ = postSpaceLogs(t, client, sp.ID, nil, src1, src2)
+	res := searchTzng(t, client, sp.ID, "*")
+	expected := `
+#0:record[_path:string,ts:time,uid:bstring]
+0:[conn;1521911723.205187;CBrzd94qfowOqJwCHa;]
+0:[conn;1521911721.255387;C8Tful1TvM3Zf5x8fl;]
+`
+	require.Equal(t, test.Trim(expected), res)
+	expectStats(api.SearchCacheStats{Entries: 1, Hits: 1, Misses: 2, Invalidations: 1})
+
+	// Different searches have different entries.
+	res = searchTzng(t, client, sp.ID, "uid=C8Tful1TvM3Zf5x8fl")
+	require.Equal(t, test.Trim(src2), res)
+	expectStats(api.SearchCacheStats{Entries: 2, Hits: 1, Misses: 3, Invalidations: 1})
+}
+
+func TestSearchCacheEviction(t *testing.T) {
+	src := `
+#0:record[_path:string,ts:time,uid:bstring]
+0:[conn;1521911723.205187;CBrzd94qfowOqJwCHa;]
+0:[conn;1521911721.255387;C8Tful1TvM3Zf5x8fl;]
+`
+	// The cache is large enough to hold the results of one search.
+	_, client, done := newCoreWithConfig(t, zqd.Config{SearchCacheMaxBytes: 100})
+	defer done()
+	sp, err := client.SpacePost(context.Background(), api.SpacePostRequest{Name: "test"})
+	require.NoError(t, err)
+	_ = postSpaceLogs(t, client, sp.ID, nil, src)
+
+	searchTzng(t, client, sp.ID, "*")
+	searchTzng(t, client, sp.ID, "uid=C8Tful1TvM3Zf5x8fl")
+	searchTzng(t, client, sp.ID, "uid=C8Tful1TvM3Zf5x8fl")
+	stats, err := client.SearchCacheStats(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, stats.Entries)
+	require.Equal(t, int64(1), stats.Evictions)
+	require.Equal(t, int64(1), stats.Hits)
+	require.LessOrEqual(t, stats.Bytes, stats.MaxBytes)
+}
+
 func TestSearchStats(t *testing.T) {
 	src := `
 #0:record[_path:string,ts:time]
@@ -276,6 +452,18 @@ func TestSpaceInfoNoData(t *testing.T) {
 	require.Equal(t, expected, info)
 }
 
+func TestTaskNotFound(t *testing.T) {
+	_, client, done := newCore(t)
+	defer done()
+	ctx := context.Background()
+	tasks, err := client.TaskList(ctx)
+	require.NoError(t, err)
+	require.Len(t, tasks, 0)
+	_, err = client.TaskInfo(ctx, 1)
+	require.Equal(t, api.ErrTaskNotFound, err)
+	require.Equal(t, api.ErrTaskNotFound, client.TaskCancel(ctx, 1))
+}
+
 func TestSpacePostNameOnly(t *testing.T) {
 	ctx := context.Background()
 	c, client, done := newCore(t)
@@ -679,6 +867,40 @@ func TestDeleteDuringPcapPost(t *testing.T) {
 	require.EqualError(t, <-pcapPostErr, "context canceled")
 }
 
+func TestPcapPostSuricata(t *testing.T) {
+	c, client, done := newCore(t)
+	defer done()
+
+	c.ZeekLauncher = testZeekLauncher(nil, writeLogsFn([]string{"./testdata/conn.log"}))
+	c.SuricataLauncher = testZeekLauncher(nil, writeLogsFn([]string{"./testdata/eve.json"}))
+
+	sp, err := client.SpacePost(context.Background(), api.SpacePostRequest{Name: "suricata"})
+	require.NoError(t, err)
+	stream, err := client.PcapPost(context.Background(), sp.ID, api.PcapPostRequest{"./testdata/valid.pcap"})
+	require.NoError(t, err)
+	var taskEnd *api.TaskEnd
+	for {
+		i, err := stream.Next()
+		require.NoError(t, err)
+		if i == nil {
+			break
+		}
+		if te, ok := i.(*api.TaskEnd); ok {
+			taskEnd = te
+		}
+	}
+	require.NotNil(t, taskEnd)
+	require.Nil(t, taskEnd.Error)
+
+	exptzng := `
+#0:record[_path:string,ts:time]
+0:[alert;1501770877.501001;]
+0:[conn;1501770877.471635;]
+`
+	res := searchTzng(t, client, sp.ID, "cut _path,ts")
+	require.Equal(t, test.Trim(exptzng), res)
+}
+
 func TestSpaceDataDir(t *testing.T) {
 	src := `
 #0:record[_path:string,ts:time,uid:bstring]
@@ -742,6 +964,40 @@ func indexArchiveSpace(t *testing.T, datapath string, ruledef string) {
 	require.NoError(t, err)
 }
 
+func TestArchiveSearchCache(t *testing.T) {
+	datapath := createTempDir(t)
+	thresh := int64(1000)
+	createArchiveSpace(t, datapath, thresh, "../tests/suite/zdx/babble.tzng")
+
+	_, client, done := newCoreWithConfig(t, zqd.Config{SearchCacheMaxBytes: 1024 * 1024})
+	defer done()
+
+	sp, err := client.SpacePost(context.Background(), api.SpacePostRequest{
+		Name:     "arktest",
+		DataPath: datapath,
+		Storage: &storage.Config{
+			Kind: storage.ArchiveStore,
+		},
+	})
+	require.NoError(t, err)
+
+	const prog = "s=harefoot-raucous | count()"
+	for i := 0; i < 2; i++ {
+		require.Equal(t, "#0:record[count:uint64]\n0:[1;]\n", searchTzng(t, client, sp.ID, prog))
+	}
+	stats, err := client.SearchCacheStats(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, int64(1), stats.Hits)
+
+	// Importing into the archive invalidates the cached results.
+	createArchiveSpace(t, datapath, thresh, "../tests/suite/zdx/babble.tzng")
+	require.Equal(t, "#0:record[count:uint64]\n0:[2;]\n", searchTzng(t, client, sp.ID, prog))
+	stats, err = client.SearchCacheStats(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, int64(1), stats.Hits)
+	require.Equal(t, int64(1), stats.Invalidations)
+}
+
 func TestCreateArchiveSpace(t *testing.T) {
 	datapath := createTempDir(t)
 	thresh := int64(1000)
@@ -774,7 +1030,7 @@ func TestCreateArchiveSpace(t *testing.T) {
 		DataPath:    sp.DataPath,
 		StorageKind: storage.ArchiveStore,
 		Span:        &span,
-		Size:        35285,
+		Size:        35331,
 	}
 	si, err := client.SpaceInfo(context.Background(), sp.ID)
 	require.NoError(t, err)
@@ -798,6 +1054,58 @@ func TestCreateArchiveSpace(t *testing.T) {
 	assert.Regexp(t, "space does not support log import", err.Error())
 }
 
+func TestArchiveMaintenance(t *testing.T) {
+	datapath := createTempDir(t)
+	thresh := int64(1000)
+	createArchiveSpace(t, datapath, thresh, "../tests/suite/zdx/babble.tzng")
+
+	_, client, done := newCore(t)
+	defer done()
+
+	ctx := context.Background()
+	_, err := client.SpacePost(ctx, api.SpacePostRequest{
+		Name:     "badinterval",
+		DataPath: datapath,
+		Storage: &storage.Config{
+			Kind: storage.ArchiveStore,
+			Archive: &storage.ArchiveConfig{
+				Maintenance: &storage.ArchiveMaintenance{Retain: int64(time.Hour)},
+			},
+		},
+	})
+	require.Error(t, err)
+	require.Regexp(t, "interval must be positive", err.Error())
+
+	// All of the records are from 2020 and therefore removed by the
+	// first maintenance run.
+	sp, err := client.SpacePost(ctx, api.SpacePostRequest{
+		Name:     "arktest",
+		DataPath: datapath,
+		Storage: &storage.Config{
+			Kind: storage.ArchiveStore,
+			Archive: &storage.ArchiveConfig{
+				Maintenance: &storage.ArchiveMaintenance{
+					Interval: int64(10 * time.Millisecond),
+					Compact:  true,
+					Retain:   int64(time.Hour),
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		si, err := client.SpaceInfo(ctx, sp.ID)
+		return err == nil && si.Span == nil && si.Size == 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	_, err = client.SubspacePost(ctx, sp.ID, api.SubspacePostRequest{Name: "subspace"})
+	require.Error(t, err)
+	require.Regexp(t, "scheduled maintenance", err.Error())
+
+	// Deleting the space stops its maintenance.
+	require.NoError(t, client.SpaceDelete(ctx, sp.ID))
+}
+
 func TestBlankNameSpace(t *testing.T) {
 	// Verify that spaces created before the zq#721 work have names.
 
@@ -843,15 +1151,105 @@ func TestIndexSearch(t *testing.T) {
 #0:record[key:int64,_log:zfile]
 0:[257;20200422/1587518432.06228663.zng;]
 0:[257;20200422/1587516797.06911059.zng;]
-0:[257;20200421/1587511801.06624146.zng;]
-0:[257;20200421/1587511516.06430561.zng;]
-0:[257;20200421/1587510489.06591564.zng;]
-0:[257;20200421/1587509322.06101754.zng;]
+0:[257;20200421/1587511796.06573588.zng;]
+0:[257;20200421/1587511510.06244215.zng;]
+0:[257;20200421/1587510466.06968917.zng;]
+0:[257;20200421/1587509280.06510849.zng;]
 `
 	res, _ := indexSearch(t, client, sp.ID, "", []string{"v=257"})
 	assert.Equal(t, test.Trim(expected), res)
 }
 
+func TestArchiveSearchIndexFilter(t *testing.T) {
+	datapath := createTempDir(t)
+	thresh := int64(1000)
+	createArchiveSpace(t, datapath, thresh, "../tests/suite/zdx/babble.tzng")
+	indexArchiveSpace(t, datapath, "v")
+
+	_, client, done := newCore(t)
+	defer done()
+
+	sp, err := client.SpacePost(context.Background(), api.SpacePostRequest{
+		Name:     "arktest",
+		DataPath: datapath,
+		Storage: &storage.Config{
+			Kind: storage.ArchiveStore,
+		},
+	})
+	require.NoError(t, err)
+
+	expected := `
+#0:record[ts:time,s:string,v:int64]
+0:[1587518244.06772813;enteral-femininely;257;]
+0:[1587516600.06763369;pestiduct-substriate;257;]
+0:[1587511760.06288502;stripy-winkel;257;]
+0:[1587511352.06462263;Gregorianist-northwestern;257;]
+0:[1587510205.06692309;apolaustic-spaniellike;257;]
+0:[1587509256.06429927;obbligato-untwinable;257;]
+`
+	res, msgs := search(t, client, sp.ID, "v=257")
+	assert.Equal(t, test.Trim(expected), res)
+
+	// Only the logs whose indexes hold the value are read.
+	var stats *api.SearchStats
+	for _, m := range msgs {
+		if s, ok := m.(*api.SearchStats); ok {
+			stats = s
+		}
+	}
+	require.NotNil(t, stats)
+	assert.EqualValues(t, 6, stats.RecordsMatched)
+	assert.Less(t, stats.RecordsRead, int64(1000))
+}
+
+func TestArchiveSearchParallel(t *testing.T) {
+	datapath := createTempDir(t)
+	thresh := int64(1000)
+	createArchiveSpace(t, datapath, thresh, "../tests/suite/zdx/babble.tzng")
+
+	var clients []*api.Connection
+	var spaces []api.SpaceID
+	for _, parallelism := range []int{1, 8} {
+		_, client, done := newCoreWithConfig(t, zqd.Config{SearchParallelism: parallelism})
+		defer done()
+		sp, err := client.SpacePost(context.Background(), api.SpacePostRequest{
+			Name:     "arktest",
+			DataPath: datapath,
+			Storage: &storage.Config{
+				Kind: storage.ArchiveStore,
+			},
+		})
+		require.NoError(t, err)
+		clients = append(clients, client)
+		spaces = append(spaces, sp.ID)
+	}
+
+	// Searches return the same results however many logs are read at
+	// once.
+	for _, prog := range []string{
+		"*",
+		"head 5",
+		"v>490",
+		"count(), avg(v), first(s), last(s)",
+		"count() by v | sort v",
+		"every 1h count() | sort ts",
+	} {
+		serial, _ := search(t, clients[0], spaces[0], prog)
+		parallel, msgs := search(t, clients[1], spaces[1], prog)
+		assert.Equal(t, serial, parallel, prog)
+		if prog == "count(), avg(v), first(s), last(s)" {
+			var stats *api.SearchStats
+			for _, m := range msgs {
+				if s, ok := m.(*api.SearchStats); ok {
+					stats = s
+				}
+			}
+			require.NotNil(t, stats)
+			assert.EqualValues(t, 1000, stats.RecordsRead)
+		}
+	}
+}
+
 func TestSubspaceCreate(t *testing.T) {
 	// Create archive & import data
 	datapath := createTempDir(t)
@@ -878,7 +1276,7 @@ func TestSubspaceCreate(t *testing.T) {
 #zfile=string
 #0:record[key:int64,_log:zfile]
 0:[336;20200422/1587517412.06741443.zng;]
-0:[336;20200421/1587508871.06471174.zng;]
+0:[336;20200421/1587508863.06912934.zng;]
 `
 	res, _ := indexSearch(t, client, sp1.ID, "", []string{":int64=336"})
 	assert.Equal(t, test.Trim(exp), res)
@@ -1065,10 +1463,17 @@ func newCore(t *testing.T) (*zqd.Core, *api.Connection, func()) {
 }
 
 func newCoreAtDir(t *testing.T, dir string) (*zqd.Core, *api.Connection, func()) {
-	conf := zqd.Config{
-		Root:   dir,
-		Logger: zaptest.NewLogger(t, zaptest.Level(zap.WarnLevel)),
+	return newCoreWithConfig(t, zqd.Config{Root: dir})
+}
+
+func newCoreWithConfig(t *testing.T, conf zqd.Config) (*zqd.Core, *api.Connection, func()) {
+	if conf.Root == "" {
+		conf.Root = createTempDir(t)
+	}
+	if conf.Logger == nil {
+		conf.Logger = zaptest.NewLogger(t, zaptest.Level(zap.WarnLevel))
 	}
+	dir := conf.Root
 	require.NoError(t, os.MkdirAll(dir, 0755))
 	c, err := zqd.NewCore(conf)
 	require.NoError(t, err)
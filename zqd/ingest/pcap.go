@@ -17,8 +17,14 @@ import (
 	"github.com/brimsec/zq/zio/detector"
 	"github.com/brimsec/zq/zng/resolver"
 	"github.com/brimsec/zq/zqd/zeek"
+	"golang.org/x/sync/errgroup"
 )
 
+// suricataDir is the subdirectory of a PcapOp's log directory in which
+// Suricata is run.  It keeps the logs Suricata writes alongside eve.json
+// from being mistaken for zeek logs.
+const suricataDir = "suricata"
+
 type PcapSpace interface {
 	PcapIndexPath() string
 	SetPcapPath(string) error
@@ -43,14 +49,17 @@ type PcapOp struct {
 	done, snap   chan struct{}
 	err          error
 	zlauncher    zeek.Launcher
+	slauncher    zeek.Launcher
 }
 
 // NewPcapOp kicks of the process for ingesting a pcap file into a space.
 // Should everything start out successfully, this will return a thread safe
 // Process instance once zeek log files have started to materialize in a tmp
 // directory. If zeekExec is an empty string, this will attempt to resolve zeek
-// from $PATH.
-func NewPcapOp(ctx context.Context, pspace PcapSpace, pstore PcapStore, pcap string, zlauncher zeek.Launcher) (*PcapOp, error) {
+// from $PATH. If slauncher is not nil, it is used to run Suricata on the
+// pcap alongside zeek, and the EVE alerts it writes to eve.json are
+// ingested along with the zeek logs.
+func NewPcapOp(ctx context.Context, pspace PcapSpace, pstore PcapStore, pcap string, zlauncher, slauncher zeek.Launcher) (*PcapOp, error) {
 	logdir, err := ioutil.TempDir("", "zqd-pcap-ingest-")
 	if err != nil {
 		return nil, err
@@ -69,6 +78,7 @@ func NewPcapOp(ctx context.Context, pspace PcapSpace, pstore PcapStore, pcap str
 		done:      make(chan struct{}),
 		snap:      make(chan struct{}),
 		zlauncher: zlauncher,
+		slauncher: slauncher,
 	}
 	if err = p.indexPcap(); err != nil {
 		os.Remove(p.pspace.PcapIndexPath())
@@ -90,7 +100,7 @@ func (p *PcapOp) run(ctx context.Context) error {
 	var slurpErr error
 	slurpDone := make(chan struct{})
 	go func() {
-		slurpErr = p.runZeek(ctx)
+		slurpErr = p.runAnalyzers(ctx)
 		close(slurpDone)
 	}()
 
@@ -159,6 +169,21 @@ func (p *PcapOp) indexPcap() error {
 	return p.pstore.SetSpan(idx.Span())
 }
 
+// runAnalyzers runs zeek and, if configured, Suricata on the pcap.  If
+// either fails, the other is stopped.
+func (p *PcapOp) runAnalyzers(ctx context.Context) error {
+	g, ctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		return p.runZeek(ctx)
+	})
+	if p.slauncher != nil {
+		g.Go(func() error {
+			return p.runSuricata(ctx)
+		})
+	}
+	return g.Wait()
+}
+
 func (p *PcapOp) runZeek(ctx context.Context) error {
 	pcapfile, err := fs.Open(p.pcapPath)
 	if err != nil {
@@ -173,6 +198,25 @@ func (p *PcapOp) runZeek(ctx context.Context) error {
 	return zproc.Wait()
 }
 
+// runSuricata runs Suricata on its own reader of the pcap so that
+// PcapReadSize reflects the progress of zeek alone.
+func (p *PcapOp) runSuricata(ctx context.Context) error {
+	dir := filepath.Join(p.logdir, suricataDir)
+	if err := os.Mkdir(dir, 0700); err != nil {
+		return err
+	}
+	pcapfile, err := fs.Open(p.pcapPath)
+	if err != nil {
+		return err
+	}
+	defer pcapfile.Close()
+	sproc, err := p.slauncher(ctx, bufio.NewReader(pcapfile), dir)
+	if err != nil {
+		return err
+	}
+	return sproc.Wait()
+}
+
 // PcapReadSize returns the total size in bytes of data read from the underlying
 // pcap file.
 func (p *PcapOp) PcapReadSize() int64 {
@@ -209,6 +253,10 @@ func (p *PcapOp) createSnapshot(ctx context.Context) error {
 	if err != nil {
 		panic(err)
 	}
+	eve := filepath.Join(p.logdir, suricataDir, "eve.json")
+	if _, err := os.Stat(eve); err == nil {
+		files = append(files, eve)
+	}
 	if len(files) == 0 {
 		return nil
 	}
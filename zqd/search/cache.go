@@ -0,0 +1,355 @@
+package search
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+
+	"github.com/brimsec/zq/ast"
+	"github.com/brimsec/zq/driver"
+	"github.com/brimsec/zq/pkg/fs"
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zio"
+	"github.com/brimsec/zq/zio/zngio"
+	"github.com/brimsec/zq/zng/resolver"
+	"github.com/brimsec/zq/zqd/api"
+)
+
+// Cache is a bounded, on-disk cache of the results of completed searches.
+// Entries are keyed by the space, proc, span, and direction of a search and
+// hold the records of each output channel as a ZNG file, along with the
+// warnings and stats of the search.  An entry is valid only for the
+// version of storage it was computed from and is removed when found to be
+// stale.  When the cache grows beyond its size limit, the least recently
+// used entries are evicted.
+type Cache struct {
+	dir      string
+	maxBytes int64
+
+	mu      sync.Mutex
+	lru     *list.List
+	entries map[string]*list.Element
+	bytes   int64
+	seq     int64
+	stats   api.SearchCacheStats
+}
+
+type cacheEntry struct {
+	key     string
+	version int64
+	dir     string
+	bytes   int64
+	// channels lists the output channels of the search in the order in
+	// which they ended.
+	channels []int
+	warnings []string
+	stats    api.ScannerStats
+
+	refs    int
+	evicted bool
+}
+
+// NewCache returns a Cache of at most maxBytes bytes of results stored
+// in dir.  Anything already in dir is removed.
+func NewCache(dir string, maxBytes int64) (*Cache, error) {
+	if err := os.RemoveAll(dir); err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	return &Cache{
+		dir:      dir,
+		maxBytes: maxBytes,
+		lru:      list.New(),
+		entries:  make(map[string]*list.Element),
+	}, nil
+}
+
+// cacheKey returns the key of the search described by req.  The proc is
+// unpacked and marshaled again so that equivalent requests differing only
+// in their JSON encoding share a key.
+func cacheKey(req api.SearchRequest) (string, error) {
+	proc, err := ast.UnpackProc(nil, req.Proc)
+	if err != nil {
+		return "", err
+	}
+	b, err := json.Marshal(proc)
+	if err != nil {
+		return "", err
+	}
+	h := sha256.New()
+	fmt.Fprintf(h, "%s\x00%d\x00%d\x00%d\x00", req.Space, req.Span.Ts, req.Span.Dur, req.Dir)
+	h.Write(b)
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// lookup returns the entry for key if it was computed from the given
+// version of storage.  The caller must release a returned entry.
+func (c *Cache) lookup(key string, version int64) *cacheEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	elem, ok := c.entries[key]
+	if !ok {
+		c.stats.Misses++
+		return nil
+	}
+	e := elem.Value.(*cacheEntry)
+	if e.version != version {
+		c.stats.Misses++
+		c.stats.Invalidations++
+		c.remove(elem)
+		return nil
+	}
+	c.stats.Hits++
+	c.lru.MoveToFront(elem)
+	e.refs++
+	return e
+}
+
+func (c *Cache) release(e *cacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e.refs--
+	if e.evicted && e.refs == 0 {
+		os.RemoveAll(e.dir)
+	}
+}
+
+// remove removes the entry in elem from the cache, deleting its files once
+// it is no longer in use.  c.mu must be held.
+func (c *Cache) remove(elem *list.Element) {
+	e := c.lru.Remove(elem).(*cacheEntry)
+	delete(c.entries, e.key)
+	c.bytes -= e.bytes
+	e.evicted = true
+	if e.refs == 0 {
+		os.RemoveAll(e.dir)
+	}
+}
+
+// add adds e to the cache, replacing any entry with the same key and
+// evicting the least recently used entries as needed to stay within the
+// size limit.
+func (c *Cache) add(e *cacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if elem, ok := c.entries[e.key]; ok {
+		c.remove(elem)
+	}
+	for c.bytes+e.bytes > c.maxBytes && c.lru.Len() > 0 {
+		c.stats.Evictions++
+		c.remove(c.lru.Back())
+	}
+	c.entries[e.key] = c.lru.PushFront(e)
+	c.bytes += e.bytes
+}
+
+func (c *Cache) newEntryDir() (string, error) {
+	c.mu.Lock()
+	c.seq++
+	dir := filepath.Join(c.dir, strconv.FormatInt(c.seq, 10))
+	c.mu.Unlock()
+	return dir, os.Mkdir(dir, 0700)
+}
+
+// Stats returns the current size of the cache and the counts of its hits,
+// misses, evictions, and invalidations.
+func (c *Cache) Stats() api.SearchCacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	stats := c.stats
+	stats.Entries = c.lru.Len()
+	stats.Bytes = c.bytes
+	stats.MaxBytes = c.maxBytes
+	return stats
+}
+
+func channelPath(dir string, cid int) string {
+	return filepath.Join(dir, strconv.Itoa(cid)+".zng")
+}
+
+// replay sends the results in e to d as if its search were being run.
+func (e *cacheEntry) replay(d *searchdriver) error {
+	for _, w := range e.warnings {
+		if err := d.Warn(w); err != nil {
+			return err
+		}
+	}
+	for _, cid := range e.channels {
+		if err := e.replayChannel(d, cid); err != nil {
+			return err
+		}
+		if err := d.ChannelEnd(cid); err != nil {
+			return err
+		}
+	}
+	return d.Stats(e.stats)
+}
+
+func (e *cacheEntry) replayChannel(d *searchdriver, cid int) error {
+	f, err := fs.Open(channelPath(e.dir, cid))
+	if err != nil {
+		if os.IsNotExist(err) {
+			// The channel had no records.
+			return nil
+		}
+		return err
+	}
+	defer f.Close()
+	r := zngio.NewReader(f, resolver.NewContext())
+	for {
+		batch, err := zbuf.ReadBatch(r, DefaultMTU)
+		if err != nil {
+			return err
+		}
+		if batch == nil {
+			return nil
+		}
+		if err := d.Write(cid, batch); err != nil {
+			return err
+		}
+	}
+}
+
+// recorder is a driver.Driver that records the results of a search as a
+// cacheEntry while passing them on to another driver.  A recorder that
+// fails or whose results exceed the size of the cache stops recording
+// but continues to pass on results.
+type recorder struct {
+	driver.Driver
+	cache   *Cache
+	entry   *cacheEntry
+	writers map[int]*channelWriter
+	failed  bool
+}
+
+type channelWriter struct {
+	file   *os.File
+	writer *zngio.Writer
+	n      int64
+}
+
+func (w *channelWriter) Write(b []byte) (int, error) {
+	n, err := w.file.Write(b)
+	w.n += int64(n)
+	return n, err
+}
+
+func newRecorder(d driver.Driver, cache *Cache, key string, version int64) (*recorder, error) {
+	dir, err := cache.newEntryDir()
+	if err != nil {
+		return nil, err
+	}
+	return &recorder{
+		Driver:  d,
+		cache:   cache,
+		entry:   &cacheEntry{key: key, version: version, dir: dir},
+		writers: make(map[int]*channelWriter),
+	}, nil
+}
+
+func (r *recorder) bytes() int64 {
+	var n int64
+	for _, w := range r.writers {
+		n += w.n
+	}
+	return n
+}
+
+func (r *recorder) record(cid int, batch zbuf.Batch) error {
+	w, ok := r.writers[cid]
+	if !ok {
+		f, err := os.Create(channelPath(r.entry.dir, cid))
+		if err != nil {
+			return err
+		}
+		w = &channelWriter{file: f}
+		w.writer = zngio.NewWriter(w, zio.WriterFlags{})
+		r.writers[cid] = w
+	}
+	for _, rec := range batch.Records() {
+		if err := w.writer.Write(rec); err != nil {
+			return err
+		}
+	}
+	if r.bytes() > r.cache.maxBytes {
+		return fmt.Errorf("search results exceed cache size of %d bytes", r.cache.maxBytes)
+	}
+	return nil
+}
+
+func (r *recorder) Write(cid int, batch zbuf.Batch) error {
+	if !r.failed {
+		if err := r.record(cid, batch); err != nil {
+			r.failed = true
+		}
+	}
+	return r.Driver.Write(cid, batch)
+}
+
+func (r *recorder) Warn(msg string) error {
+	r.entry.warnings = append(r.entry.warnings, msg)
+	return r.Driver.Warn(msg)
+}
+
+func (r *recorder) ChannelEnd(cid int) error {
+	r.entry.channels = append(r.entry.channels, cid)
+	return r.Driver.ChannelEnd(cid)
+}
+
+func (r *recorder) Stats(stats api.ScannerStats) error {
+	r.entry.stats = stats
+	return r.Driver.Stats(stats)
+}
+
+// closeWriters flushes and closes the channel files and returns their
+// total size.
+func (r *recorder) closeWriters() (int64, error) {
+	var err error
+	for _, w := range r.writers {
+		if ferr := w.writer.Flush(); ferr != nil && err == nil {
+			err = ferr
+		}
+		if cerr := w.file.Close(); cerr != nil && err == nil {
+			err = cerr
+		}
+	}
+	n := r.bytes()
+	r.writers = nil
+	return n, err
+}
+
+// commit adds the recorded results to the cache if they are complete and
+// were computed from the given version of storage.
+func (r *recorder) commit(version int64) error {
+	if r.failed || version != r.entry.version {
+		return r.discard()
+	}
+	n, err := r.closeWriters()
+	if err != nil || n > r.cache.maxBytes {
+		r.discard()
+		return err
+	}
+	r.entry.bytes = n
+	r.cache.add(r.entry)
+	r.entry = nil
+	return nil
+}
+
+// discard removes any recorded results not added to the cache.
+func (r *recorder) discard() error {
+	if r.entry == nil {
+		return nil
+	}
+	r.closeWriters()
+	err := os.RemoveAll(r.entry.dir)
+	r.entry = nil
+	return err
+}
@@ -25,20 +25,43 @@ const DefaultMTU = 100
 const StatsInterval = time.Millisecond * 500
 
 const (
+	MimeTypeCSV    = "text/csv"
 	MimeTypeNDJSON = "application/x-ndjson"
+	MimeTypeText   = "text/plain"
+	MimeTypeTSV    = "text/tab-separated-values"
 	MimeTypeZNG    = "application/x-zng"
 )
 
 type SearchStore interface {
 	Open(ctx context.Context, span nano.Span) (zbuf.ReadCloser, error)
+	Version(ctx context.Context) (int64, error)
+}
+
+// A ParallelSearchStore is a SearchStore whose data can be divided into
+// inputs that are searched in parallel.
+type ParallelSearchStore interface {
+	SearchInputs(ctx context.Context, span nano.Span, filter ast.BooleanExpr) ([]driver.Opener, error)
 }
 
 type SearchOp struct {
-	mux *driver.MuxOutput
-	io.Closer
+	ctx    context.Context
+	mux    *driver.MuxOutput
+	reader io.Closer
+	store  SearchStore
+	// If cache is not nil, results are replayed from hit if it is not
+	// nil and are otherwise recorded in the cache under key.
+	cache   *Cache
+	key     string
+	version int64
+	hit     *cacheEntry
 }
 
-func NewSearchOp(ctx context.Context, s SearchStore, req api.SearchRequest) (*SearchOp, error) {
+// NewSearchOp returns a SearchOp for the search described by req.  If
+// cache is not nil, the results of the search are taken from the cache if
+// there and are otherwise added to it when the search completes.  If s is a
+// ParallelSearchStore, as many as parallelism of its inputs are searched
+// at once.
+func NewSearchOp(ctx context.Context, s SearchStore, req api.SearchRequest, cache *Cache, parallelism int) (*SearchOp, error) {
 	if req.Span.Ts < 0 {
 		return nil, errors.New("time span must have non-negative timestamp")
 	}
@@ -57,7 +80,34 @@ func NewSearchOp(ctx context.Context, s SearchStore, req api.SearchRequest) (*Se
 	if err != nil {
 		return nil, err
 	}
+	op := &SearchOp{ctx: ctx, store: s, cache: cache}
+	if cache != nil {
+		if op.key, err = cacheKey(req); err != nil {
+			return nil, err
+		}
+		if op.version, err = s.Version(ctx); err != nil {
+			return nil, err
+		}
+		if op.hit = cache.lookup(op.key, op.version); op.hit != nil {
+			return op, nil
+		}
+	}
 
+	filterProc, _ := driver.LiftFilter(query.Proc)
+	if ps, ok := s.(ParallelSearchStore); ok {
+		var filter ast.BooleanExpr
+		if filterProc != nil {
+			filter = filterProc.Filter
+		}
+		inputs, err := ps.SearchInputs(ctx, query.Span, filter)
+		if err != nil {
+			return nil, err
+		}
+		if op.mux, err = launchParallel(ctx, query, inputs, parallelism); err != nil {
+			return nil, err
+		}
+		return op, nil
+	}
 	zngReader, err := s.Open(ctx, query.Span)
 	if err != nil {
 		return nil, err
@@ -69,26 +119,65 @@ func NewSearchOp(ctx context.Context, s SearchStore, req api.SearchRequest) (*Se
 		zngReader.Close()
 		return nil, err
 	}
-	return &SearchOp{mux, zngReader}, nil
+	op.mux = mux
+	op.reader = zngReader
+	return op, nil
 }
 
-func (s *SearchOp) Run(output Output) error {
+// Run runs the search, sending its results to output in a response stream
+// identified by taskID.
+func (s *SearchOp) Run(taskID int64, output Output) error {
 	d := &searchdriver{
 		output:    output,
 		startTime: nano.Now(),
 	}
-	d.start(0)
+	d.start(taskID)
+	if s.hit != nil {
+		if err := s.hit.replay(d); err != nil {
+			d.abort(taskID, err)
+			return err
+		}
+		return d.end(taskID)
+	}
+	var drv driver.Driver = d
+	var rec *recorder
+	if s.cache != nil {
+		// A search whose results can't be recorded still runs.
+		if r, err := newRecorder(d, s.cache, s.key, s.version); err == nil {
+			rec = r
+			drv = r
+			defer rec.discard()
+		}
+	}
 	statsTicker := time.NewTicker(StatsInterval)
 	defer statsTicker.Stop()
-	if err := driver.Run(s.mux, d, statsTicker.C); err != nil {
-		d.abort(0, err)
+	if err := driver.Run(s.mux, drv, statsTicker.C); err != nil {
+		d.abort(taskID, err)
 		return err
 	}
-	if err := d.Stats(s.mux.Stats()); err != nil {
-		d.abort(0, err)
+	if err := drv.Stats(s.mux.Stats()); err != nil {
+		d.abort(taskID, err)
 		return err
 	}
-	return d.end(0)
+	if rec != nil {
+		// Only cache results if storage didn't change during the search.
+		if version, err := s.store.Version(s.ctx); err == nil {
+			rec.commit(version)
+		}
+	}
+	return d.end(taskID)
+}
+
+func (s *SearchOp) Close() error {
+	if s.hit != nil {
+		s.cache.release(s.hit)
+		return nil
+	}
+	if s.reader == nil {
+		// The inputs of a parallel search are closed as they're read.
+		return nil
+	}
+	return s.reader.Close()
 }
 
 type Output interface {
@@ -178,5 +267,14 @@ func launch(ctx context.Context, query *Query, reader zbuf.Reader, zctx *resolve
 		span = nano.MaxSpan
 	}
 	// Records in a zqd filestore are sorted by descending ts (in zqd/storage/filestore.(*Storage).write).
-	return driver.Compile(context.Background(), query.Proc, reader, "ts", true, span, zap.NewNop())
+	return driver.Compile(ctx, query.Proc, reader, "ts", true, span, zap.NewNop())
+}
+
+func launchParallel(ctx context.Context, query *Query, inputs []driver.Opener, parallelism int) (*driver.MuxOutput, error) {
+	span := query.Span
+	if span == (nano.Span{}) {
+		span = nano.MaxSpan
+	}
+	ch := make(chan string, 5)
+	return driver.CompileParallel(ctx, query.Proc, inputs, "ts", true, span, zap.NewNop(), ch, parallelism)
 }
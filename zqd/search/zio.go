@@ -0,0 +1,106 @@
+package search
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zio"
+	"github.com/brimsec/zq/zio/detector"
+	"github.com/brimsec/zq/zqd/api"
+)
+
+type zioFormat struct {
+	// writer is the format of the zio writer, which differs from the
+	// search format for json since ndjson selects the JSON protocol.
+	writer string
+	ctype  string
+}
+
+// zioFormats maps the search formats written by ZioOutput to the formats
+// of their zio writers and the content types of their responses.
+var zioFormats = map[string]zioFormat{
+	"csv":   {"csv", MimeTypeCSV},
+	"json":  {"ndjson", MimeTypeNDJSON},
+	"table": {"table", MimeTypeText},
+	"text":  {"text", MimeTypeText},
+	"tsv":   {"tsv", MimeTypeTSV},
+	"tzng":  {"tzng", MimeTypeText},
+	"zeek":  {"zeek", MimeTypeText},
+}
+
+// ZioOutput writes records to the client in one of the formats written
+// by zq (e.g., csv, ndjson, or zeek) using the same zio writers.  These
+// formats have no place for protocol messages, so control messages are
+// dropped and the records of all channels are written to the one stream.
+// An error that ends the search is instead reported as a JSON-encoded
+// api.Error in the api.ErrorTrailer trailer of the response.  Also, it
+// implements the Output interface.
+type ZioOutput struct {
+	response http.ResponseWriter
+	writer   *zio.Writer
+	ctype    string
+}
+
+func NewZioOutput(response http.ResponseWriter, flags zio.WriterFlags) (*ZioOutput, error) {
+	format, ok := zioFormats[flags.Format]
+	if !ok {
+		return nil, fmt.Errorf("unknown format: %s", flags.Format)
+	}
+	flags.Format = format.writer
+	// Trailers must be announced before the response is written.
+	response.Header().Set("Trailer", api.ErrorTrailer)
+	return &ZioOutput{
+		response: response,
+		writer:   detector.LookupWriter(&nopCloser{response}, &flags),
+		ctype:    format.ctype,
+	}, nil
+}
+
+func (r *ZioOutput) flush() error {
+	if err := r.writer.Flush(); err != nil {
+		return err
+	}
+	r.response.(http.Flusher).Flush()
+	return nil
+}
+
+func (r *ZioOutput) SendBatch(cid int, batch zbuf.Batch) error {
+	for _, rec := range batch.Records() {
+		if err := r.writer.Write(rec); err != nil {
+			return err
+		}
+	}
+	batch.Unref()
+	return r.flush()
+}
+
+func (r *ZioOutput) End(ctrl interface{}) error {
+	return r.flush()
+}
+
+func (r *ZioOutput) SendControl(ctrl interface{}) error {
+	end, ok := ctrl.(*api.TaskEnd)
+	if !ok || end.Error == nil {
+		return nil
+	}
+	b, err := json.Marshal(end.Error)
+	if err != nil {
+		return err
+	}
+	if err := r.flush(); err != nil {
+		return err
+	}
+	r.response.Header().Set(api.ErrorTrailer, string(b))
+	return nil
+}
+
+func (r *ZioOutput) ContentType() string {
+	return r.ctype
+}
+
+type nopCloser struct{ io.Writer }
+
+func (*nopCloser) Close() error { return nil }
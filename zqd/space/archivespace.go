@@ -80,6 +80,10 @@ func (s *archiveSpace) CreateSubspace(req api.SubspacePostRequest) (*archiveSubs
 	s.confMu.Lock()
 	defer s.confMu.Unlock()
 
+	if s.conf.Storage.Archive != nil && s.conf.Storage.Archive.Maintenance != nil {
+		return nil, zqe.E(zqe.Invalid, "cannot create subspace of space with scheduled maintenance")
+	}
+
 	substore, err := archivestore.Load(s.conf.DataPath, &storage.ArchiveConfig{
 		OpenOptions: &req.OpenOptions,
 	})
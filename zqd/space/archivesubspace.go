@@ -39,6 +39,22 @@ func (s *archiveSubspace) Info(ctx context.Context) (api.SpaceInfo, error) {
 	}, err
 }
 
+// StartOp registers an operation on the subspace like spaceBase.StartOp.
+// Since the subspace reads the archive of its parent, the operation also
+// keeps exclusive operations on the parent, like maintenance, from running.
+func (s *archiveSubspace) StartOp(ctx context.Context) (context.Context, context.CancelFunc, error) {
+	s.parent.sg.excl.RLock()
+	ctx, done, err := s.sg.acquire(ctx)
+	if err != nil {
+		s.parent.sg.excl.RUnlock()
+		return ctx, done, err
+	}
+	return ctx, func() {
+		done()
+		s.parent.sg.excl.RUnlock()
+	}, nil
+}
+
 func (s *archiveSubspace) update(req api.SpacePutRequest) error {
 	return s.findConfig(func(i int) error {
 		conf := s.parent.conf.clone()
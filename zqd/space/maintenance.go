@@ -0,0 +1,65 @@
+package space
+
+import (
+	"context"
+	"time"
+
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/zqd/storage"
+	"github.com/brimsec/zq/zqd/storage/archivestore"
+	"go.uber.org/zap"
+)
+
+func (s *archiveSpace) maintenance() *storage.ArchiveMaintenance {
+	s.confMu.Lock()
+	defer s.confMu.Unlock()
+	if s.conf.Storage.Archive == nil {
+		return nil
+	}
+	return s.conf.Storage.Archive.Maintenance
+}
+
+// maintain runs the scheduled maintenance of the space's archive until the
+// space is deleted.
+func (s *archiveSpace) maintain(m storage.ArchiveMaintenance, logger *zap.Logger) {
+	ticker := time.NewTicker(time.Duration(m.Interval))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.sg.cancelChan:
+			return
+		case <-ticker.C:
+		}
+		if err := s.runMaintenance(m, logger); err != nil {
+			logger.Warn("Archive maintenance failed", zap.Error(err))
+		}
+	}
+}
+
+func (s *archiveSpace) runMaintenance(m storage.ArchiveMaintenance, logger *zap.Logger) error {
+	// Compaction and retention remove logs, so they wait for searches,
+	// which may have listed those logs, to finish and keep new ones from
+	// starting.
+	ctx, done, err := s.sg.acquireExclusive(context.Background())
+	if err != nil {
+		// The space is being deleted.
+		return nil
+	}
+	defer done()
+	store := s.store.(*archivestore.Storage)
+	if m.Retain > 0 {
+		removed, err := store.Retain(ctx, nano.Now().Sub(m.Retain))
+		if err != nil {
+			return err
+		}
+		for _, si := range removed {
+			logger.Info("Removed aged log", zap.String("log_id", string(si.LogID)))
+		}
+	}
+	if m.Compact {
+		if err := store.Compact(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -66,11 +66,35 @@ func NewManager(root string, logger *zap.Logger) (*Manager, error) {
 			mgr.spaces[s.ID()] = s
 			mgr.names[s.Name()] = s.ID()
 		}
+		mgr.startMaintenance(spaces[0])
 	}
 
 	return mgr, nil
 }
 
+// startMaintenance starts the scheduled maintenance of s, if any.
+func (m *Manager) startMaintenance(s Space) {
+	as, ok := s.(*archiveSpace)
+	if !ok {
+		return
+	}
+	maint := as.maintenance()
+	if maint == nil {
+		return
+	}
+	logger := m.logger.With(zap.String("space_id", string(s.ID())))
+	as.confMu.Lock()
+	nsub := len(as.conf.Subspaces)
+	as.confMu.Unlock()
+	if nsub != 0 {
+		// Subspaces refer to the logs of the archive by name, so
+		// compaction and retention would break them.
+		logger.Warn("Archive maintenance disabled for space with subspaces")
+		return
+	}
+	go as.maintain(*maint, logger)
+}
+
 func (m *Manager) Create(req api.SpacePostRequest) (Space, error) {
 	m.spacesMu.Lock()
 	defer m.spacesMu.Unlock()
@@ -92,6 +116,14 @@ func (m *Manager) Create(req api.SpacePostRequest) (Space, error) {
 	if storecfg.Kind == storage.UnknownStore {
 		storecfg.Kind = storage.FileStore
 	}
+	if storecfg.Archive != nil && storecfg.Archive.Maintenance != nil {
+		if storecfg.Kind != storage.ArchiveStore {
+			return nil, zqe.E(zqe.Invalid, "maintenance requires archive storage")
+		}
+		if storecfg.Archive.Maintenance.Interval <= 0 {
+			return nil, zqe.E(zqe.Invalid, "maintenance interval must be positive")
+		}
+	}
 	id := newSpaceID()
 	path := filepath.Join(m.rootPath, string(id))
 	if err := os.Mkdir(path, 0755); err != nil {
@@ -112,6 +144,7 @@ func (m *Manager) Create(req api.SpacePostRequest) (Space, error) {
 	s := spaces[0]
 	m.spaces[s.ID()] = s
 	m.names[s.Name()] = s.ID()
+	m.startMaintenance(s)
 	return s, err
 }
 
@@ -55,6 +55,10 @@ type guard struct {
 	wg sync.WaitGroup
 	// closed to signal non-delete ops should terminate
 	cancelChan chan struct{}
+
+	// excl is held shared by each operation and exclusively by an
+	// operation that can't run alongside others, like archive maintenance.
+	excl sync.RWMutex
 }
 
 func newGuard() *guard {
@@ -64,10 +68,26 @@ func newGuard() *guard {
 }
 
 func (g *guard) acquire(ctx context.Context) (context.Context, context.CancelFunc, error) {
+	g.excl.RLock()
+	return g.register(ctx, g.excl.RUnlock)
+}
+
+// acquireExclusive is like acquire but waits for all other operations to
+// finish and keeps new ones from starting until the returned done
+// function is called.
+func (g *guard) acquireExclusive(ctx context.Context) (context.Context, context.CancelFunc, error) {
+	g.excl.Lock()
+	return g.register(ctx, g.excl.Unlock)
+}
+
+// register registers an operation holding excl, which is released by
+// calling unlock when the operation is done.
+func (g *guard) register(ctx context.Context, unlock func()) (context.Context, context.CancelFunc, error) {
 	g.mu.Lock()
 	defer g.mu.Unlock()
 
 	if g.deletePending {
+		unlock()
 		return ctx, func() {}, zqe.E(zqe.Conflict, "space is pending deletion")
 	}
 
@@ -83,8 +103,9 @@ func (g *guard) acquire(ctx context.Context) (context.Context, context.CancelFun
 	}()
 
 	done := func() {
-		g.wg.Done()
 		cancel()
+		unlock()
+		g.wg.Done()
 	}
 
 	return ctx, done, nil
@@ -0,0 +1,53 @@
+package space
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGuardExclusive(t *testing.T) {
+	g := newGuard()
+	_, done, err := g.acquire(context.Background())
+	require.NoError(t, err)
+
+	acquired := make(chan func())
+	go func() {
+		_, done, err := g.acquireExclusive(context.Background())
+		require.NoError(t, err)
+		acquired <- done
+	}()
+	select {
+	case <-acquired:
+		t.Fatal("exclusive operation started alongside another")
+	case <-time.After(50 * time.Millisecond):
+	}
+	done()
+	var exclDone func()
+	select {
+	case exclDone = <-acquired:
+	case <-time.After(5 * time.Second):
+		t.Fatal("exclusive operation didn't start")
+	}
+
+	started := make(chan struct{})
+	go func() {
+		_, done, err := g.acquire(context.Background())
+		require.NoError(t, err)
+		done()
+		close(started)
+	}()
+	select {
+	case <-started:
+		t.Fatal("operation started alongside an exclusive one")
+	case <-time.After(50 * time.Millisecond):
+	}
+	exclDone()
+	<-started
+
+	require.NoError(t, g.acquireForDelete())
+	_, _, err = g.acquireExclusive(context.Background())
+	require.Error(t, err)
+}
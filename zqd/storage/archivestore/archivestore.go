@@ -6,6 +6,8 @@ import (
 	"sync"
 
 	"github.com/brimsec/zq/archive"
+	"github.com/brimsec/zq/ast"
+	"github.com/brimsec/zq/driver"
 	"github.com/brimsec/zq/pkg/nano"
 	"github.com/brimsec/zq/zbuf"
 	"github.com/brimsec/zq/zio/detector"
@@ -62,6 +64,17 @@ func (s *Storage) Open(ctx context.Context, span nano.Span) (zbuf.ReadCloser, er
 	return detector.MultiFileReader(zctx, paths, cfg), nil
 }
 
+// SearchInputs returns the inputs of a parallel search over the logs that
+// overlap span, skipping the logs whose summaries or indexes show they
+// contain no records matching filter.
+func (s *Storage) SearchInputs(_ context.Context, span nano.Span, filter ast.BooleanExpr) ([]driver.Opener, error) {
+	var f *archive.IndexFilter
+	if filter != nil {
+		f = archive.NewIndexFilter(filter)
+	}
+	return archive.SearchInputs(s.ark, span, f)
+}
+
 func (s *Storage) Summary(_ context.Context) (storage.Summary, error) {
 	var sum storage.Summary
 	sum.Kind = storage.ArchiveStore
@@ -107,6 +120,24 @@ func (s *Storage) Summary(_ context.Context) (storage.Summary, error) {
 	return sum, nil
 }
 
+// Version returns the update count of the archive, which changes whenever
+// its metadata, and thus the set of logs it holds, changes.
+func (s *Storage) Version(_ context.Context) (int64, error) {
+	update, err := s.ark.UpdateCheck()
+	return int64(update), err
+}
+
 func (s *Storage) IndexSearch(ctx context.Context, query archive.IndexQuery) (zbuf.ReadCloser, error) {
 	return archive.FindReadCloser(ctx, s.ark, query, archive.AddPath(archive.DefaultAddPathField, false))
 }
+
+// Compact merges small logs in the archive.  See archive.Compact.
+func (s *Storage) Compact(ctx context.Context) error {
+	return archive.Compact(ctx, s.ark)
+}
+
+// Retain removes the logs in the archive that end at or before cutoff.
+// See archive.Retain.
+func (s *Storage) Retain(ctx context.Context, cutoff nano.Ts) ([]archive.SpanInfo, error) {
+	return archive.Retain(ctx, s.ark, cutoff)
+}
@@ -7,6 +7,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync/atomic"
 
 	"github.com/brimsec/zq/driver"
 	"github.com/brimsec/zq/pkg/fs"
@@ -55,6 +56,7 @@ type Storage struct {
 	index      *zngio.TimeIndex
 	streamsize int
 	wsem       *semaphore.Weighted
+	version    int64
 }
 
 func (s *Storage) NativeDirection() zbuf.Direction {
@@ -125,6 +127,7 @@ func (s *Storage) Rewrite(ctx context.Context, zr zbuf.Reader) error {
 	}); err != nil {
 		return err
 	}
+	atomic.AddInt64(&s.version, 1)
 
 	if !spanWriter.writes {
 		return nil
@@ -152,9 +155,15 @@ func (s *Storage) Clear(ctx context.Context) error {
 	if err := os.Remove(s.join(allZngFile)); err != nil && !os.IsNotExist(err) {
 		return err
 	}
+	atomic.AddInt64(&s.version, 1)
 	return s.SetSpan(nano.Span{})
 }
 
+// Version returns a count of the writes to storage since it was loaded.
+func (s *Storage) Version(_ context.Context) (int64, error) {
+	return atomic.LoadInt64(&s.version), nil
+}
+
 func (s *Storage) extendSpan(span nano.Span) error {
 	// XXX This is not thread safe and it should be.
 	first := s.span == nano.Span{}
@@ -42,6 +42,7 @@ type Config struct {
 type ArchiveConfig struct {
 	OpenOptions   *ArchiveOpenOptions   `json:"open_options,omitempty"`
 	CreateOptions *ArchiveCreateOptions `json:"create_options,omitempty"`
+	Maintenance   *ArchiveMaintenance   `json:"maintenance,omitempty"`
 }
 
 type ArchiveOpenOptions struct {
@@ -52,6 +53,18 @@ type ArchiveCreateOptions struct {
 	LogSizeThreshold *int64 `json:"log_size_threshold,omitempty"`
 }
 
+// ArchiveMaintenance schedules the compaction of an archive and the
+// removal of its aged logs.
+type ArchiveMaintenance struct {
+	// Interval is the time in nanoseconds between maintenance runs.
+	Interval int64 `json:"interval"`
+	// Compact enables merging small logs, as with "zar compact".
+	Compact bool `json:"compact,omitempty"`
+	// Retain, if positive, is the age in nanoseconds beyond which logs
+	// are removed, as with "zar retain".
+	Retain int64 `json:"retain,omitempty"`
+}
+
 type Summary struct {
 	Kind      Kind
 	Span      nano.Span
@@ -62,4 +75,8 @@ type Storage interface {
 	Open(ctx context.Context, span nano.Span) (zbuf.ReadCloser, error)
 	Summary(ctx context.Context) (Summary, error)
 	NativeDirection() zbuf.Direction
+	// Version returns a number that changes whenever the data in
+	// storage changes, so that results computed from the data may be
+	// reused until it does.
+	Version(ctx context.Context) (int64, error)
 }
@@ -0,0 +1,113 @@
+package zqd
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/zqd/api"
+	"github.com/brimsec/zq/zqd/search"
+)
+
+// taskRegistry tracks the running search tasks so that they can be listed
+// and canceled by clients other than the one that started them.
+type taskRegistry struct {
+	mu    sync.Mutex
+	tasks map[int64]*task
+}
+
+type task struct {
+	info   api.TaskInfo
+	cancel context.CancelFunc
+}
+
+func newTaskRegistry() *taskRegistry {
+	return &taskRegistry{tasks: make(map[int64]*task)}
+}
+
+// add registers a task described by info that is canceled by calling
+// cancel.
+func (r *taskRegistry) add(info api.TaskInfo, cancel context.CancelFunc) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.tasks[info.TaskID] = &task{info: info, cancel: cancel}
+}
+
+func (r *taskRegistry) remove(id int64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.tasks, id)
+}
+
+func (r *taskRegistry) update(id int64, stats api.SearchStats) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if t, ok := r.tasks[id]; ok {
+		t.info.UpdateTime = stats.UpdateTime
+		t.info.ScannerStats = stats.ScannerStats
+	}
+}
+
+// list returns the running tasks ordered by ID.
+func (r *taskRegistry) list() []api.TaskInfo {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	infos := make([]api.TaskInfo, 0, len(r.tasks))
+	for _, t := range r.tasks {
+		infos = append(infos, t.info)
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].TaskID < infos[j].TaskID
+	})
+	return infos
+}
+
+func (r *taskRegistry) get(id int64) (api.TaskInfo, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	t, ok := r.tasks[id]
+	if !ok {
+		return api.TaskInfo{}, false
+	}
+	return t.info, true
+}
+
+// cancel cancels the task with the given ID and reports whether it was
+// running.  The task remains registered until it ends.
+func (r *taskRegistry) cancel(id int64) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	t, ok := r.tasks[id]
+	if ok {
+		t.cancel()
+	}
+	return ok
+}
+
+// taskOutput is a search.Output that records the progress reported in
+// the search stats it sends in the task registry.
+type taskOutput struct {
+	search.Output
+	tasks *taskRegistry
+	id    int64
+}
+
+func (o *taskOutput) SendControl(msg interface{}) error {
+	if stats, ok := msg.(api.SearchStats); ok {
+		o.tasks.update(o.id, stats)
+	}
+	return o.Output.SendControl(msg)
+}
+
+func newSearchTaskInfo(id int64, req api.SearchRequest) api.TaskInfo {
+	now := nano.Now()
+	return api.TaskInfo{
+		TaskID:     id,
+		Kind:       "search",
+		Space:      req.Space,
+		Proc:       req.Proc,
+		StartTime:  now,
+		UpdateTime: now,
+	}
+}
@@ -34,6 +34,14 @@ type Launcher func(context.Context, io.Reader, string) (Process, error)
 // - expects to receive a pcap file on stdin
 // - writes the resulting zeek logs into its working directory
 func LauncherFromPath(zeekpath string) (Launcher, error) {
+	return NewLauncher("zeek", zeekpath)
+}
+
+// NewLauncher returns a Launcher that runs the executable at path in
+// the same way as one returned by LauncherFromPath, with errors
+// attributed to the program name.  It allows other pcap analyzers (e.g.,
+// Suricata) to be run through a runner script like zeek is.
+func NewLauncher(name, path string) (Launcher, error) {
 	var cmdline []string
 
 	if runtime.GOOS == "windows" {
@@ -43,27 +51,28 @@ func LauncherFromPath(zeekpath string) (Launcher, error) {
 		if err != nil {
 			return nil, fmt.Errorf("cant get executable path for zqd")
 		}
-		cmdline = []string{zqdexec, "winexec", zeekpath}
+		cmdline = []string{zqdexec, "winexec", path}
 	} else {
-		cmdline = []string{zeekpath}
+		cmdline = []string{path}
 	}
 
 	return func(ctx context.Context, r io.Reader, dir string) (Process, error) {
-		p := newProcess(ctx, r, cmdline[0], cmdline[1:], dir)
+		p := newProcess(ctx, r, name, cmdline[0], cmdline[1:], dir)
 		return p, p.start()
 	}, nil
 }
 
 type process struct {
+	name      string
 	cmd       *exec.Cmd
 	stderrBuf *bytes.Buffer
 }
 
-func newProcess(ctx context.Context, pcap io.Reader, name string, args []string, outdir string) *process {
-	cmd := exec.CommandContext(ctx, name, args...)
+func newProcess(ctx context.Context, pcap io.Reader, name, prog string, args []string, outdir string) *process {
+	cmd := exec.CommandContext(ctx, prog, args...)
 	cmd.Dir = outdir
 	cmd.Stdin = pcap
-	p := &process{cmd: cmd, stderrBuf: bytes.NewBuffer(nil)}
+	p := &process{name: name, cmd: cmd, stderrBuf: bytes.NewBuffer(nil)}
 	// Capture stderr for error reporting.
 	cmd.Stderr = p.stderrBuf
 	return p
@@ -74,11 +83,11 @@ func (p *process) wrapError(err error) error {
 	if errors.As(err, &exitErr) {
 		stderr := p.stderrBuf.String()
 		stderr = strings.TrimSpace(stderr)
-		return fmt.Errorf("zeek exited with status %d: %s", exitErr.ExitCode(), stderr)
+		return fmt.Errorf("%s exited with status %d: %s", p.name, exitErr.ExitCode(), stderr)
 	}
 	var pathErr *os.PathError
 	if errors.As(err, &pathErr) {
-		return fmt.Errorf("error executing zeek runner: %s: %v", pathErr.Path, pathErr.Err)
+		return fmt.Errorf("error executing %s runner: %s: %v", p.name, pathErr.Path, pathErr.Err)
 	}
 	return err
 }
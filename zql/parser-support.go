@@ -119,6 +119,13 @@ func makeCompareField(comparatorIn, fieldIn, valueIn interface{}) *ast.CompareFi
 	return &ast.CompareField{ast.Node{"CompareField"}, comparator, field, *value}
 }
 
+func makeCompareExpr(comparatorIn, exprIn, valueIn interface{}) *ast.CompareExpr {
+	comparator := comparatorIn.(string)
+	expr := exprIn.(ast.Expression)
+	value := valueIn.(*ast.Literal)
+	return &ast.CompareExpr{ast.Node{"CompareExpr"}, comparator, expr, *value}
+}
+
 func makeCompareAny(comparatorIn, recurseIn, valueIn interface{}) *ast.CompareAny {
 	comparator := comparatorIn.(string)
 	recurse := recurseIn.(bool)
@@ -273,12 +280,73 @@ func makePutProc(first, rest interface{}) *ast.PutProc {
 	return &ast.PutProc{ast.Node{"PutProc"}, clauses}
 }
 
+func makeFieldRename(targetIn, sourceIn interface{}) ast.FieldRename {
+	return ast.FieldRename{targetIn.(string), sourceIn.(string)}
+}
+
+func makeRenameProc(first, rest interface{}) *ast.RenameProc {
+	fields := []ast.FieldRename{first.(ast.FieldRename)}
+	for _, f := range rest.([]interface{}) {
+		fields = append(fields, f.(ast.FieldRename))
+	}
+	return &ast.RenameProc{ast.Node{"RenameProc"}, fields}
+}
+
+func makeFuseProc() *ast.FuseProc {
+	return &ast.FuseProc{ast.Node{"FuseProc"}}
+}
+
+func makeJoinProc(argsIn, firstIn, restIn interface{}) (*ast.JoinProc, error) {
+	kind := "inner"
+	argsArray := argsIn.([]interface{})
+	if len(argsArray) > 1 {
+		return nil, fmt.Errorf("Only one of -inner, -left, or -anti may be specified")
+	}
+	if len(argsArray) == 1 {
+		kind = argsArray[0].(*ProcArg).Name
+	}
+	var leftKeys, rightKeys []string
+	keys := append([]interface{}{firstIn}, restIn.([]interface{})...)
+	for _, k := range keys {
+		pair := k.([]interface{})
+		leftKeys = append(leftKeys, pair[0].(string))
+		rightKeys = append(rightKeys, pair[1].(string))
+	}
+	return &ast.JoinProc{ast.Node{"JoinProc"}, kind, leftKeys, rightKeys}, nil
+}
+
+func makeRunningProc(windowIn, reducersIn, keysIn interface{}) *ast.RunningProc {
+	proc := &ast.RunningProc{
+		Node:     ast.Node{"RunningProc"},
+		Reducers: reducersArray(reducersIn),
+	}
+	if windowIn != nil {
+		window := windowIn.([]interface{})
+		switch window[0] {
+		case "rows":
+			proc.Rows = window[1].(int)
+		case "span":
+			proc.Span = *(window[1].(*ast.Duration))
+		}
+	}
+	if keysIn != nil {
+		proc.Keys = stringArray(keysIn)
+	}
+	return proc
+}
+
 func makeReducer(opIn, varIn, fieldIn interface{}) *ast.Reducer {
 	var field ast.FieldExpr
 	if fieldIn != nil {
 		field = fieldIn.(ast.FieldExpr)
 	}
-	return &ast.Reducer{ast.Node{opIn.(string)}, varIn.(string), field}
+	return &ast.Reducer{ast.Node{opIn.(string)}, varIn.(string), field, ""}
+}
+
+func makeParamReducer(opIn, varIn, fieldIn, paramIn interface{}) *ast.Reducer {
+	reducer := makeReducer(opIn, varIn, fieldIn)
+	reducer.Param = paramIn.(string)
+	return reducer
 }
 
 func overrideReducerVar(reducerIn, varIn interface{}) *ast.Reducer {
@@ -313,6 +381,43 @@ func makeGroupByKey(textIn, valIn interface{}) ast.Assignment {
 	return ast.Assignment{text, val}
 }
 
+// makeFunctionKey returns a key for a function call, which, like a reducer,
+// is named after its function.  So that keys computed from different fields
+// have different names, the name of the first argument that is a field is
+// appended with its dots replaced by underscores, e.g., the key for
+// Net.mask(id.orig_h, 24) is named "mask_id_orig_h".
+func makeFunctionKey(callIn interface{}) ast.Assignment {
+	call := callIn.(*ast.FunctionCall)
+	name := call.Function[strings.LastIndexByte(call.Function, '.')+1:]
+	for _, arg := range call.Args {
+		if field, ok := fieldOfExpr(arg); ok {
+			name += "_" + strings.ReplaceAll(field, ".", "_")
+			break
+		}
+	}
+	return ast.Assignment{name, call}
+}
+
+// fieldOfExpr returns the dotted name of the field read by e if e is a
+// field reference.
+func fieldOfExpr(e ast.Expression) (string, bool) {
+	switch e := e.(type) {
+	case *ast.FieldRead:
+		return e.Field, true
+	case *ast.BinaryExpression:
+		if e.Operator != "." {
+			return "", false
+		}
+		lhs, ok := fieldOfExpr(e.LHS)
+		rhs, isLiteral := e.RHS.(*ast.Literal)
+		if !ok || !isLiteral {
+			return "", false
+		}
+		return lhs + "." + rhs.Value, true
+	}
+	return "", false
+}
+
 func makeGroupByKeys(first, rest interface{}) []ast.Assignment {
 	keys := []ast.Assignment{first.(ast.Assignment)}
 	for _, k := range rest.([]interface{}) {
@@ -43,6 +43,10 @@ function makeCompareField(comparator, field, value) {
   return { op: "CompareField", comparator, field, value };
 }
 
+function makeCompareExpr(comparator, expr, value) {
+  return { op: "CompareExpr", comparator, expr, value };
+}
+
 function makeCompareAny(comparator, recursive, value) {
   return { op: "CompareAny", comparator, recursive, value };
 }
@@ -111,10 +115,41 @@ function makeAssignment(target, expression) { return { target, expression }; }
 function makePutProc(first, rest) {
   return { op: "PutProc", clauses: [first, ...rest] };
 }
+function makeFieldRename(target, source) { return { target, source }; }
+function makeRenameProc(first, rest) {
+  return { op: "RenameProc", fields: [first, ...rest] };
+}
+function makeFuseProc() { return { op: "FuseProc" }; }
+function makeJoinProc(args, first, rest) {
+  if (args.length > 1) {
+    throw new Error(`Only one of -inner, -left, or -anti may be specified`);
+  }
+  let kind = args.length == 1 ? args[0].name : "inner";
+  let keys = [first, ...rest];
+  return {
+    op: "JoinProc",
+    kind,
+    left_keys: keys.map(k => k[0]),
+    right_keys: keys.map(k => k[1]),
+  };
+}
+function makeRunningProc(window, reducers, keys) {
+  let proc = { op: "RunningProc", reducers };
+  if (window) {
+    proc[window[0]] = window[1];
+  }
+  if (keys) {
+    proc.keys = keys;
+  }
+  return proc;
+}
 function makeReducer(op, var_, field) {
   if (field === null) { field = undefined; }
   return { op, var: var_, field };
 }
+function makeParamReducer(op, var_, field, param) {
+  return { op, var: var_, field, param };
+}
 function overrideReducerVar(reducer, v) {
   reducer.var = v;
   return reducer;
@@ -132,6 +167,31 @@ function makeGroupByKey(target, expression) {
     return { op: "Assignment", target, expression };
 }
 
+function makeFunctionKey(call) {
+    let name = call.function.substring(call.function.lastIndexOf(".") + 1);
+    for (let arg of call.args) {
+        let field = fieldOfExpr(arg);
+        if (field !== null) {
+            name += "_" + field.replace(/\./g, "_");
+            break;
+        }
+    }
+    return makeGroupByKey(name, call);
+}
+
+function fieldOfExpr(e) {
+    if (e.op === "FieldRead") {
+        return e.field;
+    }
+    if (e.op === "BinaryExpr" && e.operator === "." && e.rhs.op === "Literal") {
+        let lhs = fieldOfExpr(e.lhs);
+        if (lhs !== null) {
+            return lhs + "." + e.rhs.value;
+        }
+    }
+    return null;
+}
+
 function makeGroupByKeys(first, rest) {
   return [first, ...rest];
 }
@@ -628,32 +628,91 @@ var g = &grammar{
 							exprs: []interface{}{
 								&labeledExpr{
 									pos:   position{line: 76, col: 5, offset: 1833},
-									label: "v",
+									label: "e",
 									expr: &ruleRefExpr{
 										pos:  position{line: 76, col: 7, offset: 1835},
+										name: "searchFunction",
+									},
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 76, col: 22, offset: 1850},
+									expr: &ruleRefExpr{
+										pos:  position{line: 76, col: 22, offset: 1850},
+										name: "_",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 76, col: 25, offset: 1853},
+									label: "fieldComparator",
+									expr: &ruleRefExpr{
+										pos:  position{line: 76, col: 41, offset: 1869},
+										name: "equalityToken",
+									},
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 76, col: 55, offset: 1883},
+									expr: &ruleRefExpr{
+										pos:  position{line: 76, col: 55, offset: 1883},
+										name: "_",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 76, col: 58, offset: 1886},
+									label: "v",
+									expr: &ruleRefExpr{
+										pos:  position{line: 76, col: 60, offset: 1888},
+										name: "searchValue",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 79, col: 5, offset: 1969},
+						run: (*parser).callonsearchPred48,
+						expr: &labeledExpr{
+							pos:   position{line: 79, col: 5, offset: 1969},
+							label: "e",
+							expr: &ruleRefExpr{
+								pos:  position{line: 79, col: 7, offset: 1971},
+								name: "searchFunction",
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 82, col: 5, offset: 2069},
+						run: (*parser).callonsearchPred51,
+						expr: &seqExpr{
+							pos: position{line: 82, col: 5, offset: 2069},
+							exprs: []interface{}{
+								&labeledExpr{
+									pos:   position{line: 82, col: 5, offset: 2069},
+									label: "v",
+									expr: &ruleRefExpr{
+										pos:  position{line: 82, col: 7, offset: 2071},
 										name: "searchValue",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 76, col: 19, offset: 1847},
+									pos: position{line: 82, col: 19, offset: 2083},
 									expr: &ruleRefExpr{
-										pos:  position{line: 76, col: 19, offset: 1847},
+										pos:  position{line: 82, col: 19, offset: 2083},
 										name: "_",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 76, col: 22, offset: 1850},
+									pos:  position{line: 82, col: 22, offset: 2086},
 									name: "inToken",
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 76, col: 30, offset: 1858},
+									pos: position{line: 82, col: 30, offset: 2094},
 									expr: &ruleRefExpr{
-										pos:  position{line: 76, col: 30, offset: 1858},
+										pos:  position{line: 82, col: 30, offset: 2094},
 										name: "_",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 76, col: 33, offset: 1861},
+									pos:        position{line: 82, col: 33, offset: 2097},
 									val:        "*",
 									ignoreCase: false,
 								},
@@ -661,42 +720,42 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 79, col: 5, offset: 1926},
-						run: (*parser).callonsearchPred46,
+						pos: position{line: 85, col: 5, offset: 2162},
+						run: (*parser).callonsearchPred61,
 						expr: &seqExpr{
-							pos: position{line: 79, col: 5, offset: 1926},
+							pos: position{line: 85, col: 5, offset: 2162},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 79, col: 5, offset: 1926},
+									pos:   position{line: 85, col: 5, offset: 2162},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 79, col: 7, offset: 1928},
+										pos:  position{line: 85, col: 7, offset: 2164},
 										name: "searchValue",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 79, col: 19, offset: 1940},
+									pos: position{line: 85, col: 19, offset: 2176},
 									expr: &ruleRefExpr{
-										pos:  position{line: 79, col: 19, offset: 1940},
+										pos:  position{line: 85, col: 19, offset: 2176},
 										name: "_",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 79, col: 22, offset: 1943},
+									pos:  position{line: 85, col: 22, offset: 2179},
 									name: "inToken",
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 79, col: 30, offset: 1951},
+									pos: position{line: 85, col: 30, offset: 2187},
 									expr: &ruleRefExpr{
-										pos:  position{line: 79, col: 30, offset: 1951},
+										pos:  position{line: 85, col: 30, offset: 2187},
 										name: "_",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 79, col: 33, offset: 1954},
+									pos:   position{line: 85, col: 33, offset: 2190},
 									label: "f",
 									expr: &ruleRefExpr{
-										pos:  position{line: 79, col: 35, offset: 1956},
+										pos:  position{line: 85, col: 35, offset: 2192},
 										name: "fieldReference",
 									},
 								},
@@ -704,44 +763,44 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 82, col: 5, offset: 2030},
-						run: (*parser).callonsearchPred57,
+						pos: position{line: 88, col: 5, offset: 2266},
+						run: (*parser).callonsearchPred72,
 						expr: &labeledExpr{
-							pos:   position{line: 82, col: 5, offset: 2030},
+							pos:   position{line: 88, col: 5, offset: 2266},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 82, col: 7, offset: 2032},
+								pos:  position{line: 88, col: 7, offset: 2268},
 								name: "searchLiteral",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 85, col: 5, offset: 2113},
-						run: (*parser).callonsearchPred60,
+						pos: position{line: 91, col: 5, offset: 2349},
+						run: (*parser).callonsearchPred75,
 						expr: &seqExpr{
-							pos: position{line: 85, col: 5, offset: 2113},
+							pos: position{line: 91, col: 5, offset: 2349},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 85, col: 5, offset: 2113},
+									pos: position{line: 91, col: 5, offset: 2349},
 									expr: &seqExpr{
-										pos: position{line: 85, col: 7, offset: 2115},
+										pos: position{line: 91, col: 7, offset: 2351},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 85, col: 8, offset: 2116},
+												pos:  position{line: 91, col: 8, offset: 2352},
 												name: "searchKeywords",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 85, col: 24, offset: 2132},
+												pos:  position{line: 91, col: 24, offset: 2368},
 												name: "_",
 											},
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 85, col: 28, offset: 2136},
+									pos:   position{line: 91, col: 28, offset: 2372},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 85, col: 30, offset: 2138},
+										pos:  position{line: 91, col: 30, offset: 2374},
 										name: "searchWord",
 									},
 								},
@@ -751,54 +810,140 @@ var g = &grammar{
 				},
 			},
 		},
+		{
+			name: "searchFunction",
+			pos:  position{line: 97, col: 1, offset: 2607},
+			expr: &actionExpr{
+				pos: position{line: 98, col: 5, offset: 2626},
+				run: (*parser).callonsearchFunction1,
+				expr: &seqExpr{
+					pos: position{line: 98, col: 5, offset: 2626},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 98, col: 5, offset: 2626},
+							label: "fn",
+							expr: &ruleRefExpr{
+								pos:  position{line: 98, col: 8, offset: 2629},
+								name: "qualifiedFunctionName",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 98, col: 30, offset: 2651},
+							name: "__",
+						},
+						&litMatcher{
+							pos:        position{line: 98, col: 33, offset: 2654},
+							val:        "(",
+							ignoreCase: false,
+						},
+						&labeledExpr{
+							pos:   position{line: 98, col: 37, offset: 2658},
+							label: "args",
+							expr: &ruleRefExpr{
+								pos:  position{line: 98, col: 42, offset: 2663},
+								name: "ArgumentList",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 98, col: 55, offset: 2676},
+							name: "__",
+						},
+						&litMatcher{
+							pos:        position{line: 98, col: 58, offset: 2679},
+							val:        ")",
+							ignoreCase: false,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "qualifiedFunctionName",
+			pos:  position{line: 102, col: 1, offset: 2737},
+			expr: &actionExpr{
+				pos: position{line: 103, col: 5, offset: 2763},
+				run: (*parser).callonqualifiedFunctionName1,
+				expr: &seqExpr{
+					pos: position{line: 103, col: 5, offset: 2763},
+					exprs: []interface{}{
+						&oneOrMoreExpr{
+							pos: position{line: 103, col: 5, offset: 2763},
+							expr: &charClassMatcher{
+								pos:        position{line: 103, col: 5, offset: 2763},
+								val:        "[A-Za-z]",
+								ranges:     []rune{'A', 'Z', 'a', 'z'},
+								ignoreCase: false,
+								inverted:   false,
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 103, col: 15, offset: 2773},
+							val:        ".",
+							ignoreCase: false,
+						},
+						&ruleRefExpr{
+							pos:  position{line: 103, col: 19, offset: 2777},
+							name: "FunctionNameStart",
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 103, col: 37, offset: 2795},
+							expr: &ruleRefExpr{
+								pos:  position{line: 103, col: 37, offset: 2795},
+								name: "FunctionNameRest",
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "searchLiteral",
-			pos:  position{line: 89, col: 1, offset: 2235},
+			pos:  position{line: 105, col: 1, offset: 2845},
 			expr: &choiceExpr{
-				pos: position{line: 90, col: 5, offset: 2253},
+				pos: position{line: 106, col: 5, offset: 2863},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 90, col: 5, offset: 2253},
+						pos:  position{line: 106, col: 5, offset: 2863},
 						name: "StringLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 91, col: 5, offset: 2271},
+						pos:  position{line: 107, col: 5, offset: 2881},
 						name: "RegexpLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 92, col: 5, offset: 2289},
+						pos:  position{line: 108, col: 5, offset: 2899},
 						name: "PortLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 93, col: 5, offset: 2305},
+						pos:  position{line: 109, col: 5, offset: 2915},
 						name: "SubnetLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 94, col: 5, offset: 2323},
+						pos:  position{line: 110, col: 5, offset: 2933},
 						name: "AddressLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 95, col: 5, offset: 2342},
+						pos:  position{line: 111, col: 5, offset: 2952},
 						name: "FloatLiteral",
 					},
 					&actionExpr{
-						pos: position{line: 99, col: 5, offset: 2509},
+						pos: position{line: 115, col: 5, offset: 3119},
 						run: (*parser).callonsearchLiteral8,
 						expr: &seqExpr{
-							pos: position{line: 99, col: 5, offset: 2509},
+							pos: position{line: 115, col: 5, offset: 3119},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 99, col: 5, offset: 2509},
+									pos:   position{line: 115, col: 5, offset: 3119},
 									label: "i",
 									expr: &ruleRefExpr{
-										pos:  position{line: 99, col: 7, offset: 2511},
+										pos:  position{line: 115, col: 7, offset: 3121},
 										name: "IntegerLiteral",
 									},
 								},
 								&notExpr{
-									pos: position{line: 99, col: 22, offset: 2526},
+									pos: position{line: 115, col: 22, offset: 3136},
 									expr: &ruleRefExpr{
-										pos:  position{line: 99, col: 23, offset: 2527},
+										pos:  position{line: 115, col: 23, offset: 3137},
 										name: "searchWord",
 									},
 								},
@@ -806,32 +951,32 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 101, col: 5, offset: 2561},
+						pos: position{line: 117, col: 5, offset: 3171},
 						run: (*parser).callonsearchLiteral14,
 						expr: &seqExpr{
-							pos: position{line: 101, col: 5, offset: 2561},
+							pos: position{line: 117, col: 5, offset: 3171},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 101, col: 5, offset: 2561},
+									pos: position{line: 117, col: 5, offset: 3171},
 									expr: &seqExpr{
-										pos: position{line: 101, col: 7, offset: 2563},
+										pos: position{line: 117, col: 7, offset: 3173},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 101, col: 7, offset: 2563},
+												pos:  position{line: 117, col: 7, offset: 3173},
 												name: "searchKeywords",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 101, col: 22, offset: 2578},
+												pos:  position{line: 117, col: 22, offset: 3188},
 												name: "_",
 											},
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 101, col: 25, offset: 2581},
+									pos:   position{line: 117, col: 25, offset: 3191},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 101, col: 27, offset: 2583},
+										pos:  position{line: 117, col: 27, offset: 3193},
 										name: "BooleanLiteral",
 									},
 								},
@@ -839,32 +984,32 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 102, col: 5, offset: 2620},
+						pos: position{line: 118, col: 5, offset: 3230},
 						run: (*parser).callonsearchLiteral22,
 						expr: &seqExpr{
-							pos: position{line: 102, col: 5, offset: 2620},
+							pos: position{line: 118, col: 5, offset: 3230},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 102, col: 5, offset: 2620},
+									pos: position{line: 118, col: 5, offset: 3230},
 									expr: &seqExpr{
-										pos: position{line: 102, col: 7, offset: 2622},
+										pos: position{line: 118, col: 7, offset: 3232},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 102, col: 7, offset: 2622},
+												pos:  position{line: 118, col: 7, offset: 3232},
 												name: "searchKeywords",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 102, col: 22, offset: 2637},
+												pos:  position{line: 118, col: 22, offset: 3247},
 												name: "_",
 											},
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 102, col: 25, offset: 2640},
+									pos:   position{line: 118, col: 25, offset: 3250},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 102, col: 27, offset: 2642},
+										pos:  position{line: 118, col: 27, offset: 3252},
 										name: "NullLiteral",
 									},
 								},
@@ -876,41 +1021,41 @@ var g = &grammar{
 		},
 		{
 			name: "searchValue",
-			pos:  position{line: 105, col: 1, offset: 2674},
+			pos:  position{line: 121, col: 1, offset: 3284},
 			expr: &choiceExpr{
-				pos: position{line: 106, col: 5, offset: 2690},
+				pos: position{line: 122, col: 5, offset: 3300},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 106, col: 5, offset: 2690},
+						pos:  position{line: 122, col: 5, offset: 3300},
 						name: "searchLiteral",
 					},
 					&actionExpr{
-						pos: position{line: 107, col: 5, offset: 2708},
+						pos: position{line: 123, col: 5, offset: 3318},
 						run: (*parser).callonsearchValue3,
 						expr: &seqExpr{
-							pos: position{line: 107, col: 5, offset: 2708},
+							pos: position{line: 123, col: 5, offset: 3318},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 107, col: 5, offset: 2708},
+									pos: position{line: 123, col: 5, offset: 3318},
 									expr: &seqExpr{
-										pos: position{line: 107, col: 7, offset: 2710},
+										pos: position{line: 123, col: 7, offset: 3320},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 107, col: 8, offset: 2711},
+												pos:  position{line: 123, col: 8, offset: 3321},
 												name: "searchKeywords",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 107, col: 24, offset: 2727},
+												pos:  position{line: 123, col: 24, offset: 3337},
 												name: "_",
 											},
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 107, col: 27, offset: 2730},
+									pos:   position{line: 123, col: 27, offset: 3340},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 107, col: 29, offset: 2732},
+										pos:  position{line: 123, col: 29, offset: 3342},
 										name: "searchWord",
 									},
 								},
@@ -922,15 +1067,15 @@ var g = &grammar{
 		},
 		{
 			name: "StringLiteral",
-			pos:  position{line: 111, col: 1, offset: 2795},
+			pos:  position{line: 127, col: 1, offset: 3405},
 			expr: &actionExpr{
-				pos: position{line: 112, col: 5, offset: 2813},
+				pos: position{line: 128, col: 5, offset: 3423},
 				run: (*parser).callonStringLiteral1,
 				expr: &labeledExpr{
-					pos:   position{line: 112, col: 5, offset: 2813},
+					pos:   position{line: 128, col: 5, offset: 3423},
 					label: "v",
 					expr: &ruleRefExpr{
-						pos:  position{line: 112, col: 7, offset: 2815},
+						pos:  position{line: 128, col: 7, offset: 3425},
 						name: "quotedString",
 					},
 				},
@@ -938,15 +1083,15 @@ var g = &grammar{
 		},
 		{
 			name: "RegexpLiteral",
-			pos:  position{line: 116, col: 1, offset: 2880},
+			pos:  position{line: 132, col: 1, offset: 3490},
 			expr: &actionExpr{
-				pos: position{line: 117, col: 5, offset: 2898},
+				pos: position{line: 133, col: 5, offset: 3508},
 				run: (*parser).callonRegexpLiteral1,
 				expr: &labeledExpr{
-					pos:   position{line: 117, col: 5, offset: 2898},
+					pos:   position{line: 133, col: 5, offset: 3508},
 					label: "v",
 					expr: &ruleRefExpr{
-						pos:  position{line: 117, col: 7, offset: 2900},
+						pos:  position{line: 133, col: 7, offset: 3510},
 						name: "reString",
 					},
 				},
@@ -954,15 +1099,15 @@ var g = &grammar{
 		},
 		{
 			name: "PortLiteral",
-			pos:  position{line: 121, col: 1, offset: 2961},
+			pos:  position{line: 137, col: 1, offset: 3571},
 			expr: &actionExpr{
-				pos: position{line: 122, col: 5, offset: 2977},
+				pos: position{line: 138, col: 5, offset: 3587},
 				run: (*parser).callonPortLiteral1,
 				expr: &labeledExpr{
-					pos:   position{line: 122, col: 5, offset: 2977},
+					pos:   position{line: 138, col: 5, offset: 3587},
 					label: "v",
 					expr: &ruleRefExpr{
-						pos:  position{line: 122, col: 7, offset: 2979},
+						pos:  position{line: 138, col: 7, offset: 3589},
 						name: "port",
 					},
 				},
@@ -970,30 +1115,30 @@ var g = &grammar{
 		},
 		{
 			name: "SubnetLiteral",
-			pos:  position{line: 126, col: 1, offset: 3034},
+			pos:  position{line: 142, col: 1, offset: 3644},
 			expr: &choiceExpr{
-				pos: position{line: 127, col: 5, offset: 3052},
+				pos: position{line: 143, col: 5, offset: 3662},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 127, col: 5, offset: 3052},
+						pos: position{line: 143, col: 5, offset: 3662},
 						run: (*parser).callonSubnetLiteral2,
 						expr: &labeledExpr{
-							pos:   position{line: 127, col: 5, offset: 3052},
+							pos:   position{line: 143, col: 5, offset: 3662},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 127, col: 7, offset: 3054},
+								pos:  position{line: 143, col: 7, offset: 3664},
 								name: "ip6subnet",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 130, col: 5, offset: 3116},
+						pos: position{line: 146, col: 5, offset: 3726},
 						run: (*parser).callonSubnetLiteral5,
 						expr: &labeledExpr{
-							pos:   position{line: 130, col: 5, offset: 3116},
+							pos:   position{line: 146, col: 5, offset: 3726},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 130, col: 7, offset: 3118},
+								pos:  position{line: 146, col: 7, offset: 3728},
 								name: "subnet",
 							},
 						},
@@ -1003,30 +1148,30 @@ var g = &grammar{
 		},
 		{
 			name: "AddressLiteral",
-			pos:  position{line: 134, col: 1, offset: 3174},
+			pos:  position{line: 150, col: 1, offset: 3784},
 			expr: &choiceExpr{
-				pos: position{line: 135, col: 5, offset: 3193},
+				pos: position{line: 151, col: 5, offset: 3803},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 135, col: 5, offset: 3193},
+						pos: position{line: 151, col: 5, offset: 3803},
 						run: (*parser).callonAddressLiteral2,
 						expr: &labeledExpr{
-							pos:   position{line: 135, col: 5, offset: 3193},
+							pos:   position{line: 151, col: 5, offset: 3803},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 135, col: 7, offset: 3195},
+								pos:  position{line: 151, col: 7, offset: 3805},
 								name: "ip6addr",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 138, col: 5, offset: 3254},
+						pos: position{line: 154, col: 5, offset: 3864},
 						run: (*parser).callonAddressLiteral5,
 						expr: &labeledExpr{
-							pos:   position{line: 138, col: 5, offset: 3254},
+							pos:   position{line: 154, col: 5, offset: 3864},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 138, col: 7, offset: 3256},
+								pos:  position{line: 154, col: 7, offset: 3866},
 								name: "addr",
 							},
 						},
@@ -1036,15 +1181,15 @@ var g = &grammar{
 		},
 		{
 			name: "FloatLiteral",
-			pos:  position{line: 142, col: 1, offset: 3309},
+			pos:  position{line: 158, col: 1, offset: 3919},
 			expr: &actionExpr{
-				pos: position{line: 143, col: 5, offset: 3326},
+				pos: position{line: 159, col: 5, offset: 3936},
 				run: (*parser).callonFloatLiteral1,
 				expr: &labeledExpr{
-					pos:   position{line: 143, col: 5, offset: 3326},
+					pos:   position{line: 159, col: 5, offset: 3936},
 					label: "v",
 					expr: &ruleRefExpr{
-						pos:  position{line: 143, col: 7, offset: 3328},
+						pos:  position{line: 159, col: 7, offset: 3938},
 						name: "sdouble",
 					},
 				},
@@ -1052,15 +1197,15 @@ var g = &grammar{
 		},
 		{
 			name: "IntegerLiteral",
-			pos:  position{line: 147, col: 1, offset: 3389},
+			pos:  position{line: 163, col: 1, offset: 3999},
 			expr: &actionExpr{
-				pos: position{line: 148, col: 5, offset: 3408},
+				pos: position{line: 164, col: 5, offset: 4018},
 				run: (*parser).callonIntegerLiteral1,
 				expr: &labeledExpr{
-					pos:   position{line: 148, col: 5, offset: 3408},
+					pos:   position{line: 164, col: 5, offset: 4018},
 					label: "v",
 					expr: &ruleRefExpr{
-						pos:  position{line: 148, col: 7, offset: 3410},
+						pos:  position{line: 164, col: 7, offset: 4020},
 						name: "sinteger",
 					},
 				},
@@ -1068,24 +1213,24 @@ var g = &grammar{
 		},
 		{
 			name: "BooleanLiteral",
-			pos:  position{line: 152, col: 1, offset: 3470},
+			pos:  position{line: 168, col: 1, offset: 4080},
 			expr: &choiceExpr{
-				pos: position{line: 153, col: 5, offset: 3489},
+				pos: position{line: 169, col: 5, offset: 4099},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 153, col: 5, offset: 3489},
+						pos: position{line: 169, col: 5, offset: 4099},
 						run: (*parser).callonBooleanLiteral2,
 						expr: &litMatcher{
-							pos:        position{line: 153, col: 5, offset: 3489},
+							pos:        position{line: 169, col: 5, offset: 4099},
 							val:        "true",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 154, col: 5, offset: 3554},
+						pos: position{line: 170, col: 5, offset: 4164},
 						run: (*parser).callonBooleanLiteral4,
 						expr: &litMatcher{
-							pos:        position{line: 154, col: 5, offset: 3554},
+							pos:        position{line: 170, col: 5, offset: 4164},
 							val:        "false",
 							ignoreCase: false,
 						},
@@ -1095,12 +1240,12 @@ var g = &grammar{
 		},
 		{
 			name: "NullLiteral",
-			pos:  position{line: 156, col: 1, offset: 3617},
+			pos:  position{line: 172, col: 1, offset: 4227},
 			expr: &actionExpr{
-				pos: position{line: 157, col: 5, offset: 3633},
+				pos: position{line: 173, col: 5, offset: 4243},
 				run: (*parser).callonNullLiteral1,
 				expr: &litMatcher{
-					pos:        position{line: 157, col: 5, offset: 3633},
+					pos:        position{line: 173, col: 5, offset: 4243},
 					val:        "null",
 					ignoreCase: false,
 				},
@@ -1108,20 +1253,20 @@ var g = &grammar{
 		},
 		{
 			name: "searchKeywords",
-			pos:  position{line: 159, col: 1, offset: 3691},
+			pos:  position{line: 175, col: 1, offset: 4301},
 			expr: &choiceExpr{
-				pos: position{line: 160, col: 5, offset: 3710},
+				pos: position{line: 176, col: 5, offset: 4320},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 160, col: 5, offset: 3710},
+						pos:  position{line: 176, col: 5, offset: 4320},
 						name: "andToken",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 161, col: 5, offset: 3723},
+						pos:  position{line: 177, col: 5, offset: 4333},
 						name: "orToken",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 162, col: 5, offset: 3735},
+						pos:  position{line: 178, col: 5, offset: 4345},
 						name: "inToken",
 					},
 				},
@@ -1129,28 +1274,28 @@ var g = &grammar{
 		},
 		{
 			name: "procList",
-			pos:  position{line: 164, col: 1, offset: 3744},
+			pos:  position{line: 180, col: 1, offset: 4354},
 			expr: &actionExpr{
-				pos: position{line: 165, col: 5, offset: 3757},
+				pos: position{line: 181, col: 5, offset: 4367},
 				run: (*parser).callonprocList1,
 				expr: &seqExpr{
-					pos: position{line: 165, col: 5, offset: 3757},
+					pos: position{line: 181, col: 5, offset: 4367},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 165, col: 5, offset: 3757},
+							pos:   position{line: 181, col: 5, offset: 4367},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 165, col: 11, offset: 3763},
+								pos:  position{line: 181, col: 11, offset: 4373},
 								name: "procChain",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 165, col: 21, offset: 3773},
+							pos:   position{line: 181, col: 21, offset: 4383},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 165, col: 26, offset: 3778},
+								pos: position{line: 181, col: 26, offset: 4388},
 								expr: &ruleRefExpr{
-									pos:  position{line: 165, col: 26, offset: 3778},
+									pos:  position{line: 181, col: 26, offset: 4388},
 									name: "parallelChain",
 								},
 							},
@@ -1161,37 +1306,37 @@ var g = &grammar{
 		},
 		{
 			name: "parallelChain",
-			pos:  position{line: 174, col: 1, offset: 4002},
+			pos:  position{line: 190, col: 1, offset: 4612},
 			expr: &actionExpr{
-				pos: position{line: 175, col: 5, offset: 4020},
+				pos: position{line: 191, col: 5, offset: 4630},
 				run: (*parser).callonparallelChain1,
 				expr: &seqExpr{
-					pos: position{line: 175, col: 5, offset: 4020},
+					pos: position{line: 191, col: 5, offset: 4630},
 					exprs: []interface{}{
 						&zeroOrOneExpr{
-							pos: position{line: 175, col: 5, offset: 4020},
+							pos: position{line: 191, col: 5, offset: 4630},
 							expr: &ruleRefExpr{
-								pos:  position{line: 175, col: 5, offset: 4020},
+								pos:  position{line: 191, col: 5, offset: 4630},
 								name: "_",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 175, col: 8, offset: 4023},
+							pos:        position{line: 191, col: 8, offset: 4633},
 							val:        ";",
 							ignoreCase: false,
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 175, col: 12, offset: 4027},
+							pos: position{line: 191, col: 12, offset: 4637},
 							expr: &ruleRefExpr{
-								pos:  position{line: 175, col: 12, offset: 4027},
+								pos:  position{line: 191, col: 12, offset: 4637},
 								name: "_",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 175, col: 15, offset: 4030},
+							pos:   position{line: 191, col: 15, offset: 4640},
 							label: "ch",
 							expr: &ruleRefExpr{
-								pos:  position{line: 175, col: 18, offset: 4033},
+								pos:  position{line: 191, col: 18, offset: 4643},
 								name: "procChain",
 							},
 						},
@@ -1201,53 +1346,53 @@ var g = &grammar{
 		},
 		{
 			name: "proc",
-			pos:  position{line: 177, col: 1, offset: 4083},
+			pos:  position{line: 193, col: 1, offset: 4693},
 			expr: &choiceExpr{
-				pos: position{line: 178, col: 5, offset: 4092},
+				pos: position{line: 194, col: 5, offset: 4702},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 178, col: 5, offset: 4092},
+						pos:  position{line: 194, col: 5, offset: 4702},
 						name: "simpleProc",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 179, col: 5, offset: 4107},
+						pos:  position{line: 195, col: 5, offset: 4717},
 						name: "reduceProc",
 					},
 					&actionExpr{
-						pos: position{line: 180, col: 5, offset: 4122},
+						pos: position{line: 196, col: 5, offset: 4732},
 						run: (*parser).callonproc4,
 						expr: &seqExpr{
-							pos: position{line: 180, col: 5, offset: 4122},
+							pos: position{line: 196, col: 5, offset: 4732},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 180, col: 5, offset: 4122},
+									pos:        position{line: 196, col: 5, offset: 4732},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 180, col: 9, offset: 4126},
+									pos: position{line: 196, col: 9, offset: 4736},
 									expr: &ruleRefExpr{
-										pos:  position{line: 180, col: 9, offset: 4126},
+										pos:  position{line: 196, col: 9, offset: 4736},
 										name: "_",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 180, col: 12, offset: 4129},
+									pos:   position{line: 196, col: 12, offset: 4739},
 									label: "proc",
 									expr: &ruleRefExpr{
-										pos:  position{line: 180, col: 17, offset: 4134},
+										pos:  position{line: 196, col: 17, offset: 4744},
 										name: "procList",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 180, col: 26, offset: 4143},
+									pos: position{line: 196, col: 26, offset: 4753},
 									expr: &ruleRefExpr{
-										pos:  position{line: 180, col: 26, offset: 4143},
+										pos:  position{line: 196, col: 26, offset: 4753},
 										name: "_",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 180, col: 29, offset: 4146},
+									pos:        position{line: 196, col: 29, offset: 4756},
 									val:        ")",
 									ignoreCase: false,
 								},
@@ -1259,59 +1404,59 @@ var g = &grammar{
 		},
 		{
 			name: "groupByKeys",
-			pos:  position{line: 184, col: 1, offset: 4182},
+			pos:  position{line: 200, col: 1, offset: 4792},
 			expr: &actionExpr{
-				pos: position{line: 185, col: 5, offset: 4198},
+				pos: position{line: 201, col: 5, offset: 4808},
 				run: (*parser).callongroupByKeys1,
 				expr: &seqExpr{
-					pos: position{line: 185, col: 5, offset: 4198},
+					pos: position{line: 201, col: 5, offset: 4808},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 185, col: 5, offset: 4198},
+							pos:        position{line: 201, col: 5, offset: 4808},
 							val:        "by",
 							ignoreCase: true,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 185, col: 11, offset: 4204},
+							pos:  position{line: 201, col: 11, offset: 4814},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 185, col: 13, offset: 4206},
+							pos:   position{line: 201, col: 13, offset: 4816},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 185, col: 19, offset: 4212},
+								pos:  position{line: 201, col: 19, offset: 4822},
 								name: "groupByKey",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 185, col: 30, offset: 4223},
+							pos:   position{line: 201, col: 30, offset: 4833},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 185, col: 35, offset: 4228},
+								pos: position{line: 201, col: 35, offset: 4838},
 								expr: &actionExpr{
-									pos: position{line: 185, col: 36, offset: 4229},
+									pos: position{line: 201, col: 36, offset: 4839},
 									run: (*parser).callongroupByKeys9,
 									expr: &seqExpr{
-										pos: position{line: 185, col: 36, offset: 4229},
+										pos: position{line: 201, col: 36, offset: 4839},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 185, col: 36, offset: 4229},
+												pos:  position{line: 201, col: 36, offset: 4839},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 185, col: 39, offset: 4232},
+												pos:        position{line: 201, col: 39, offset: 4842},
 												val:        ",",
 												ignoreCase: false,
 											},
 											&ruleRefExpr{
-												pos:  position{line: 185, col: 43, offset: 4236},
+												pos:  position{line: 201, col: 43, offset: 4846},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 185, col: 46, offset: 4239},
+												pos:   position{line: 201, col: 46, offset: 4849},
 												label: "cl",
 												expr: &ruleRefExpr{
-													pos:  position{line: 185, col: 49, offset: 4242},
+													pos:  position{line: 201, col: 49, offset: 4852},
 													name: "groupByKey",
 												},
 											},
@@ -1326,22 +1471,34 @@ var g = &grammar{
 		},
 		{
 			name: "groupByKey",
-			pos:  position{line: 190, col: 1, offset: 4331},
+			pos:  position{line: 206, col: 1, offset: 4941},
 			expr: &choiceExpr{
-				pos: position{line: 191, col: 5, offset: 4346},
+				pos: position{line: 207, col: 5, offset: 4956},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 191, col: 5, offset: 4346},
+						pos:  position{line: 207, col: 5, offset: 4956},
 						name: "Assignment",
 					},
 					&actionExpr{
-						pos: position{line: 192, col: 5, offset: 4361},
+						pos: position{line: 208, col: 5, offset: 4971},
 						run: (*parser).callongroupByKey3,
 						expr: &labeledExpr{
-							pos:   position{line: 192, col: 5, offset: 4361},
+							pos:   position{line: 208, col: 5, offset: 4971},
+							label: "call",
+							expr: &ruleRefExpr{
+								pos:  position{line: 208, col: 10, offset: 4976},
+								name: "searchFunction",
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 209, col: 5, offset: 5033},
+						run: (*parser).callongroupByKey6,
+						expr: &labeledExpr{
+							pos:   position{line: 209, col: 5, offset: 5033},
 							label: "field",
 							expr: &ruleRefExpr{
-								pos:  position{line: 192, col: 11, offset: 4367},
+								pos:  position{line: 209, col: 11, offset: 5039},
 								name: "fieldExpr",
 							},
 						},
@@ -1351,27 +1508,27 @@ var g = &grammar{
 		},
 		{
 			name: "everyDur",
-			pos:  position{line: 195, col: 1, offset: 4433},
+			pos:  position{line: 212, col: 1, offset: 5105},
 			expr: &actionExpr{
-				pos: position{line: 196, col: 5, offset: 4446},
+				pos: position{line: 213, col: 5, offset: 5118},
 				run: (*parser).calloneveryDur1,
 				expr: &seqExpr{
-					pos: position{line: 196, col: 5, offset: 4446},
+					pos: position{line: 213, col: 5, offset: 5118},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 196, col: 5, offset: 4446},
+							pos:        position{line: 213, col: 5, offset: 5118},
 							val:        "every",
 							ignoreCase: true,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 196, col: 14, offset: 4455},
+							pos:  position{line: 213, col: 14, offset: 5127},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 196, col: 16, offset: 4457},
+							pos:   position{line: 213, col: 16, offset: 5129},
 							label: "dur",
 							expr: &ruleRefExpr{
-								pos:  position{line: 196, col: 20, offset: 4461},
+								pos:  position{line: 213, col: 20, offset: 5133},
 								name: "duration",
 							},
 						},
@@ -1381,16 +1538,16 @@ var g = &grammar{
 		},
 		{
 			name: "equalityToken",
-			pos:  position{line: 198, col: 1, offset: 4491},
+			pos:  position{line: 215, col: 1, offset: 5163},
 			expr: &choiceExpr{
-				pos: position{line: 199, col: 5, offset: 4509},
+				pos: position{line: 216, col: 5, offset: 5181},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 199, col: 5, offset: 4509},
+						pos:  position{line: 216, col: 5, offset: 5181},
 						name: "EqualityOperator",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 199, col: 24, offset: 4528},
+						pos:  position{line: 216, col: 24, offset: 5200},
 						name: "RelativeOperator",
 					},
 				},
@@ -1398,12 +1555,12 @@ var g = &grammar{
 		},
 		{
 			name: "andToken",
-			pos:  position{line: 201, col: 1, offset: 4546},
+			pos:  position{line: 218, col: 1, offset: 5218},
 			expr: &actionExpr{
-				pos: position{line: 201, col: 12, offset: 4557},
+				pos: position{line: 218, col: 12, offset: 5229},
 				run: (*parser).callonandToken1,
 				expr: &litMatcher{
-					pos:        position{line: 201, col: 12, offset: 4557},
+					pos:        position{line: 218, col: 12, offset: 5229},
 					val:        "and",
 					ignoreCase: true,
 				},
@@ -1411,12 +1568,12 @@ var g = &grammar{
 		},
 		{
 			name: "orToken",
-			pos:  position{line: 202, col: 1, offset: 4595},
+			pos:  position{line: 219, col: 1, offset: 5267},
 			expr: &actionExpr{
-				pos: position{line: 202, col: 11, offset: 4605},
+				pos: position{line: 219, col: 11, offset: 5277},
 				run: (*parser).callonorToken1,
 				expr: &litMatcher{
-					pos:        position{line: 202, col: 11, offset: 4605},
+					pos:        position{line: 219, col: 11, offset: 5277},
 					val:        "or",
 					ignoreCase: true,
 				},
@@ -1424,12 +1581,12 @@ var g = &grammar{
 		},
 		{
 			name: "inToken",
-			pos:  position{line: 203, col: 1, offset: 4642},
+			pos:  position{line: 220, col: 1, offset: 5314},
 			expr: &actionExpr{
-				pos: position{line: 203, col: 11, offset: 4652},
+				pos: position{line: 220, col: 11, offset: 5324},
 				run: (*parser).calloninToken1,
 				expr: &litMatcher{
-					pos:        position{line: 203, col: 11, offset: 4652},
+					pos:        position{line: 220, col: 11, offset: 5324},
 					val:        "in",
 					ignoreCase: true,
 				},
@@ -1437,12 +1594,12 @@ var g = &grammar{
 		},
 		{
 			name: "notToken",
-			pos:  position{line: 204, col: 1, offset: 4689},
+			pos:  position{line: 221, col: 1, offset: 5361},
 			expr: &actionExpr{
-				pos: position{line: 204, col: 12, offset: 4700},
+				pos: position{line: 221, col: 12, offset: 5372},
 				run: (*parser).callonnotToken1,
 				expr: &litMatcher{
-					pos:        position{line: 204, col: 12, offset: 4700},
+					pos:        position{line: 221, col: 12, offset: 5372},
 					val:        "not",
 					ignoreCase: true,
 				},
@@ -1450,21 +1607,21 @@ var g = &grammar{
 		},
 		{
 			name: "fieldName",
-			pos:  position{line: 206, col: 1, offset: 4739},
+			pos:  position{line: 223, col: 1, offset: 5411},
 			expr: &actionExpr{
-				pos: position{line: 206, col: 13, offset: 4751},
+				pos: position{line: 223, col: 13, offset: 5423},
 				run: (*parser).callonfieldName1,
 				expr: &seqExpr{
-					pos: position{line: 206, col: 13, offset: 4751},
+					pos: position{line: 223, col: 13, offset: 5423},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 206, col: 13, offset: 4751},
+							pos:  position{line: 223, col: 13, offset: 5423},
 							name: "fieldNameStart",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 206, col: 28, offset: 4766},
+							pos: position{line: 223, col: 28, offset: 5438},
 							expr: &ruleRefExpr{
-								pos:  position{line: 206, col: 28, offset: 4766},
+								pos:  position{line: 223, col: 28, offset: 5438},
 								name: "fieldNameRest",
 							},
 						},
@@ -1474,9 +1631,9 @@ var g = &grammar{
 		},
 		{
 			name: "fieldNameStart",
-			pos:  position{line: 208, col: 1, offset: 4813},
+			pos:  position{line: 225, col: 1, offset: 5485},
 			expr: &charClassMatcher{
-				pos:        position{line: 208, col: 18, offset: 4830},
+				pos:        position{line: 225, col: 18, offset: 5502},
 				val:        "[A-Za-z_$]",
 				chars:      []rune{'_', '$'},
 				ranges:     []rune{'A', 'Z', 'a', 'z'},
@@ -1486,16 +1643,16 @@ var g = &grammar{
 		},
 		{
 			name: "fieldNameRest",
-			pos:  position{line: 209, col: 1, offset: 4841},
+			pos:  position{line: 226, col: 1, offset: 5513},
 			expr: &choiceExpr{
-				pos: position{line: 209, col: 17, offset: 4857},
+				pos: position{line: 226, col: 17, offset: 5529},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 209, col: 17, offset: 4857},
+						pos:  position{line: 226, col: 17, offset: 5529},
 						name: "fieldNameStart",
 					},
 					&charClassMatcher{
-						pos:        position{line: 209, col: 34, offset: 4874},
+						pos:        position{line: 226, col: 34, offset: 5546},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
@@ -1506,45 +1663,45 @@ var g = &grammar{
 		},
 		{
 			name: "fieldReference",
-			pos:  position{line: 211, col: 1, offset: 4881},
+			pos:  position{line: 228, col: 1, offset: 5553},
 			expr: &actionExpr{
-				pos: position{line: 212, col: 4, offset: 4899},
+				pos: position{line: 229, col: 4, offset: 5571},
 				run: (*parser).callonfieldReference1,
 				expr: &seqExpr{
-					pos: position{line: 212, col: 4, offset: 4899},
+					pos: position{line: 229, col: 4, offset: 5571},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 212, col: 4, offset: 4899},
+							pos:   position{line: 229, col: 4, offset: 5571},
 							label: "base",
 							expr: &ruleRefExpr{
-								pos:  position{line: 212, col: 9, offset: 4904},
+								pos:  position{line: 229, col: 9, offset: 5576},
 								name: "fieldName",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 212, col: 19, offset: 4914},
+							pos:   position{line: 229, col: 19, offset: 5586},
 							label: "derefs",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 212, col: 26, offset: 4921},
+								pos: position{line: 229, col: 26, offset: 5593},
 								expr: &choiceExpr{
-									pos: position{line: 213, col: 8, offset: 4930},
+									pos: position{line: 230, col: 8, offset: 5602},
 									alternatives: []interface{}{
 										&actionExpr{
-											pos: position{line: 213, col: 8, offset: 4930},
+											pos: position{line: 230, col: 8, offset: 5602},
 											run: (*parser).callonfieldReference8,
 											expr: &seqExpr{
-												pos: position{line: 213, col: 8, offset: 4930},
+												pos: position{line: 230, col: 8, offset: 5602},
 												exprs: []interface{}{
 													&litMatcher{
-														pos:        position{line: 213, col: 8, offset: 4930},
+														pos:        position{line: 230, col: 8, offset: 5602},
 														val:        ".",
 														ignoreCase: false,
 													},
 													&labeledExpr{
-														pos:   position{line: 213, col: 12, offset: 4934},
+														pos:   position{line: 230, col: 12, offset: 5606},
 														label: "field",
 														expr: &ruleRefExpr{
-															pos:  position{line: 213, col: 18, offset: 4940},
+															pos:  position{line: 230, col: 18, offset: 5612},
 															name: "fieldName",
 														},
 													},
@@ -1552,26 +1709,26 @@ var g = &grammar{
 											},
 										},
 										&actionExpr{
-											pos: position{line: 214, col: 8, offset: 5021},
+											pos: position{line: 231, col: 8, offset: 5693},
 											run: (*parser).callonfieldReference13,
 											expr: &seqExpr{
-												pos: position{line: 214, col: 8, offset: 5021},
+												pos: position{line: 231, col: 8, offset: 5693},
 												exprs: []interface{}{
 													&litMatcher{
-														pos:        position{line: 214, col: 8, offset: 5021},
+														pos:        position{line: 231, col: 8, offset: 5693},
 														val:        "[",
 														ignoreCase: false,
 													},
 													&labeledExpr{
-														pos:   position{line: 214, col: 12, offset: 5025},
+														pos:   position{line: 231, col: 12, offset: 5697},
 														label: "index",
 														expr: &ruleRefExpr{
-															pos:  position{line: 214, col: 18, offset: 5031},
+															pos:  position{line: 231, col: 18, offset: 5703},
 															name: "suint",
 														},
 													},
 													&litMatcher{
-														pos:        position{line: 214, col: 24, offset: 5037},
+														pos:        position{line: 231, col: 24, offset: 5709},
 														val:        "]",
 														ignoreCase: false,
 													},
@@ -1588,60 +1745,60 @@ var g = &grammar{
 		},
 		{
 			name: "fieldExpr",
-			pos:  position{line: 219, col: 1, offset: 5153},
+			pos:  position{line: 236, col: 1, offset: 5825},
 			expr: &choiceExpr{
-				pos: position{line: 220, col: 5, offset: 5167},
+				pos: position{line: 237, col: 5, offset: 5839},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 220, col: 5, offset: 5167},
+						pos: position{line: 237, col: 5, offset: 5839},
 						run: (*parser).callonfieldExpr2,
 						expr: &seqExpr{
-							pos: position{line: 220, col: 5, offset: 5167},
+							pos: position{line: 237, col: 5, offset: 5839},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 220, col: 5, offset: 5167},
+									pos:   position{line: 237, col: 5, offset: 5839},
 									label: "op",
 									expr: &ruleRefExpr{
-										pos:  position{line: 220, col: 8, offset: 5170},
+										pos:  position{line: 237, col: 8, offset: 5842},
 										name: "fieldOp",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 220, col: 16, offset: 5178},
+									pos: position{line: 237, col: 16, offset: 5850},
 									expr: &ruleRefExpr{
-										pos:  position{line: 220, col: 16, offset: 5178},
+										pos:  position{line: 237, col: 16, offset: 5850},
 										name: "_",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 220, col: 19, offset: 5181},
+									pos:        position{line: 237, col: 19, offset: 5853},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 220, col: 23, offset: 5185},
+									pos: position{line: 237, col: 23, offset: 5857},
 									expr: &ruleRefExpr{
-										pos:  position{line: 220, col: 23, offset: 5185},
+										pos:  position{line: 237, col: 23, offset: 5857},
 										name: "_",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 220, col: 26, offset: 5188},
+									pos:   position{line: 237, col: 26, offset: 5860},
 									label: "field",
 									expr: &ruleRefExpr{
-										pos:  position{line: 220, col: 32, offset: 5194},
+										pos:  position{line: 237, col: 32, offset: 5866},
 										name: "fieldReference",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 220, col: 47, offset: 5209},
+									pos: position{line: 237, col: 47, offset: 5881},
 									expr: &ruleRefExpr{
-										pos:  position{line: 220, col: 47, offset: 5209},
+										pos:  position{line: 237, col: 47, offset: 5881},
 										name: "_",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 220, col: 50, offset: 5212},
+									pos:        position{line: 237, col: 50, offset: 5884},
 									val:        ")",
 									ignoreCase: false,
 								},
@@ -1649,7 +1806,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 223, col: 5, offset: 5276},
+						pos:  position{line: 240, col: 5, offset: 5948},
 						name: "fieldReference",
 					},
 				},
@@ -1657,12 +1814,12 @@ var g = &grammar{
 		},
 		{
 			name: "fieldOp",
-			pos:  position{line: 225, col: 1, offset: 5292},
+			pos:  position{line: 242, col: 1, offset: 5964},
 			expr: &actionExpr{
-				pos: position{line: 226, col: 5, offset: 5304},
+				pos: position{line: 243, col: 5, offset: 5976},
 				run: (*parser).callonfieldOp1,
 				expr: &litMatcher{
-					pos:        position{line: 226, col: 5, offset: 5304},
+					pos:        position{line: 243, col: 5, offset: 5976},
 					val:        "len",
 					ignoreCase: true,
 				},
@@ -1670,50 +1827,50 @@ var g = &grammar{
 		},
 		{
 			name: "fieldExprList",
-			pos:  position{line: 228, col: 1, offset: 5334},
+			pos:  position{line: 245, col: 1, offset: 6006},
 			expr: &actionExpr{
-				pos: position{line: 229, col: 5, offset: 5352},
+				pos: position{line: 246, col: 5, offset: 6024},
 				run: (*parser).callonfieldExprList1,
 				expr: &seqExpr{
-					pos: position{line: 229, col: 5, offset: 5352},
+					pos: position{line: 246, col: 5, offset: 6024},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 229, col: 5, offset: 5352},
+							pos:   position{line: 246, col: 5, offset: 6024},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 229, col: 11, offset: 5358},
+								pos:  position{line: 246, col: 11, offset: 6030},
 								name: "fieldExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 229, col: 21, offset: 5368},
+							pos:   position{line: 246, col: 21, offset: 6040},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 229, col: 26, offset: 5373},
+								pos: position{line: 246, col: 26, offset: 6045},
 								expr: &seqExpr{
-									pos: position{line: 229, col: 27, offset: 5374},
+									pos: position{line: 246, col: 27, offset: 6046},
 									exprs: []interface{}{
 										&zeroOrOneExpr{
-											pos: position{line: 229, col: 27, offset: 5374},
+											pos: position{line: 246, col: 27, offset: 6046},
 											expr: &ruleRefExpr{
-												pos:  position{line: 229, col: 27, offset: 5374},
+												pos:  position{line: 246, col: 27, offset: 6046},
 												name: "_",
 											},
 										},
 										&litMatcher{
-											pos:        position{line: 229, col: 30, offset: 5377},
+											pos:        position{line: 246, col: 30, offset: 6049},
 											val:        ",",
 											ignoreCase: false,
 										},
 										&zeroOrOneExpr{
-											pos: position{line: 229, col: 34, offset: 5381},
+											pos: position{line: 246, col: 34, offset: 6053},
 											expr: &ruleRefExpr{
-												pos:  position{line: 229, col: 34, offset: 5381},
+												pos:  position{line: 246, col: 34, offset: 6053},
 												name: "_",
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 229, col: 37, offset: 5384},
+											pos:  position{line: 246, col: 37, offset: 6056},
 											name: "fieldExpr",
 										},
 									},
@@ -1726,39 +1883,39 @@ var g = &grammar{
 		},
 		{
 			name: "fieldRefDotOnly",
-			pos:  position{line: 239, col: 1, offset: 5579},
+			pos:  position{line: 256, col: 1, offset: 6251},
 			expr: &actionExpr{
-				pos: position{line: 240, col: 5, offset: 5599},
+				pos: position{line: 257, col: 5, offset: 6271},
 				run: (*parser).callonfieldRefDotOnly1,
 				expr: &seqExpr{
-					pos: position{line: 240, col: 5, offset: 5599},
+					pos: position{line: 257, col: 5, offset: 6271},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 240, col: 5, offset: 5599},
+							pos:   position{line: 257, col: 5, offset: 6271},
 							label: "base",
 							expr: &ruleRefExpr{
-								pos:  position{line: 240, col: 10, offset: 5604},
+								pos:  position{line: 257, col: 10, offset: 6276},
 								name: "fieldName",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 240, col: 20, offset: 5614},
+							pos:   position{line: 257, col: 20, offset: 6286},
 							label: "refs",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 240, col: 25, offset: 5619},
+								pos: position{line: 257, col: 25, offset: 6291},
 								expr: &seqExpr{
-									pos: position{line: 240, col: 26, offset: 5620},
+									pos: position{line: 257, col: 26, offset: 6292},
 									exprs: []interface{}{
 										&litMatcher{
-											pos:        position{line: 240, col: 26, offset: 5620},
+											pos:        position{line: 257, col: 26, offset: 6292},
 											val:        ".",
 											ignoreCase: false,
 										},
 										&labeledExpr{
-											pos:   position{line: 240, col: 30, offset: 5624},
+											pos:   position{line: 257, col: 30, offset: 6296},
 											label: "field",
 											expr: &ruleRefExpr{
-												pos:  position{line: 240, col: 36, offset: 5630},
+												pos:  position{line: 257, col: 36, offset: 6302},
 												name: "fieldName",
 											},
 										},
@@ -1772,56 +1929,56 @@ var g = &grammar{
 		},
 		{
 			name: "fieldRefDotOnlyList",
-			pos:  position{line: 242, col: 1, offset: 5674},
+			pos:  position{line: 259, col: 1, offset: 6346},
 			expr: &actionExpr{
-				pos: position{line: 243, col: 5, offset: 5698},
+				pos: position{line: 260, col: 5, offset: 6370},
 				run: (*parser).callonfieldRefDotOnlyList1,
 				expr: &seqExpr{
-					pos: position{line: 243, col: 5, offset: 5698},
+					pos: position{line: 260, col: 5, offset: 6370},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 243, col: 5, offset: 5698},
+							pos:   position{line: 260, col: 5, offset: 6370},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 243, col: 11, offset: 5704},
+								pos:  position{line: 260, col: 11, offset: 6376},
 								name: "fieldRefDotOnly",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 243, col: 27, offset: 5720},
+							pos:   position{line: 260, col: 27, offset: 6392},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 243, col: 32, offset: 5725},
+								pos: position{line: 260, col: 32, offset: 6397},
 								expr: &actionExpr{
-									pos: position{line: 243, col: 33, offset: 5726},
+									pos: position{line: 260, col: 33, offset: 6398},
 									run: (*parser).callonfieldRefDotOnlyList7,
 									expr: &seqExpr{
-										pos: position{line: 243, col: 33, offset: 5726},
+										pos: position{line: 260, col: 33, offset: 6398},
 										exprs: []interface{}{
 											&zeroOrOneExpr{
-												pos: position{line: 243, col: 33, offset: 5726},
+												pos: position{line: 260, col: 33, offset: 6398},
 												expr: &ruleRefExpr{
-													pos:  position{line: 243, col: 33, offset: 5726},
+													pos:  position{line: 260, col: 33, offset: 6398},
 													name: "_",
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 243, col: 36, offset: 5729},
+												pos:        position{line: 260, col: 36, offset: 6401},
 												val:        ",",
 												ignoreCase: false,
 											},
 											&zeroOrOneExpr{
-												pos: position{line: 243, col: 40, offset: 5733},
+												pos: position{line: 260, col: 40, offset: 6405},
 												expr: &ruleRefExpr{
-													pos:  position{line: 243, col: 40, offset: 5733},
+													pos:  position{line: 260, col: 40, offset: 6405},
 													name: "_",
 												},
 											},
 											&labeledExpr{
-												pos:   position{line: 243, col: 43, offset: 5736},
+												pos:   position{line: 260, col: 43, offset: 6408},
 												label: "ref",
 												expr: &ruleRefExpr{
-													pos:  position{line: 243, col: 47, offset: 5740},
+													pos:  position{line: 260, col: 47, offset: 6412},
 													name: "fieldRefDotOnly",
 												},
 											},
@@ -1836,50 +1993,50 @@ var g = &grammar{
 		},
 		{
 			name: "fieldNameList",
-			pos:  position{line: 251, col: 1, offset: 5920},
+			pos:  position{line: 268, col: 1, offset: 6592},
 			expr: &actionExpr{
-				pos: position{line: 252, col: 5, offset: 5938},
+				pos: position{line: 269, col: 5, offset: 6610},
 				run: (*parser).callonfieldNameList1,
 				expr: &seqExpr{
-					pos: position{line: 252, col: 5, offset: 5938},
+					pos: position{line: 269, col: 5, offset: 6610},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 252, col: 5, offset: 5938},
+							pos:   position{line: 269, col: 5, offset: 6610},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 252, col: 11, offset: 5944},
+								pos:  position{line: 269, col: 11, offset: 6616},
 								name: "fieldName",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 252, col: 21, offset: 5954},
+							pos:   position{line: 269, col: 21, offset: 6626},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 252, col: 26, offset: 5959},
+								pos: position{line: 269, col: 26, offset: 6631},
 								expr: &seqExpr{
-									pos: position{line: 252, col: 27, offset: 5960},
+									pos: position{line: 269, col: 27, offset: 6632},
 									exprs: []interface{}{
 										&zeroOrOneExpr{
-											pos: position{line: 252, col: 27, offset: 5960},
+											pos: position{line: 269, col: 27, offset: 6632},
 											expr: &ruleRefExpr{
-												pos:  position{line: 252, col: 27, offset: 5960},
+												pos:  position{line: 269, col: 27, offset: 6632},
 												name: "_",
 											},
 										},
 										&litMatcher{
-											pos:        position{line: 252, col: 30, offset: 5963},
+											pos:        position{line: 269, col: 30, offset: 6635},
 											val:        ",",
 											ignoreCase: false,
 										},
 										&zeroOrOneExpr{
-											pos: position{line: 252, col: 34, offset: 5967},
+											pos: position{line: 269, col: 34, offset: 6639},
 											expr: &ruleRefExpr{
-												pos:  position{line: 252, col: 34, offset: 5967},
+												pos:  position{line: 269, col: 34, offset: 6639},
 												name: "_",
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 252, col: 37, offset: 5970},
+											pos:  position{line: 269, col: 37, offset: 6642},
 											name: "fieldName",
 										},
 									},
@@ -1892,12 +2049,12 @@ var g = &grammar{
 		},
 		{
 			name: "countOp",
-			pos:  position{line: 260, col: 1, offset: 6163},
+			pos:  position{line: 277, col: 1, offset: 6835},
 			expr: &actionExpr{
-				pos: position{line: 261, col: 5, offset: 6175},
+				pos: position{line: 278, col: 5, offset: 6847},
 				run: (*parser).calloncountOp1,
 				expr: &litMatcher{
-					pos:        position{line: 261, col: 5, offset: 6175},
+					pos:        position{line: 278, col: 5, offset: 6847},
 					val:        "count",
 					ignoreCase: true,
 				},
@@ -1905,140 +2062,167 @@ var g = &grammar{
 		},
 		{
 			name: "fieldReducerOp",
-			pos:  position{line: 263, col: 1, offset: 6209},
+			pos:  position{line: 280, col: 1, offset: 6881},
 			expr: &choiceExpr{
-				pos: position{line: 264, col: 5, offset: 6228},
+				pos: position{line: 281, col: 5, offset: 6900},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 264, col: 5, offset: 6228},
+						pos: position{line: 281, col: 5, offset: 6900},
 						run: (*parser).callonfieldReducerOp2,
 						expr: &litMatcher{
-							pos:        position{line: 264, col: 5, offset: 6228},
+							pos:        position{line: 281, col: 5, offset: 6900},
 							val:        "sum",
 							ignoreCase: true,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 265, col: 5, offset: 6262},
+						pos: position{line: 282, col: 5, offset: 6934},
 						run: (*parser).callonfieldReducerOp4,
 						expr: &litMatcher{
-							pos:        position{line: 265, col: 5, offset: 6262},
+							pos:        position{line: 282, col: 5, offset: 6934},
 							val:        "avg",
 							ignoreCase: true,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 266, col: 5, offset: 6296},
+						pos: position{line: 283, col: 5, offset: 6968},
 						run: (*parser).callonfieldReducerOp6,
 						expr: &litMatcher{
-							pos:        position{line: 266, col: 5, offset: 6296},
+							pos:        position{line: 283, col: 5, offset: 6968},
 							val:        "stdev",
 							ignoreCase: true,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 267, col: 5, offset: 6333},
+						pos: position{line: 284, col: 5, offset: 7005},
 						run: (*parser).callonfieldReducerOp8,
 						expr: &litMatcher{
-							pos:        position{line: 267, col: 5, offset: 6333},
+							pos:        position{line: 284, col: 5, offset: 7005},
 							val:        "sd",
 							ignoreCase: true,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 268, col: 5, offset: 6369},
+						pos: position{line: 285, col: 5, offset: 7041},
 						run: (*parser).callonfieldReducerOp10,
 						expr: &litMatcher{
-							pos:        position{line: 268, col: 5, offset: 6369},
+							pos:        position{line: 285, col: 5, offset: 7041},
 							val:        "var",
 							ignoreCase: true,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 269, col: 5, offset: 6403},
+						pos: position{line: 286, col: 5, offset: 7075},
 						run: (*parser).callonfieldReducerOp12,
 						expr: &litMatcher{
-							pos:        position{line: 269, col: 5, offset: 6403},
+							pos:        position{line: 286, col: 5, offset: 7075},
 							val:        "entropy",
 							ignoreCase: true,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 270, col: 5, offset: 6444},
+						pos: position{line: 287, col: 5, offset: 7116},
 						run: (*parser).callonfieldReducerOp14,
 						expr: &litMatcher{
-							pos:        position{line: 270, col: 5, offset: 6444},
+							pos:        position{line: 287, col: 5, offset: 7116},
 							val:        "min",
 							ignoreCase: true,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 271, col: 5, offset: 6478},
+						pos: position{line: 288, col: 5, offset: 7150},
 						run: (*parser).callonfieldReducerOp16,
 						expr: &litMatcher{
-							pos:        position{line: 271, col: 5, offset: 6478},
+							pos:        position{line: 288, col: 5, offset: 7150},
 							val:        "max",
 							ignoreCase: true,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 272, col: 5, offset: 6512},
+						pos: position{line: 289, col: 5, offset: 7184},
 						run: (*parser).callonfieldReducerOp18,
 						expr: &litMatcher{
-							pos:        position{line: 272, col: 5, offset: 6512},
+							pos:        position{line: 289, col: 5, offset: 7184},
 							val:        "first",
 							ignoreCase: true,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 273, col: 5, offset: 6550},
+						pos: position{line: 290, col: 5, offset: 7222},
 						run: (*parser).callonfieldReducerOp20,
 						expr: &litMatcher{
-							pos:        position{line: 273, col: 5, offset: 6550},
+							pos:        position{line: 290, col: 5, offset: 7222},
 							val:        "last",
 							ignoreCase: true,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 274, col: 5, offset: 6586},
+						pos: position{line: 291, col: 5, offset: 7258},
 						run: (*parser).callonfieldReducerOp22,
 						expr: &litMatcher{
-							pos:        position{line: 274, col: 5, offset: 6586},
+							pos:        position{line: 291, col: 5, offset: 7258},
 							val:        "countdistinct",
 							ignoreCase: true,
 						},
 					},
+					&actionExpr{
+						pos: position{line: 292, col: 5, offset: 7311},
+						run: (*parser).callonfieldReducerOp24,
+						expr: &litMatcher{
+							pos:        position{line: 292, col: 5, offset: 7311},
+							val:        "lag",
+							ignoreCase: true,
+						},
+					},
+					&actionExpr{
+						pos: position{line: 293, col: 5, offset: 7344},
+						run: (*parser).callonfieldReducerOp26,
+						expr: &litMatcher{
+							pos:        position{line: 293, col: 5, offset: 7344},
+							val:        "collect",
+							ignoreCase: true,
+						},
+					},
+					&actionExpr{
+						pos: position{line: 294, col: 5, offset: 7385},
+						run: (*parser).callonfieldReducerOp28,
+						expr: &litMatcher{
+							pos:        position{line: 294, col: 5, offset: 7385},
+							val:        "union",
+							ignoreCase: true,
+						},
+					},
 				},
 			},
 		},
 		{
 			name: "paddedFieldExpr",
-			pos:  position{line: 276, col: 1, offset: 6636},
+			pos:  position{line: 296, col: 1, offset: 7419},
 			expr: &actionExpr{
-				pos: position{line: 276, col: 19, offset: 6654},
+				pos: position{line: 296, col: 19, offset: 7437},
 				run: (*parser).callonpaddedFieldExpr1,
 				expr: &seqExpr{
-					pos: position{line: 276, col: 19, offset: 6654},
+					pos: position{line: 296, col: 19, offset: 7437},
 					exprs: []interface{}{
 						&zeroOrOneExpr{
-							pos: position{line: 276, col: 19, offset: 6654},
+							pos: position{line: 296, col: 19, offset: 7437},
 							expr: &ruleRefExpr{
-								pos:  position{line: 276, col: 19, offset: 6654},
+								pos:  position{line: 296, col: 19, offset: 7437},
 								name: "_",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 276, col: 22, offset: 6657},
+							pos:   position{line: 296, col: 22, offset: 7440},
 							label: "field",
 							expr: &ruleRefExpr{
-								pos:  position{line: 276, col: 28, offset: 6663},
+								pos:  position{line: 296, col: 28, offset: 7446},
 								name: "fieldExpr",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 276, col: 38, offset: 6673},
+							pos: position{line: 296, col: 38, offset: 7456},
 							expr: &ruleRefExpr{
-								pos:  position{line: 276, col: 38, offset: 6673},
+								pos:  position{line: 296, col: 38, offset: 7456},
 								name: "_",
 							},
 						},
@@ -2048,53 +2232,53 @@ var g = &grammar{
 		},
 		{
 			name: "countReducer",
-			pos:  position{line: 278, col: 1, offset: 6699},
+			pos:  position{line: 298, col: 1, offset: 7482},
 			expr: &actionExpr{
-				pos: position{line: 279, col: 5, offset: 6716},
+				pos: position{line: 299, col: 5, offset: 7499},
 				run: (*parser).calloncountReducer1,
 				expr: &seqExpr{
-					pos: position{line: 279, col: 5, offset: 6716},
+					pos: position{line: 299, col: 5, offset: 7499},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 279, col: 5, offset: 6716},
+							pos:   position{line: 299, col: 5, offset: 7499},
 							label: "op",
 							expr: &ruleRefExpr{
-								pos:  position{line: 279, col: 8, offset: 6719},
+								pos:  position{line: 299, col: 8, offset: 7502},
 								name: "countOp",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 279, col: 16, offset: 6727},
+							pos: position{line: 299, col: 16, offset: 7510},
 							expr: &ruleRefExpr{
-								pos:  position{line: 279, col: 16, offset: 6727},
+								pos:  position{line: 299, col: 16, offset: 7510},
 								name: "_",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 279, col: 19, offset: 6730},
+							pos:        position{line: 299, col: 19, offset: 7513},
 							val:        "(",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 279, col: 23, offset: 6734},
+							pos:   position{line: 299, col: 23, offset: 7517},
 							label: "field",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 279, col: 29, offset: 6740},
+								pos: position{line: 299, col: 29, offset: 7523},
 								expr: &ruleRefExpr{
-									pos:  position{line: 279, col: 29, offset: 6740},
+									pos:  position{line: 299, col: 29, offset: 7523},
 									name: "paddedFieldExpr",
 								},
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 279, col: 47, offset: 6758},
+							pos: position{line: 299, col: 47, offset: 7541},
 							expr: &ruleRefExpr{
-								pos:  position{line: 279, col: 47, offset: 6758},
+								pos:  position{line: 299, col: 47, offset: 7541},
 								name: "_",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 279, col: 50, offset: 6761},
+							pos:        position{line: 299, col: 50, offset: 7544},
 							val:        ")",
 							ignoreCase: false,
 						},
@@ -2104,57 +2288,57 @@ var g = &grammar{
 		},
 		{
 			name: "fieldReducer",
-			pos:  position{line: 283, col: 1, offset: 6820},
+			pos:  position{line: 303, col: 1, offset: 7603},
 			expr: &actionExpr{
-				pos: position{line: 284, col: 5, offset: 6837},
+				pos: position{line: 304, col: 5, offset: 7620},
 				run: (*parser).callonfieldReducer1,
 				expr: &seqExpr{
-					pos: position{line: 284, col: 5, offset: 6837},
+					pos: position{line: 304, col: 5, offset: 7620},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 284, col: 5, offset: 6837},
+							pos:   position{line: 304, col: 5, offset: 7620},
 							label: "op",
 							expr: &ruleRefExpr{
-								pos:  position{line: 284, col: 8, offset: 6840},
+								pos:  position{line: 304, col: 8, offset: 7623},
 								name: "fieldReducerOp",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 284, col: 23, offset: 6855},
+							pos: position{line: 304, col: 23, offset: 7638},
 							expr: &ruleRefExpr{
-								pos:  position{line: 284, col: 23, offset: 6855},
+								pos:  position{line: 304, col: 23, offset: 7638},
 								name: "_",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 284, col: 26, offset: 6858},
+							pos:        position{line: 304, col: 26, offset: 7641},
 							val:        "(",
 							ignoreCase: false,
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 284, col: 30, offset: 6862},
+							pos: position{line: 304, col: 30, offset: 7645},
 							expr: &ruleRefExpr{
-								pos:  position{line: 284, col: 30, offset: 6862},
+								pos:  position{line: 304, col: 30, offset: 7645},
 								name: "_",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 284, col: 33, offset: 6865},
+							pos:   position{line: 304, col: 33, offset: 7648},
 							label: "field",
 							expr: &ruleRefExpr{
-								pos:  position{line: 284, col: 39, offset: 6871},
+								pos:  position{line: 304, col: 39, offset: 7654},
 								name: "fieldExpr",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 284, col: 50, offset: 6882},
+							pos: position{line: 304, col: 50, offset: 7665},
 							expr: &ruleRefExpr{
-								pos:  position{line: 284, col: 50, offset: 6882},
+								pos:  position{line: 304, col: 50, offset: 7665},
 								name: "_",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 284, col: 53, offset: 6885},
+							pos:        position{line: 304, col: 53, offset: 7668},
 							val:        ")",
 							ignoreCase: false,
 						},
@@ -2163,188 +2347,423 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "reduceProc",
-			pos:  position{line: 288, col: 1, offset: 6952},
-			expr: &actionExpr{
-				pos: position{line: 289, col: 5, offset: 6967},
-				run: (*parser).callonreduceProc1,
-				expr: &seqExpr{
-					pos: position{line: 289, col: 5, offset: 6967},
-					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 289, col: 5, offset: 6967},
-							label: "every",
-							expr: &zeroOrOneExpr{
-								pos: position{line: 289, col: 11, offset: 6973},
-								expr: &seqExpr{
-									pos: position{line: 289, col: 12, offset: 6974},
-									exprs: []interface{}{
-										&ruleRefExpr{
-											pos:  position{line: 289, col: 12, offset: 6974},
-											name: "everyDur",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 289, col: 21, offset: 6983},
-											name: "_",
-										},
-									},
-								},
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 289, col: 25, offset: 6987},
-							label: "reducers",
-							expr: &ruleRefExpr{
-								pos:  position{line: 289, col: 34, offset: 6996},
-								name: "reducerList",
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 289, col: 46, offset: 7008},
-							label: "keys",
-							expr: &zeroOrOneExpr{
-								pos: position{line: 289, col: 51, offset: 7013},
-								expr: &seqExpr{
-									pos: position{line: 289, col: 52, offset: 7014},
-									exprs: []interface{}{
-										&ruleRefExpr{
-											pos:  position{line: 289, col: 52, offset: 7014},
-											name: "_",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 289, col: 54, offset: 7016},
-											name: "groupByKeys",
-										},
-									},
-								},
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 289, col: 68, offset: 7030},
-							label: "limit",
-							expr: &zeroOrOneExpr{
-								pos: position{line: 289, col: 74, offset: 7036},
-								expr: &ruleRefExpr{
-									pos:  position{line: 289, col: 74, offset: 7036},
-									name: "procLimitArg",
-								},
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "asClause",
-			pos:  position{line: 307, col: 1, offset: 7392},
-			expr: &actionExpr{
-				pos: position{line: 308, col: 5, offset: 7405},
-				run: (*parser).callonasClause1,
-				expr: &seqExpr{
-					pos: position{line: 308, col: 5, offset: 7405},
-					exprs: []interface{}{
-						&litMatcher{
-							pos:        position{line: 308, col: 5, offset: 7405},
-							val:        "as",
-							ignoreCase: true,
-						},
-						&ruleRefExpr{
-							pos:  position{line: 308, col: 11, offset: 7411},
-							name: "_",
-						},
-						&labeledExpr{
-							pos:   position{line: 308, col: 13, offset: 7413},
-							label: "v",
-							expr: &ruleRefExpr{
-								pos:  position{line: 308, col: 15, offset: 7415},
-								name: "fieldName",
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "reducerExpr",
-			pos:  position{line: 310, col: 1, offset: 7444},
+			name: "percentileReducer",
+			pos:  position{line: 308, col: 1, offset: 7735},
 			expr: &choiceExpr{
-				pos: position{line: 311, col: 5, offset: 7460},
+				pos: position{line: 309, col: 5, offset: 7757},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 311, col: 5, offset: 7460},
-						run: (*parser).callonreducerExpr2,
+						pos: position{line: 309, col: 5, offset: 7757},
+						run: (*parser).callonpercentileReducer2,
 						expr: &seqExpr{
-							pos: position{line: 311, col: 5, offset: 7460},
+							pos: position{line: 309, col: 5, offset: 7757},
 							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 309, col: 5, offset: 7757},
+									val:        "percentile",
+									ignoreCase: true,
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 309, col: 19, offset: 7771},
+									expr: &ruleRefExpr{
+										pos:  position{line: 309, col: 19, offset: 7771},
+										name: "_",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 309, col: 22, offset: 7774},
+									val:        "(",
+									ignoreCase: false,
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 309, col: 26, offset: 7778},
+									expr: &ruleRefExpr{
+										pos:  position{line: 309, col: 26, offset: 7778},
+										name: "_",
+									},
+								},
 								&labeledExpr{
-									pos:   position{line: 311, col: 5, offset: 7460},
+									pos:   position{line: 309, col: 29, offset: 7781},
 									label: "field",
 									expr: &ruleRefExpr{
-										pos:  position{line: 311, col: 11, offset: 7466},
+										pos:  position{line: 309, col: 35, offset: 7787},
 										name: "fieldExpr",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 311, col: 21, offset: 7476},
+									pos: position{line: 309, col: 45, offset: 7797},
 									expr: &ruleRefExpr{
-										pos:  position{line: 311, col: 21, offset: 7476},
+										pos:  position{line: 309, col: 45, offset: 7797},
 										name: "_",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 311, col: 24, offset: 7479},
-									val:        "=",
+									pos:        position{line: 309, col: 48, offset: 7800},
+									val:        ",",
 									ignoreCase: false,
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 311, col: 28, offset: 7483},
+									pos: position{line: 309, col: 52, offset: 7804},
 									expr: &ruleRefExpr{
-										pos:  position{line: 311, col: 28, offset: 7483},
+										pos:  position{line: 309, col: 52, offset: 7804},
 										name: "_",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 311, col: 31, offset: 7486},
-									label: "f",
+									pos:   position{line: 309, col: 55, offset: 7807},
+									label: "p",
+									expr: &choiceExpr{
+										pos: position{line: 309, col: 58, offset: 7810},
+										alternatives: []interface{}{
+											&ruleRefExpr{
+												pos:  position{line: 309, col: 58, offset: 7810},
+												name: "sdouble",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 309, col: 68, offset: 7820},
+												name: "suint",
+											},
+										},
+									},
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 309, col: 75, offset: 7827},
 									expr: &ruleRefExpr{
-										pos:  position{line: 311, col: 33, offset: 7488},
-										name: "reducer",
+										pos:  position{line: 309, col: 75, offset: 7827},
+										name: "_",
 									},
 								},
+								&litMatcher{
+									pos:        position{line: 309, col: 78, offset: 7830},
+									val:        ")",
+									ignoreCase: false,
+								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 314, col: 5, offset: 7551},
-						run: (*parser).callonreducerExpr13,
+						pos: position{line: 312, col: 5, offset: 7915},
+						run: (*parser).callonpercentileReducer24,
 						expr: &seqExpr{
-							pos: position{line: 314, col: 5, offset: 7551},
+							pos: position{line: 312, col: 5, offset: 7915},
 							exprs: []interface{}{
-								&labeledExpr{
-									pos:   position{line: 314, col: 5, offset: 7551},
-									label: "f",
+								&litMatcher{
+									pos:        position{line: 312, col: 5, offset: 7915},
+									val:        "median",
+									ignoreCase: true,
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 312, col: 15, offset: 7925},
 									expr: &ruleRefExpr{
-										pos:  position{line: 314, col: 7, offset: 7553},
-										name: "reducer",
+										pos:  position{line: 312, col: 15, offset: 7925},
+										name: "_",
 									},
 								},
-								&ruleRefExpr{
-									pos:  position{line: 314, col: 15, offset: 7561},
-									name: "_",
+								&litMatcher{
+									pos:        position{line: 312, col: 18, offset: 7928},
+									val:        "(",
+									ignoreCase: false,
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 312, col: 22, offset: 7932},
+									expr: &ruleRefExpr{
+										pos:  position{line: 312, col: 22, offset: 7932},
+										name: "_",
+									},
 								},
 								&labeledExpr{
-									pos:   position{line: 314, col: 17, offset: 7563},
+									pos:   position{line: 312, col: 25, offset: 7935},
 									label: "field",
 									expr: &ruleRefExpr{
-										pos:  position{line: 314, col: 23, offset: 7569},
-										name: "asClause",
+										pos:  position{line: 312, col: 31, offset: 7941},
+										name: "fieldExpr",
 									},
 								},
-							},
-						},
-					},
+								&zeroOrOneExpr{
+									pos: position{line: 312, col: 41, offset: 7951},
+									expr: &ruleRefExpr{
+										pos:  position{line: 312, col: 41, offset: 7951},
+										name: "_",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 312, col: 44, offset: 7954},
+									val:        ")",
+									ignoreCase: false,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "countDistinctReducer",
+			pos:  position{line: 316, col: 1, offset: 8020},
+			expr: &actionExpr{
+				pos: position{line: 317, col: 5, offset: 8045},
+				run: (*parser).calloncountDistinctReducer1,
+				expr: &seqExpr{
+					pos: position{line: 317, col: 5, offset: 8045},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 317, col: 5, offset: 8045},
+							val:        "countdistinct",
+							ignoreCase: true,
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 317, col: 22, offset: 8062},
+							expr: &ruleRefExpr{
+								pos:  position{line: 317, col: 22, offset: 8062},
+								name: "_",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 317, col: 25, offset: 8065},
+							val:        "(",
+							ignoreCase: false,
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 317, col: 29, offset: 8069},
+							expr: &ruleRefExpr{
+								pos:  position{line: 317, col: 29, offset: 8069},
+								name: "_",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 317, col: 32, offset: 8072},
+							label: "field",
+							expr: &ruleRefExpr{
+								pos:  position{line: 317, col: 38, offset: 8078},
+								name: "fieldExpr",
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 317, col: 48, offset: 8088},
+							expr: &ruleRefExpr{
+								pos:  position{line: 317, col: 48, offset: 8088},
+								name: "_",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 317, col: 51, offset: 8091},
+							val:        ",",
+							ignoreCase: false,
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 317, col: 55, offset: 8095},
+							expr: &ruleRefExpr{
+								pos:  position{line: 317, col: 55, offset: 8095},
+								name: "_",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 317, col: 58, offset: 8098},
+							label: "p",
+							expr: &ruleRefExpr{
+								pos:  position{line: 317, col: 60, offset: 8100},
+								name: "suint",
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 317, col: 66, offset: 8106},
+							expr: &ruleRefExpr{
+								pos:  position{line: 317, col: 66, offset: 8106},
+								name: "_",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 317, col: 69, offset: 8109},
+							val:        ")",
+							ignoreCase: false,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceProc",
+			pos:  position{line: 321, col: 1, offset: 8197},
+			expr: &actionExpr{
+				pos: position{line: 322, col: 5, offset: 8212},
+				run: (*parser).callonreduceProc1,
+				expr: &seqExpr{
+					pos: position{line: 322, col: 5, offset: 8212},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 322, col: 5, offset: 8212},
+							label: "every",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 322, col: 11, offset: 8218},
+								expr: &seqExpr{
+									pos: position{line: 322, col: 12, offset: 8219},
+									exprs: []interface{}{
+										&ruleRefExpr{
+											pos:  position{line: 322, col: 12, offset: 8219},
+											name: "everyDur",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 322, col: 21, offset: 8228},
+											name: "_",
+										},
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 322, col: 25, offset: 8232},
+							label: "reducers",
+							expr: &ruleRefExpr{
+								pos:  position{line: 322, col: 34, offset: 8241},
+								name: "reducerList",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 322, col: 46, offset: 8253},
+							label: "keys",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 322, col: 51, offset: 8258},
+								expr: &seqExpr{
+									pos: position{line: 322, col: 52, offset: 8259},
+									exprs: []interface{}{
+										&ruleRefExpr{
+											pos:  position{line: 322, col: 52, offset: 8259},
+											name: "_",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 322, col: 54, offset: 8261},
+											name: "groupByKeys",
+										},
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 322, col: 68, offset: 8275},
+							label: "limit",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 322, col: 74, offset: 8281},
+								expr: &ruleRefExpr{
+									pos:  position{line: 322, col: 74, offset: 8281},
+									name: "procLimitArg",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "asClause",
+			pos:  position{line: 340, col: 1, offset: 8637},
+			expr: &actionExpr{
+				pos: position{line: 341, col: 5, offset: 8650},
+				run: (*parser).callonasClause1,
+				expr: &seqExpr{
+					pos: position{line: 341, col: 5, offset: 8650},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 341, col: 5, offset: 8650},
+							val:        "as",
+							ignoreCase: true,
+						},
+						&ruleRefExpr{
+							pos:  position{line: 341, col: 11, offset: 8656},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 341, col: 13, offset: 8658},
+							label: "v",
+							expr: &ruleRefExpr{
+								pos:  position{line: 341, col: 15, offset: 8660},
+								name: "fieldName",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reducerExpr",
+			pos:  position{line: 343, col: 1, offset: 8689},
+			expr: &choiceExpr{
+				pos: position{line: 344, col: 5, offset: 8705},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 344, col: 5, offset: 8705},
+						run: (*parser).callonreducerExpr2,
+						expr: &seqExpr{
+							pos: position{line: 344, col: 5, offset: 8705},
+							exprs: []interface{}{
+								&labeledExpr{
+									pos:   position{line: 344, col: 5, offset: 8705},
+									label: "field",
+									expr: &ruleRefExpr{
+										pos:  position{line: 344, col: 11, offset: 8711},
+										name: "fieldExpr",
+									},
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 344, col: 21, offset: 8721},
+									expr: &ruleRefExpr{
+										pos:  position{line: 344, col: 21, offset: 8721},
+										name: "_",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 344, col: 24, offset: 8724},
+									val:        "=",
+									ignoreCase: false,
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 344, col: 28, offset: 8728},
+									expr: &ruleRefExpr{
+										pos:  position{line: 344, col: 28, offset: 8728},
+										name: "_",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 344, col: 31, offset: 8731},
+									label: "f",
+									expr: &ruleRefExpr{
+										pos:  position{line: 344, col: 33, offset: 8733},
+										name: "reducer",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 347, col: 5, offset: 8796},
+						run: (*parser).callonreducerExpr13,
+						expr: &seqExpr{
+							pos: position{line: 347, col: 5, offset: 8796},
+							exprs: []interface{}{
+								&labeledExpr{
+									pos:   position{line: 347, col: 5, offset: 8796},
+									label: "f",
+									expr: &ruleRefExpr{
+										pos:  position{line: 347, col: 7, offset: 8798},
+										name: "reducer",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 347, col: 15, offset: 8806},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 347, col: 17, offset: 8808},
+									label: "field",
+									expr: &ruleRefExpr{
+										pos:  position{line: 347, col: 23, offset: 8814},
+										name: "asClause",
+									},
+								},
+							},
+						},
+					},
 					&ruleRefExpr{
-						pos:  position{line: 317, col: 5, offset: 7633},
+						pos:  position{line: 350, col: 5, offset: 8878},
 						name: "reducer",
 					},
 				},
@@ -2352,67 +2771,75 @@ var g = &grammar{
 		},
 		{
 			name: "reducer",
-			pos:  position{line: 319, col: 1, offset: 7642},
+			pos:  position{line: 352, col: 1, offset: 8887},
 			expr: &choiceExpr{
-				pos: position{line: 320, col: 5, offset: 7654},
+				pos: position{line: 353, col: 5, offset: 8899},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 320, col: 5, offset: 7654},
+						pos:  position{line: 353, col: 5, offset: 8899},
 						name: "countReducer",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 321, col: 5, offset: 7671},
+						pos:  position{line: 354, col: 5, offset: 8916},
 						name: "fieldReducer",
 					},
+					&ruleRefExpr{
+						pos:  position{line: 355, col: 5, offset: 8933},
+						name: "percentileReducer",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 356, col: 5, offset: 8955},
+						name: "countDistinctReducer",
+					},
 				},
 			},
 		},
 		{
 			name: "reducerList",
-			pos:  position{line: 323, col: 1, offset: 7685},
+			pos:  position{line: 358, col: 1, offset: 8977},
 			expr: &actionExpr{
-				pos: position{line: 324, col: 5, offset: 7701},
+				pos: position{line: 359, col: 5, offset: 8993},
 				run: (*parser).callonreducerList1,
 				expr: &seqExpr{
-					pos: position{line: 324, col: 5, offset: 7701},
+					pos: position{line: 359, col: 5, offset: 8993},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 324, col: 5, offset: 7701},
+							pos:   position{line: 359, col: 5, offset: 8993},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 324, col: 11, offset: 7707},
+								pos:  position{line: 359, col: 11, offset: 8999},
 								name: "reducerExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 324, col: 23, offset: 7719},
+							pos:   position{line: 359, col: 23, offset: 9011},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 324, col: 28, offset: 7724},
+								pos: position{line: 359, col: 28, offset: 9016},
 								expr: &seqExpr{
-									pos: position{line: 324, col: 29, offset: 7725},
+									pos: position{line: 359, col: 29, offset: 9017},
 									exprs: []interface{}{
 										&zeroOrOneExpr{
-											pos: position{line: 324, col: 29, offset: 7725},
+											pos: position{line: 359, col: 29, offset: 9017},
 											expr: &ruleRefExpr{
-												pos:  position{line: 324, col: 29, offset: 7725},
+												pos:  position{line: 359, col: 29, offset: 9017},
 												name: "_",
 											},
 										},
 										&litMatcher{
-											pos:        position{line: 324, col: 32, offset: 7728},
+											pos:        position{line: 359, col: 32, offset: 9020},
 											val:        ",",
 											ignoreCase: false,
 										},
 										&zeroOrOneExpr{
-											pos: position{line: 324, col: 36, offset: 7732},
+											pos: position{line: 359, col: 36, offset: 9024},
 											expr: &ruleRefExpr{
-												pos:  position{line: 324, col: 36, offset: 7732},
+												pos:  position{line: 359, col: 36, offset: 9024},
 												name: "_",
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 324, col: 39, offset: 7735},
+											pos:  position{line: 359, col: 39, offset: 9027},
 											name: "reducerExpr",
 										},
 									},
@@ -2425,87 +2852,103 @@ var g = &grammar{
 		},
 		{
 			name: "simpleProc",
-			pos:  position{line: 332, col: 1, offset: 7932},
+			pos:  position{line: 367, col: 1, offset: 9224},
 			expr: &choiceExpr{
-				pos: position{line: 333, col: 5, offset: 7947},
+				pos: position{line: 368, col: 5, offset: 9239},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 333, col: 5, offset: 7947},
+						pos:  position{line: 368, col: 5, offset: 9239},
 						name: "sort",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 334, col: 5, offset: 7956},
+						pos:  position{line: 369, col: 5, offset: 9248},
 						name: "top",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 335, col: 5, offset: 7964},
+						pos:  position{line: 370, col: 5, offset: 9256},
 						name: "cut",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 336, col: 5, offset: 7972},
+						pos:  position{line: 371, col: 5, offset: 9264},
 						name: "head",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 337, col: 5, offset: 7981},
+						pos:  position{line: 372, col: 5, offset: 9273},
 						name: "tail",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 338, col: 5, offset: 7990},
+						pos:  position{line: 373, col: 5, offset: 9282},
 						name: "filter",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 339, col: 5, offset: 8001},
+						pos:  position{line: 374, col: 5, offset: 9293},
 						name: "uniq",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 340, col: 5, offset: 8010},
+						pos:  position{line: 375, col: 5, offset: 9302},
 						name: "put",
 					},
+					&ruleRefExpr{
+						pos:  position{line: 376, col: 5, offset: 9310},
+						name: "join",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 377, col: 5, offset: 9319},
+						name: "running",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 378, col: 5, offset: 9331},
+						name: "rename",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 379, col: 5, offset: 9342},
+						name: "fuse",
+					},
 				},
 			},
 		},
 		{
 			name: "sort",
-			pos:  position{line: 342, col: 1, offset: 8015},
+			pos:  position{line: 381, col: 1, offset: 9348},
 			expr: &actionExpr{
-				pos: position{line: 343, col: 5, offset: 8024},
+				pos: position{line: 382, col: 5, offset: 9357},
 				run: (*parser).callonsort1,
 				expr: &seqExpr{
-					pos: position{line: 343, col: 5, offset: 8024},
+					pos: position{line: 382, col: 5, offset: 9357},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 343, col: 5, offset: 8024},
+							pos:        position{line: 382, col: 5, offset: 9357},
 							val:        "sort",
 							ignoreCase: true,
 						},
 						&labeledExpr{
-							pos:   position{line: 343, col: 13, offset: 8032},
+							pos:   position{line: 382, col: 13, offset: 9365},
 							label: "args",
 							expr: &ruleRefExpr{
-								pos:  position{line: 343, col: 18, offset: 8037},
+								pos:  position{line: 382, col: 18, offset: 9370},
 								name: "sortArgs",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 343, col: 27, offset: 8046},
+							pos:   position{line: 382, col: 27, offset: 9379},
 							label: "list",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 343, col: 32, offset: 8051},
+								pos: position{line: 382, col: 32, offset: 9384},
 								expr: &actionExpr{
-									pos: position{line: 343, col: 33, offset: 8052},
+									pos: position{line: 382, col: 33, offset: 9385},
 									run: (*parser).callonsort8,
 									expr: &seqExpr{
-										pos: position{line: 343, col: 33, offset: 8052},
+										pos: position{line: 382, col: 33, offset: 9385},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 343, col: 33, offset: 8052},
+												pos:  position{line: 382, col: 33, offset: 9385},
 												name: "_",
 											},
 											&labeledExpr{
-												pos:   position{line: 343, col: 35, offset: 8054},
+												pos:   position{line: 382, col: 35, offset: 9387},
 												label: "l",
 												expr: &ruleRefExpr{
-													pos:  position{line: 343, col: 37, offset: 8056},
+													pos:  position{line: 382, col: 37, offset: 9389},
 													name: "fieldExprList",
 												},
 											},
@@ -2520,24 +2963,24 @@ var g = &grammar{
 		},
 		{
 			name: "sortArgs",
-			pos:  position{line: 347, col: 1, offset: 8133},
+			pos:  position{line: 386, col: 1, offset: 9466},
 			expr: &zeroOrMoreExpr{
-				pos: position{line: 347, col: 12, offset: 8144},
+				pos: position{line: 386, col: 12, offset: 9477},
 				expr: &actionExpr{
-					pos: position{line: 347, col: 13, offset: 8145},
+					pos: position{line: 386, col: 13, offset: 9478},
 					run: (*parser).callonsortArgs2,
 					expr: &seqExpr{
-						pos: position{line: 347, col: 13, offset: 8145},
+						pos: position{line: 386, col: 13, offset: 9478},
 						exprs: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 347, col: 13, offset: 8145},
+								pos:  position{line: 386, col: 13, offset: 9478},
 								name: "_",
 							},
 							&labeledExpr{
-								pos:   position{line: 347, col: 15, offset: 8147},
+								pos:   position{line: 386, col: 15, offset: 9480},
 								label: "a",
 								expr: &ruleRefExpr{
-									pos:  position{line: 347, col: 17, offset: 8149},
+									pos:  position{line: 386, col: 17, offset: 9482},
 									name: "sortArg",
 								},
 							},
@@ -2548,50 +2991,50 @@ var g = &grammar{
 		},
 		{
 			name: "sortArg",
-			pos:  position{line: 349, col: 1, offset: 8178},
+			pos:  position{line: 388, col: 1, offset: 9511},
 			expr: &choiceExpr{
-				pos: position{line: 350, col: 5, offset: 8190},
+				pos: position{line: 389, col: 5, offset: 9523},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 350, col: 5, offset: 8190},
+						pos: position{line: 389, col: 5, offset: 9523},
 						run: (*parser).callonsortArg2,
 						expr: &litMatcher{
-							pos:        position{line: 350, col: 5, offset: 8190},
+							pos:        position{line: 389, col: 5, offset: 9523},
 							val:        "-r",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 351, col: 5, offset: 8233},
+						pos: position{line: 390, col: 5, offset: 9566},
 						run: (*parser).callonsortArg4,
 						expr: &seqExpr{
-							pos: position{line: 351, col: 5, offset: 8233},
+							pos: position{line: 390, col: 5, offset: 9566},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 351, col: 5, offset: 8233},
+									pos:        position{line: 390, col: 5, offset: 9566},
 									val:        "-nulls",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 351, col: 14, offset: 8242},
+									pos:  position{line: 390, col: 14, offset: 9575},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 351, col: 16, offset: 8244},
+									pos:   position{line: 390, col: 16, offset: 9577},
 									label: "where",
 									expr: &actionExpr{
-										pos: position{line: 351, col: 23, offset: 8251},
+										pos: position{line: 390, col: 23, offset: 9584},
 										run: (*parser).callonsortArg9,
 										expr: &choiceExpr{
-											pos: position{line: 351, col: 24, offset: 8252},
+											pos: position{line: 390, col: 24, offset: 9585},
 											alternatives: []interface{}{
 												&litMatcher{
-													pos:        position{line: 351, col: 24, offset: 8252},
+													pos:        position{line: 390, col: 24, offset: 9585},
 													val:        "first",
 													ignoreCase: false,
 												},
 												&litMatcher{
-													pos:        position{line: 351, col: 34, offset: 8262},
+													pos:        position{line: 390, col: 34, offset: 9595},
 													val:        "last",
 													ignoreCase: false,
 												},
@@ -2607,38 +3050,38 @@ var g = &grammar{
 		},
 		{
 			name: "top",
-			pos:  position{line: 353, col: 1, offset: 8344},
+			pos:  position{line: 392, col: 1, offset: 9677},
 			expr: &actionExpr{
-				pos: position{line: 354, col: 5, offset: 8352},
+				pos: position{line: 393, col: 5, offset: 9685},
 				run: (*parser).callontop1,
 				expr: &seqExpr{
-					pos: position{line: 354, col: 5, offset: 8352},
+					pos: position{line: 393, col: 5, offset: 9685},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 354, col: 5, offset: 8352},
+							pos:        position{line: 393, col: 5, offset: 9685},
 							val:        "top",
 							ignoreCase: true,
 						},
 						&labeledExpr{
-							pos:   position{line: 354, col: 12, offset: 8359},
+							pos:   position{line: 393, col: 12, offset: 9692},
 							label: "limit",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 354, col: 18, offset: 8365},
+								pos: position{line: 393, col: 18, offset: 9698},
 								expr: &actionExpr{
-									pos: position{line: 354, col: 19, offset: 8366},
+									pos: position{line: 393, col: 19, offset: 9699},
 									run: (*parser).callontop6,
 									expr: &seqExpr{
-										pos: position{line: 354, col: 19, offset: 8366},
+										pos: position{line: 393, col: 19, offset: 9699},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 354, col: 19, offset: 8366},
+												pos:  position{line: 393, col: 19, offset: 9699},
 												name: "_",
 											},
 											&labeledExpr{
-												pos:   position{line: 354, col: 21, offset: 8368},
+												pos:   position{line: 393, col: 21, offset: 9701},
 												label: "n",
 												expr: &ruleRefExpr{
-													pos:  position{line: 354, col: 23, offset: 8370},
+													pos:  position{line: 393, col: 23, offset: 9703},
 													name: "unsignedInteger",
 												},
 											},
@@ -2648,19 +3091,19 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 354, col: 58, offset: 8405},
+							pos:   position{line: 393, col: 58, offset: 9738},
 							label: "flush",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 354, col: 64, offset: 8411},
+								pos: position{line: 393, col: 64, offset: 9744},
 								expr: &seqExpr{
-									pos: position{line: 354, col: 65, offset: 8412},
+									pos: position{line: 393, col: 65, offset: 9745},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 354, col: 65, offset: 8412},
+											pos:  position{line: 393, col: 65, offset: 9745},
 											name: "_",
 										},
 										&litMatcher{
-											pos:        position{line: 354, col: 67, offset: 8414},
+											pos:        position{line: 393, col: 67, offset: 9747},
 											val:        "-flush",
 											ignoreCase: false,
 										},
@@ -2669,25 +3112,25 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 354, col: 78, offset: 8425},
+							pos:   position{line: 393, col: 78, offset: 9758},
 							label: "list",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 354, col: 83, offset: 8430},
+								pos: position{line: 393, col: 83, offset: 9763},
 								expr: &actionExpr{
-									pos: position{line: 354, col: 84, offset: 8431},
+									pos: position{line: 393, col: 84, offset: 9764},
 									run: (*parser).callontop18,
 									expr: &seqExpr{
-										pos: position{line: 354, col: 84, offset: 8431},
+										pos: position{line: 393, col: 84, offset: 9764},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 354, col: 84, offset: 8431},
+												pos:  position{line: 393, col: 84, offset: 9764},
 												name: "_",
 											},
 											&labeledExpr{
-												pos:   position{line: 354, col: 86, offset: 8433},
+												pos:   position{line: 393, col: 86, offset: 9766},
 												label: "f",
 												expr: &ruleRefExpr{
-													pos:  position{line: 354, col: 88, offset: 8435},
+													pos:  position{line: 393, col: 88, offset: 9768},
 													name: "fieldExprList",
 												},
 											},
@@ -2702,31 +3145,31 @@ var g = &grammar{
 		},
 		{
 			name: "procLimitArg",
-			pos:  position{line: 358, col: 1, offset: 8524},
+			pos:  position{line: 397, col: 1, offset: 9857},
 			expr: &actionExpr{
-				pos: position{line: 359, col: 5, offset: 8541},
+				pos: position{line: 398, col: 5, offset: 9874},
 				run: (*parser).callonprocLimitArg1,
 				expr: &seqExpr{
-					pos: position{line: 359, col: 5, offset: 8541},
+					pos: position{line: 398, col: 5, offset: 9874},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 359, col: 5, offset: 8541},
+							pos:  position{line: 398, col: 5, offset: 9874},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 359, col: 7, offset: 8543},
+							pos:        position{line: 398, col: 7, offset: 9876},
 							val:        "-limit",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 359, col: 16, offset: 8552},
+							pos:  position{line: 398, col: 16, offset: 9885},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 359, col: 18, offset: 8554},
+							pos:   position{line: 398, col: 18, offset: 9887},
 							label: "limit",
 							expr: &ruleRefExpr{
-								pos:  position{line: 359, col: 24, offset: 8560},
+								pos:  position{line: 398, col: 24, offset: 9893},
 								name: "unsignedInteger",
 							},
 						},
@@ -2736,21 +3179,21 @@ var g = &grammar{
 		},
 		{
 			name: "cutArg",
-			pos:  position{line: 361, col: 1, offset: 8599},
+			pos:  position{line: 400, col: 1, offset: 9932},
 			expr: &zeroOrMoreExpr{
-				pos: position{line: 361, col: 10, offset: 8608},
+				pos: position{line: 400, col: 10, offset: 9941},
 				expr: &actionExpr{
-					pos: position{line: 361, col: 11, offset: 8609},
+					pos: position{line: 400, col: 11, offset: 9942},
 					run: (*parser).calloncutArg2,
 					expr: &seqExpr{
-						pos: position{line: 361, col: 11, offset: 8609},
+						pos: position{line: 400, col: 11, offset: 9942},
 						exprs: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 361, col: 11, offset: 8609},
+								pos:  position{line: 400, col: 11, offset: 9942},
 								name: "_",
 							},
 							&litMatcher{
-								pos:        position{line: 361, col: 13, offset: 8611},
+								pos:        position{line: 400, col: 13, offset: 9944},
 								val:        "-c",
 								ignoreCase: false,
 							},
@@ -2761,35 +3204,35 @@ var g = &grammar{
 		},
 		{
 			name: "cut",
-			pos:  position{line: 363, col: 1, offset: 8653},
+			pos:  position{line: 402, col: 1, offset: 9986},
 			expr: &actionExpr{
-				pos: position{line: 364, col: 5, offset: 8661},
+				pos: position{line: 403, col: 5, offset: 9994},
 				run: (*parser).calloncut1,
 				expr: &seqExpr{
-					pos: position{line: 364, col: 5, offset: 8661},
+					pos: position{line: 403, col: 5, offset: 9994},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 364, col: 5, offset: 8661},
+							pos:        position{line: 403, col: 5, offset: 9994},
 							val:        "cut",
 							ignoreCase: true,
 						},
 						&labeledExpr{
-							pos:   position{line: 364, col: 12, offset: 8668},
+							pos:   position{line: 403, col: 12, offset: 10001},
 							label: "arg",
 							expr: &ruleRefExpr{
-								pos:  position{line: 364, col: 16, offset: 8672},
+								pos:  position{line: 403, col: 16, offset: 10005},
 								name: "cutArg",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 364, col: 23, offset: 8679},
+							pos:  position{line: 403, col: 23, offset: 10012},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 364, col: 25, offset: 8681},
+							pos:   position{line: 403, col: 25, offset: 10014},
 							label: "list",
 							expr: &ruleRefExpr{
-								pos:  position{line: 364, col: 30, offset: 8686},
+								pos:  position{line: 403, col: 30, offset: 10019},
 								name: "fieldRefDotOnlyList",
 							},
 						},
@@ -2799,30 +3242,30 @@ var g = &grammar{
 		},
 		{
 			name: "head",
-			pos:  position{line: 365, col: 1, offset: 8741},
+			pos:  position{line: 404, col: 1, offset: 10074},
 			expr: &choiceExpr{
-				pos: position{line: 366, col: 5, offset: 8750},
+				pos: position{line: 405, col: 5, offset: 10083},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 366, col: 5, offset: 8750},
+						pos: position{line: 405, col: 5, offset: 10083},
 						run: (*parser).callonhead2,
 						expr: &seqExpr{
-							pos: position{line: 366, col: 5, offset: 8750},
+							pos: position{line: 405, col: 5, offset: 10083},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 366, col: 5, offset: 8750},
+									pos:        position{line: 405, col: 5, offset: 10083},
 									val:        "head",
 									ignoreCase: true,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 366, col: 13, offset: 8758},
+									pos:  position{line: 405, col: 13, offset: 10091},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 366, col: 15, offset: 8760},
+									pos:   position{line: 405, col: 15, offset: 10093},
 									label: "count",
 									expr: &ruleRefExpr{
-										pos:  position{line: 366, col: 21, offset: 8766},
+										pos:  position{line: 405, col: 21, offset: 10099},
 										name: "unsignedInteger",
 									},
 								},
@@ -2830,10 +3273,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 367, col: 5, offset: 8822},
+						pos: position{line: 406, col: 5, offset: 10155},
 						run: (*parser).callonhead8,
 						expr: &litMatcher{
-							pos:        position{line: 367, col: 5, offset: 8822},
+							pos:        position{line: 406, col: 5, offset: 10155},
 							val:        "head",
 							ignoreCase: true,
 						},
@@ -2843,30 +3286,30 @@ var g = &grammar{
 		},
 		{
 			name: "tail",
-			pos:  position{line: 368, col: 1, offset: 8862},
+			pos:  position{line: 407, col: 1, offset: 10195},
 			expr: &choiceExpr{
-				pos: position{line: 369, col: 5, offset: 8871},
+				pos: position{line: 408, col: 5, offset: 10204},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 369, col: 5, offset: 8871},
+						pos: position{line: 408, col: 5, offset: 10204},
 						run: (*parser).callontail2,
 						expr: &seqExpr{
-							pos: position{line: 369, col: 5, offset: 8871},
+							pos: position{line: 408, col: 5, offset: 10204},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 369, col: 5, offset: 8871},
+									pos:        position{line: 408, col: 5, offset: 10204},
 									val:        "tail",
 									ignoreCase: true,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 369, col: 13, offset: 8879},
+									pos:  position{line: 408, col: 13, offset: 10212},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 369, col: 15, offset: 8881},
+									pos:   position{line: 408, col: 15, offset: 10214},
 									label: "count",
 									expr: &ruleRefExpr{
-										pos:  position{line: 369, col: 21, offset: 8887},
+										pos:  position{line: 408, col: 21, offset: 10220},
 										name: "unsignedInteger",
 									},
 								},
@@ -2874,10 +3317,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 370, col: 5, offset: 8943},
+						pos: position{line: 409, col: 5, offset: 10276},
 						run: (*parser).callontail8,
 						expr: &litMatcher{
-							pos:        position{line: 370, col: 5, offset: 8943},
+							pos:        position{line: 409, col: 5, offset: 10276},
 							val:        "tail",
 							ignoreCase: true,
 						},
@@ -2887,131 +3330,543 @@ var g = &grammar{
 		},
 		{
 			name: "filter",
-			pos:  position{line: 372, col: 1, offset: 8984},
+			pos:  position{line: 411, col: 1, offset: 10317},
 			expr: &actionExpr{
-				pos: position{line: 373, col: 5, offset: 8995},
+				pos: position{line: 412, col: 5, offset: 10328},
 				run: (*parser).callonfilter1,
 				expr: &seqExpr{
-					pos: position{line: 373, col: 5, offset: 8995},
+					pos: position{line: 412, col: 5, offset: 10328},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 373, col: 5, offset: 8995},
+							pos:        position{line: 412, col: 5, offset: 10328},
 							val:        "filter",
 							ignoreCase: true,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 373, col: 15, offset: 9005},
+							pos:  position{line: 412, col: 15, offset: 10338},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 373, col: 17, offset: 9007},
+							pos:   position{line: 412, col: 17, offset: 10340},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 373, col: 22, offset: 9012},
+								pos:  position{line: 412, col: 22, offset: 10345},
 								name: "searchExpr",
 							},
 						},
 					},
-				},
-			},
-		},
-		{
-			name: "uniq",
-			pos:  position{line: 376, col: 1, offset: 9070},
-			expr: &choiceExpr{
-				pos: position{line: 377, col: 5, offset: 9079},
-				alternatives: []interface{}{
+				},
+			},
+		},
+		{
+			name: "uniq",
+			pos:  position{line: 415, col: 1, offset: 10403},
+			expr: &choiceExpr{
+				pos: position{line: 416, col: 5, offset: 10412},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 416, col: 5, offset: 10412},
+						run: (*parser).callonuniq2,
+						expr: &seqExpr{
+							pos: position{line: 416, col: 5, offset: 10412},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 416, col: 5, offset: 10412},
+									val:        "uniq",
+									ignoreCase: true,
+								},
+								&ruleRefExpr{
+									pos:  position{line: 416, col: 13, offset: 10420},
+									name: "_",
+								},
+								&litMatcher{
+									pos:        position{line: 416, col: 15, offset: 10422},
+									val:        "-c",
+									ignoreCase: false,
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 419, col: 5, offset: 10476},
+						run: (*parser).callonuniq7,
+						expr: &litMatcher{
+							pos:        position{line: 419, col: 5, offset: 10476},
+							val:        "uniq",
+							ignoreCase: true,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "put",
+			pos:  position{line: 423, col: 1, offset: 10531},
+			expr: &actionExpr{
+				pos: position{line: 424, col: 5, offset: 10539},
+				run: (*parser).callonput1,
+				expr: &seqExpr{
+					pos: position{line: 424, col: 5, offset: 10539},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 424, col: 5, offset: 10539},
+							val:        "put",
+							ignoreCase: true,
+						},
+						&ruleRefExpr{
+							pos:  position{line: 424, col: 12, offset: 10546},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 424, col: 14, offset: 10548},
+							label: "first",
+							expr: &ruleRefExpr{
+								pos:  position{line: 424, col: 20, offset: 10554},
+								name: "Assignment",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 424, col: 31, offset: 10565},
+							label: "rest",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 424, col: 36, offset: 10570},
+								expr: &actionExpr{
+									pos: position{line: 424, col: 37, offset: 10571},
+									run: (*parser).callonput9,
+									expr: &seqExpr{
+										pos: position{line: 424, col: 37, offset: 10571},
+										exprs: []interface{}{
+											&ruleRefExpr{
+												pos:  position{line: 424, col: 37, offset: 10571},
+												name: "__",
+											},
+											&litMatcher{
+												pos:        position{line: 424, col: 40, offset: 10574},
+												val:        ",",
+												ignoreCase: false,
+											},
+											&ruleRefExpr{
+												pos:  position{line: 424, col: 44, offset: 10578},
+												name: "__",
+											},
+											&labeledExpr{
+												pos:   position{line: 424, col: 47, offset: 10581},
+												label: "cl",
+												expr: &ruleRefExpr{
+													pos:  position{line: 424, col: 50, offset: 10584},
+													name: "Assignment",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "join",
+			pos:  position{line: 428, col: 1, offset: 10668},
+			expr: &actionExpr{
+				pos: position{line: 429, col: 5, offset: 10677},
+				run: (*parser).callonjoin1,
+				expr: &seqExpr{
+					pos: position{line: 429, col: 5, offset: 10677},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 429, col: 5, offset: 10677},
+							val:        "join",
+							ignoreCase: true,
+						},
+						&labeledExpr{
+							pos:   position{line: 429, col: 13, offset: 10685},
+							label: "args",
+							expr: &ruleRefExpr{
+								pos:  position{line: 429, col: 18, offset: 10690},
+								name: "joinArgs",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 429, col: 27, offset: 10699},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 429, col: 29, offset: 10701},
+							label: "first",
+							expr: &ruleRefExpr{
+								pos:  position{line: 429, col: 35, offset: 10707},
+								name: "joinKey",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 429, col: 43, offset: 10715},
+							label: "rest",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 429, col: 48, offset: 10720},
+								expr: &actionExpr{
+									pos: position{line: 429, col: 49, offset: 10721},
+									run: (*parser).callonjoin11,
+									expr: &seqExpr{
+										pos: position{line: 429, col: 49, offset: 10721},
+										exprs: []interface{}{
+											&ruleRefExpr{
+												pos:  position{line: 429, col: 49, offset: 10721},
+												name: "__",
+											},
+											&litMatcher{
+												pos:        position{line: 429, col: 52, offset: 10724},
+												val:        ",",
+												ignoreCase: false,
+											},
+											&ruleRefExpr{
+												pos:  position{line: 429, col: 56, offset: 10728},
+												name: "__",
+											},
+											&labeledExpr{
+												pos:   position{line: 429, col: 59, offset: 10731},
+												label: "k",
+												expr: &ruleRefExpr{
+													pos:  position{line: 429, col: 61, offset: 10733},
+													name: "joinKey",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "joinArgs",
+			pos:  position{line: 433, col: 1, offset: 10815},
+			expr: &zeroOrMoreExpr{
+				pos: position{line: 433, col: 12, offset: 10826},
+				expr: &actionExpr{
+					pos: position{line: 433, col: 13, offset: 10827},
+					run: (*parser).callonjoinArgs2,
+					expr: &seqExpr{
+						pos: position{line: 433, col: 13, offset: 10827},
+						exprs: []interface{}{
+							&ruleRefExpr{
+								pos:  position{line: 433, col: 13, offset: 10827},
+								name: "_",
+							},
+							&labeledExpr{
+								pos:   position{line: 433, col: 15, offset: 10829},
+								label: "a",
+								expr: &ruleRefExpr{
+									pos:  position{line: 433, col: 17, offset: 10831},
+									name: "joinArg",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "joinArg",
+			pos:  position{line: 435, col: 1, offset: 10860},
+			expr: &choiceExpr{
+				pos: position{line: 436, col: 5, offset: 10872},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 436, col: 5, offset: 10872},
+						run: (*parser).callonjoinArg2,
+						expr: &litMatcher{
+							pos:        position{line: 436, col: 5, offset: 10872},
+							val:        "-inner",
+							ignoreCase: false,
+						},
+					},
+					&actionExpr{
+						pos: position{line: 437, col: 5, offset: 10923},
+						run: (*parser).callonjoinArg4,
+						expr: &litMatcher{
+							pos:        position{line: 437, col: 5, offset: 10923},
+							val:        "-left",
+							ignoreCase: false,
+						},
+					},
+					&actionExpr{
+						pos: position{line: 438, col: 5, offset: 10972},
+						run: (*parser).callonjoinArg6,
+						expr: &litMatcher{
+							pos:        position{line: 438, col: 5, offset: 10972},
+							val:        "-anti",
+							ignoreCase: false,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "joinKey",
+			pos:  position{line: 440, col: 1, offset: 11018},
+			expr: &choiceExpr{
+				pos: position{line: 441, col: 5, offset: 11030},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 441, col: 5, offset: 11030},
+						run: (*parser).callonjoinKey2,
+						expr: &seqExpr{
+							pos: position{line: 441, col: 5, offset: 11030},
+							exprs: []interface{}{
+								&labeledExpr{
+									pos:   position{line: 441, col: 5, offset: 11030},
+									label: "left",
+									expr: &ruleRefExpr{
+										pos:  position{line: 441, col: 10, offset: 11035},
+										name: "fieldRefDotOnly",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 441, col: 26, offset: 11051},
+									name: "__",
+								},
+								&litMatcher{
+									pos:        position{line: 441, col: 29, offset: 11054},
+									val:        "=",
+									ignoreCase: false,
+								},
+								&ruleRefExpr{
+									pos:  position{line: 441, col: 33, offset: 11058},
+									name: "__",
+								},
+								&labeledExpr{
+									pos:   position{line: 441, col: 36, offset: 11061},
+									label: "right",
+									expr: &ruleRefExpr{
+										pos:  position{line: 441, col: 42, offset: 11067},
+										name: "fieldRefDotOnly",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 442, col: 5, offset: 11130},
+						run: (*parser).callonjoinKey11,
+						expr: &labeledExpr{
+							pos:   position{line: 442, col: 5, offset: 11130},
+							label: "key",
+							expr: &ruleRefExpr{
+								pos:  position{line: 442, col: 9, offset: 11134},
+								name: "fieldRefDotOnly",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "running",
+			pos:  position{line: 444, col: 1, offset: 11191},
+			expr: &actionExpr{
+				pos: position{line: 445, col: 5, offset: 11203},
+				run: (*parser).callonrunning1,
+				expr: &seqExpr{
+					pos: position{line: 445, col: 5, offset: 11203},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 445, col: 5, offset: 11203},
+							val:        "running",
+							ignoreCase: true,
+						},
+						&labeledExpr{
+							pos:   position{line: 445, col: 16, offset: 11214},
+							label: "window",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 445, col: 23, offset: 11221},
+								expr: &ruleRefExpr{
+									pos:  position{line: 445, col: 23, offset: 11221},
+									name: "runningWindow",
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 445, col: 38, offset: 11236},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 445, col: 40, offset: 11238},
+							label: "reducers",
+							expr: &ruleRefExpr{
+								pos:  position{line: 445, col: 49, offset: 11247},
+								name: "reducerList",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 445, col: 61, offset: 11259},
+							label: "keys",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 445, col: 66, offset: 11264},
+								expr: &actionExpr{
+									pos: position{line: 445, col: 67, offset: 11265},
+									run: (*parser).callonrunning12,
+									expr: &seqExpr{
+										pos: position{line: 445, col: 67, offset: 11265},
+										exprs: []interface{}{
+											&ruleRefExpr{
+												pos:  position{line: 445, col: 67, offset: 11265},
+												name: "_",
+											},
+											&litMatcher{
+												pos:        position{line: 445, col: 69, offset: 11267},
+												val:        "by",
+												ignoreCase: true,
+											},
+											&ruleRefExpr{
+												pos:  position{line: 445, col: 75, offset: 11273},
+												name: "_",
+											},
+											&labeledExpr{
+												pos:   position{line: 445, col: 77, offset: 11275},
+												label: "k",
+												expr: &ruleRefExpr{
+													pos:  position{line: 445, col: 79, offset: 11277},
+													name: "fieldRefDotOnlyList",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "runningWindow",
+			pos:  position{line: 449, col: 1, offset: 11384},
+			expr: &choiceExpr{
+				pos: position{line: 450, col: 5, offset: 11402},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 450, col: 5, offset: 11402},
+						run: (*parser).callonrunningWindow2,
+						expr: &seqExpr{
+							pos: position{line: 450, col: 5, offset: 11402},
+							exprs: []interface{}{
+								&ruleRefExpr{
+									pos:  position{line: 450, col: 5, offset: 11402},
+									name: "_",
+								},
+								&litMatcher{
+									pos:        position{line: 450, col: 7, offset: 11404},
+									val:        "-rows",
+									ignoreCase: false,
+								},
+								&ruleRefExpr{
+									pos:  position{line: 450, col: 15, offset: 11412},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 450, col: 17, offset: 11414},
+									label: "rows",
+									expr: &ruleRefExpr{
+										pos:  position{line: 450, col: 22, offset: 11419},
+										name: "unsignedInteger",
+									},
+								},
+							},
+						},
+					},
 					&actionExpr{
-						pos: position{line: 377, col: 5, offset: 9079},
-						run: (*parser).callonuniq2,
+						pos: position{line: 451, col: 5, offset: 11483},
+						run: (*parser).callonrunningWindow9,
 						expr: &seqExpr{
-							pos: position{line: 377, col: 5, offset: 9079},
+							pos: position{line: 451, col: 5, offset: 11483},
 							exprs: []interface{}{
-								&litMatcher{
-									pos:        position{line: 377, col: 5, offset: 9079},
-									val:        "uniq",
-									ignoreCase: true,
-								},
 								&ruleRefExpr{
-									pos:  position{line: 377, col: 13, offset: 9087},
+									pos:  position{line: 451, col: 5, offset: 11483},
 									name: "_",
 								},
 								&litMatcher{
-									pos:        position{line: 377, col: 15, offset: 9089},
-									val:        "-c",
+									pos:        position{line: 451, col: 7, offset: 11485},
+									val:        "-span",
 									ignoreCase: false,
 								},
+								&ruleRefExpr{
+									pos:  position{line: 451, col: 15, offset: 11493},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 451, col: 17, offset: 11495},
+									label: "span",
+									expr: &ruleRefExpr{
+										pos:  position{line: 451, col: 22, offset: 11500},
+										name: "duration",
+									},
+								},
 							},
 						},
 					},
-					&actionExpr{
-						pos: position{line: 380, col: 5, offset: 9143},
-						run: (*parser).callonuniq7,
-						expr: &litMatcher{
-							pos:        position{line: 380, col: 5, offset: 9143},
-							val:        "uniq",
-							ignoreCase: true,
-						},
-					},
 				},
 			},
 		},
 		{
-			name: "put",
-			pos:  position{line: 384, col: 1, offset: 9198},
+			name: "rename",
+			pos:  position{line: 453, col: 1, offset: 11554},
 			expr: &actionExpr{
-				pos: position{line: 385, col: 5, offset: 9206},
-				run: (*parser).callonput1,
+				pos: position{line: 454, col: 5, offset: 11565},
+				run: (*parser).callonrename1,
 				expr: &seqExpr{
-					pos: position{line: 385, col: 5, offset: 9206},
+					pos: position{line: 454, col: 5, offset: 11565},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 385, col: 5, offset: 9206},
-							val:        "put",
+							pos:        position{line: 454, col: 5, offset: 11565},
+							val:        "rename",
 							ignoreCase: true,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 385, col: 12, offset: 9213},
+							pos:  position{line: 454, col: 15, offset: 11575},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 385, col: 14, offset: 9215},
+							pos:   position{line: 454, col: 17, offset: 11577},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 385, col: 20, offset: 9221},
-								name: "Assignment",
+								pos:  position{line: 454, col: 23, offset: 11583},
+								name: "fieldRename",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 385, col: 31, offset: 9232},
+							pos:   position{line: 454, col: 35, offset: 11595},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 385, col: 36, offset: 9237},
+								pos: position{line: 454, col: 40, offset: 11600},
 								expr: &actionExpr{
-									pos: position{line: 385, col: 37, offset: 9238},
-									run: (*parser).callonput9,
+									pos: position{line: 454, col: 41, offset: 11601},
+									run: (*parser).callonrename9,
 									expr: &seqExpr{
-										pos: position{line: 385, col: 37, offset: 9238},
+										pos: position{line: 454, col: 41, offset: 11601},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 385, col: 37, offset: 9238},
+												pos:  position{line: 454, col: 41, offset: 11601},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 385, col: 40, offset: 9241},
+												pos:        position{line: 454, col: 44, offset: 11604},
 												val:        ",",
 												ignoreCase: false,
 											},
 											&ruleRefExpr{
-												pos:  position{line: 385, col: 44, offset: 9245},
+												pos:  position{line: 454, col: 48, offset: 11608},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 385, col: 47, offset: 9248},
-												label: "cl",
+												pos:   position{line: 454, col: 51, offset: 11611},
+												label: "r",
 												expr: &ruleRefExpr{
-													pos:  position{line: 385, col: 50, offset: 9251},
-													name: "Assignment",
+													pos:  position{line: 454, col: 53, offset: 11613},
+													name: "fieldRename",
 												},
 											},
 										},
@@ -3023,41 +3878,96 @@ var g = &grammar{
 				},
 			},
 		},
+		{
+			name: "fuse",
+			pos:  position{line: 458, col: 1, offset: 11700},
+			expr: &actionExpr{
+				pos: position{line: 459, col: 5, offset: 11709},
+				run: (*parser).callonfuse1,
+				expr: &litMatcher{
+					pos:        position{line: 459, col: 5, offset: 11709},
+					val:        "fuse",
+					ignoreCase: true,
+				},
+			},
+		},
+		{
+			name: "fieldRename",
+			pos:  position{line: 463, col: 1, offset: 11759},
+			expr: &actionExpr{
+				pos: position{line: 464, col: 5, offset: 11775},
+				run: (*parser).callonfieldRename1,
+				expr: &seqExpr{
+					pos: position{line: 464, col: 5, offset: 11775},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 464, col: 5, offset: 11775},
+							label: "target",
+							expr: &ruleRefExpr{
+								pos:  position{line: 464, col: 12, offset: 11782},
+								name: "fieldRefDotOnly",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 464, col: 28, offset: 11798},
+							name: "__",
+						},
+						&litMatcher{
+							pos:        position{line: 464, col: 31, offset: 11801},
+							val:        "=",
+							ignoreCase: false,
+						},
+						&ruleRefExpr{
+							pos:  position{line: 464, col: 35, offset: 11805},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 464, col: 38, offset: 11808},
+							label: "source",
+							expr: &ruleRefExpr{
+								pos:  position{line: 464, col: 45, offset: 11815},
+								name: "fieldRefDotOnly",
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "Assignment",
-			pos:  position{line: 389, col: 1, offset: 9335},
+			pos:  position{line: 468, col: 1, offset: 11890},
 			expr: &actionExpr{
-				pos: position{line: 390, col: 5, offset: 9350},
+				pos: position{line: 469, col: 5, offset: 11905},
 				run: (*parser).callonAssignment1,
 				expr: &seqExpr{
-					pos: position{line: 390, col: 5, offset: 9350},
+					pos: position{line: 469, col: 5, offset: 11905},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 390, col: 5, offset: 9350},
+							pos:   position{line: 469, col: 5, offset: 11905},
 							label: "f",
 							expr: &ruleRefExpr{
-								pos:  position{line: 390, col: 7, offset: 9352},
-								name: "fieldName",
+								pos:  position{line: 469, col: 7, offset: 11907},
+								name: "fieldRefDotOnly",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 390, col: 17, offset: 9362},
+							pos:  position{line: 469, col: 23, offset: 11923},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 390, col: 20, offset: 9365},
+							pos:        position{line: 469, col: 26, offset: 11926},
 							val:        "=",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 390, col: 24, offset: 9369},
+							pos:  position{line: 469, col: 30, offset: 11930},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 390, col: 27, offset: 9372},
+							pos:   position{line: 469, col: 33, offset: 11933},
 							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 390, col: 29, offset: 9374},
+								pos:  position{line: 469, col: 35, offset: 11935},
 								name: "Expression",
 							},
 						},
@@ -3067,79 +3977,79 @@ var g = &grammar{
 		},
 		{
 			name: "PrimaryExpression",
-			pos:  position{line: 394, col: 1, offset: 9433},
+			pos:  position{line: 473, col: 1, offset: 11994},
 			expr: &choiceExpr{
-				pos: position{line: 395, col: 5, offset: 9455},
+				pos: position{line: 474, col: 5, offset: 12016},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 395, col: 5, offset: 9455},
+						pos:  position{line: 474, col: 5, offset: 12016},
 						name: "StringLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 396, col: 5, offset: 9473},
+						pos:  position{line: 475, col: 5, offset: 12034},
 						name: "RegexpLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 397, col: 5, offset: 9491},
+						pos:  position{line: 476, col: 5, offset: 12052},
 						name: "PortLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 398, col: 5, offset: 9507},
+						pos:  position{line: 477, col: 5, offset: 12068},
 						name: "SubnetLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 399, col: 5, offset: 9525},
+						pos:  position{line: 478, col: 5, offset: 12086},
 						name: "AddressLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 400, col: 5, offset: 9544},
+						pos:  position{line: 479, col: 5, offset: 12105},
 						name: "FloatLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 401, col: 5, offset: 9561},
+						pos:  position{line: 480, col: 5, offset: 12122},
 						name: "IntegerLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 402, col: 5, offset: 9580},
+						pos:  position{line: 481, col: 5, offset: 12141},
 						name: "BooleanLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 403, col: 5, offset: 9599},
+						pos:  position{line: 482, col: 5, offset: 12160},
 						name: "NullLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 404, col: 5, offset: 9615},
+						pos:  position{line: 483, col: 5, offset: 12176},
 						name: "FieldReference",
 					},
 					&actionExpr{
-						pos: position{line: 405, col: 5, offset: 9634},
+						pos: position{line: 484, col: 5, offset: 12195},
 						run: (*parser).callonPrimaryExpression12,
 						expr: &seqExpr{
-							pos: position{line: 405, col: 5, offset: 9634},
+							pos: position{line: 484, col: 5, offset: 12195},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 405, col: 5, offset: 9634},
+									pos:        position{line: 484, col: 5, offset: 12195},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 405, col: 9, offset: 9638},
+									pos:  position{line: 484, col: 9, offset: 12199},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 405, col: 12, offset: 9641},
+									pos:   position{line: 484, col: 12, offset: 12202},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 405, col: 17, offset: 9646},
+										pos:  position{line: 484, col: 17, offset: 12207},
 										name: "Expression",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 405, col: 28, offset: 9657},
+									pos:  position{line: 484, col: 28, offset: 12218},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 405, col: 31, offset: 9660},
+									pos:        position{line: 484, col: 31, offset: 12221},
 									val:        ")",
 									ignoreCase: false,
 								},
@@ -3151,15 +4061,15 @@ var g = &grammar{
 		},
 		{
 			name: "FieldReference",
-			pos:  position{line: 407, col: 1, offset: 9686},
+			pos:  position{line: 486, col: 1, offset: 12247},
 			expr: &actionExpr{
-				pos: position{line: 408, col: 5, offset: 9705},
+				pos: position{line: 487, col: 5, offset: 12266},
 				run: (*parser).callonFieldReference1,
 				expr: &labeledExpr{
-					pos:   position{line: 408, col: 5, offset: 9705},
+					pos:   position{line: 487, col: 5, offset: 12266},
 					label: "f",
 					expr: &ruleRefExpr{
-						pos:  position{line: 408, col: 7, offset: 9707},
+						pos:  position{line: 487, col: 7, offset: 12268},
 						name: "fieldName",
 					},
 				},
@@ -3167,71 +4077,71 @@ var g = &grammar{
 		},
 		{
 			name: "Expression",
-			pos:  position{line: 418, col: 1, offset: 9956},
+			pos:  position{line: 497, col: 1, offset: 12517},
 			expr: &ruleRefExpr{
-				pos:  position{line: 418, col: 14, offset: 9969},
+				pos:  position{line: 497, col: 14, offset: 12530},
 				name: "ConditionalExpression",
 			},
 		},
 		{
 			name: "ConditionalExpression",
-			pos:  position{line: 420, col: 1, offset: 9992},
+			pos:  position{line: 499, col: 1, offset: 12553},
 			expr: &choiceExpr{
-				pos: position{line: 421, col: 5, offset: 10018},
+				pos: position{line: 500, col: 5, offset: 12579},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 421, col: 5, offset: 10018},
+						pos: position{line: 500, col: 5, offset: 12579},
 						run: (*parser).callonConditionalExpression2,
 						expr: &seqExpr{
-							pos: position{line: 421, col: 5, offset: 10018},
+							pos: position{line: 500, col: 5, offset: 12579},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 421, col: 5, offset: 10018},
+									pos:   position{line: 500, col: 5, offset: 12579},
 									label: "condition",
 									expr: &ruleRefExpr{
-										pos:  position{line: 421, col: 15, offset: 10028},
+										pos:  position{line: 500, col: 15, offset: 12589},
 										name: "LogicalORExpression",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 421, col: 35, offset: 10048},
+									pos:  position{line: 500, col: 35, offset: 12609},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 421, col: 38, offset: 10051},
+									pos:        position{line: 500, col: 38, offset: 12612},
 									val:        "?",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 421, col: 42, offset: 10055},
+									pos:  position{line: 500, col: 42, offset: 12616},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 421, col: 45, offset: 10058},
+									pos:   position{line: 500, col: 45, offset: 12619},
 									label: "thenClause",
 									expr: &ruleRefExpr{
-										pos:  position{line: 421, col: 56, offset: 10069},
+										pos:  position{line: 500, col: 56, offset: 12630},
 										name: "Expression",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 421, col: 67, offset: 10080},
+									pos:  position{line: 500, col: 67, offset: 12641},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 421, col: 70, offset: 10083},
+									pos:        position{line: 500, col: 70, offset: 12644},
 									val:        ":",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 421, col: 74, offset: 10087},
+									pos:  position{line: 500, col: 74, offset: 12648},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 421, col: 77, offset: 10090},
+									pos:   position{line: 500, col: 77, offset: 12651},
 									label: "elseClause",
 									expr: &ruleRefExpr{
-										pos:  position{line: 421, col: 88, offset: 10101},
+										pos:  position{line: 500, col: 88, offset: 12662},
 										name: "Expression",
 									},
 								},
@@ -3239,7 +4149,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 424, col: 5, offset: 10193},
+						pos:  position{line: 503, col: 5, offset: 12754},
 						name: "LogicalORExpression",
 					},
 				},
@@ -3247,43 +4157,43 @@ var g = &grammar{
 		},
 		{
 			name: "LogicalORExpression",
-			pos:  position{line: 426, col: 1, offset: 10214},
+			pos:  position{line: 505, col: 1, offset: 12775},
 			expr: &actionExpr{
-				pos: position{line: 427, col: 5, offset: 10238},
+				pos: position{line: 506, col: 5, offset: 12799},
 				run: (*parser).callonLogicalORExpression1,
 				expr: &seqExpr{
-					pos: position{line: 427, col: 5, offset: 10238},
+					pos: position{line: 506, col: 5, offset: 12799},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 427, col: 5, offset: 10238},
+							pos:   position{line: 506, col: 5, offset: 12799},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 427, col: 11, offset: 10244},
+								pos:  position{line: 506, col: 11, offset: 12805},
 								name: "LogicalANDExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 428, col: 5, offset: 10269},
+							pos:   position{line: 507, col: 5, offset: 12830},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 428, col: 10, offset: 10274},
+								pos: position{line: 507, col: 10, offset: 12835},
 								expr: &seqExpr{
-									pos: position{line: 428, col: 11, offset: 10275},
+									pos: position{line: 507, col: 11, offset: 12836},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 428, col: 11, offset: 10275},
+											pos:  position{line: 507, col: 11, offset: 12836},
 											name: "__",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 428, col: 14, offset: 10278},
+											pos:  position{line: 507, col: 14, offset: 12839},
 											name: "orToken",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 428, col: 22, offset: 10286},
+											pos:  position{line: 507, col: 22, offset: 12847},
 											name: "__",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 428, col: 25, offset: 10289},
+											pos:  position{line: 507, col: 25, offset: 12850},
 											name: "LogicalANDExpression",
 										},
 									},
@@ -3296,43 +4206,43 @@ var g = &grammar{
 		},
 		{
 			name: "LogicalANDExpression",
-			pos:  position{line: 432, col: 1, offset: 10374},
+			pos:  position{line: 511, col: 1, offset: 12935},
 			expr: &actionExpr{
-				pos: position{line: 433, col: 5, offset: 10399},
+				pos: position{line: 512, col: 5, offset: 12960},
 				run: (*parser).callonLogicalANDExpression1,
 				expr: &seqExpr{
-					pos: position{line: 433, col: 5, offset: 10399},
+					pos: position{line: 512, col: 5, offset: 12960},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 433, col: 5, offset: 10399},
+							pos:   position{line: 512, col: 5, offset: 12960},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 433, col: 11, offset: 10405},
+								pos:  position{line: 512, col: 11, offset: 12966},
 								name: "EqualityCompareExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 434, col: 5, offset: 10435},
+							pos:   position{line: 513, col: 5, offset: 12996},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 434, col: 10, offset: 10440},
+								pos: position{line: 513, col: 10, offset: 13001},
 								expr: &seqExpr{
-									pos: position{line: 434, col: 11, offset: 10441},
+									pos: position{line: 513, col: 11, offset: 13002},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 434, col: 11, offset: 10441},
+											pos:  position{line: 513, col: 11, offset: 13002},
 											name: "__",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 434, col: 14, offset: 10444},
+											pos:  position{line: 513, col: 14, offset: 13005},
 											name: "andToken",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 434, col: 23, offset: 10453},
+											pos:  position{line: 513, col: 23, offset: 13014},
 											name: "__",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 434, col: 26, offset: 10456},
+											pos:  position{line: 513, col: 26, offset: 13017},
 											name: "EqualityCompareExpression",
 										},
 									},
@@ -3345,43 +4255,43 @@ var g = &grammar{
 		},
 		{
 			name: "EqualityCompareExpression",
-			pos:  position{line: 438, col: 1, offset: 10546},
+			pos:  position{line: 517, col: 1, offset: 13107},
 			expr: &actionExpr{
-				pos: position{line: 439, col: 5, offset: 10576},
+				pos: position{line: 518, col: 5, offset: 13137},
 				run: (*parser).callonEqualityCompareExpression1,
 				expr: &seqExpr{
-					pos: position{line: 439, col: 5, offset: 10576},
+					pos: position{line: 518, col: 5, offset: 13137},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 439, col: 5, offset: 10576},
+							pos:   position{line: 518, col: 5, offset: 13137},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 439, col: 11, offset: 10582},
+								pos:  position{line: 518, col: 11, offset: 13143},
 								name: "RelativeExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 440, col: 5, offset: 10605},
+							pos:   position{line: 519, col: 5, offset: 13166},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 440, col: 10, offset: 10610},
+								pos: position{line: 519, col: 10, offset: 13171},
 								expr: &seqExpr{
-									pos: position{line: 440, col: 11, offset: 10611},
+									pos: position{line: 519, col: 11, offset: 13172},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 440, col: 11, offset: 10611},
+											pos:  position{line: 519, col: 11, offset: 13172},
 											name: "__",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 440, col: 14, offset: 10614},
+											pos:  position{line: 519, col: 14, offset: 13175},
 											name: "EqualityComparator",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 440, col: 33, offset: 10633},
+											pos:  position{line: 519, col: 33, offset: 13194},
 											name: "__",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 440, col: 36, offset: 10636},
+											pos:  position{line: 519, col: 36, offset: 13197},
 											name: "RelativeExpression",
 										},
 									},
@@ -3394,30 +4304,30 @@ var g = &grammar{
 		},
 		{
 			name: "EqualityOperator",
-			pos:  position{line: 444, col: 1, offset: 10719},
+			pos:  position{line: 523, col: 1, offset: 13280},
 			expr: &actionExpr{
-				pos: position{line: 444, col: 20, offset: 10738},
+				pos: position{line: 523, col: 20, offset: 13299},
 				run: (*parser).callonEqualityOperator1,
 				expr: &choiceExpr{
-					pos: position{line: 444, col: 21, offset: 10739},
+					pos: position{line: 523, col: 21, offset: 13300},
 					alternatives: []interface{}{
 						&litMatcher{
-							pos:        position{line: 444, col: 21, offset: 10739},
+							pos:        position{line: 523, col: 21, offset: 13300},
 							val:        "=~",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 444, col: 28, offset: 10746},
+							pos:        position{line: 523, col: 28, offset: 13307},
 							val:        "!~",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 444, col: 35, offset: 10753},
+							pos:        position{line: 523, col: 35, offset: 13314},
 							val:        "=",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 444, col: 41, offset: 10759},
+							pos:        position{line: 523, col: 41, offset: 13320},
 							val:        "!=",
 							ignoreCase: false,
 						},
@@ -3427,19 +4337,19 @@ var g = &grammar{
 		},
 		{
 			name: "EqualityComparator",
-			pos:  position{line: 446, col: 1, offset: 10797},
+			pos:  position{line: 525, col: 1, offset: 13358},
 			expr: &choiceExpr{
-				pos: position{line: 447, col: 5, offset: 10820},
+				pos: position{line: 526, col: 5, offset: 13381},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 447, col: 5, offset: 10820},
+						pos:  position{line: 526, col: 5, offset: 13381},
 						name: "EqualityOperator",
 					},
 					&actionExpr{
-						pos: position{line: 448, col: 5, offset: 10841},
+						pos: position{line: 527, col: 5, offset: 13402},
 						run: (*parser).callonEqualityComparator3,
 						expr: &litMatcher{
-							pos:        position{line: 448, col: 5, offset: 10841},
+							pos:        position{line: 527, col: 5, offset: 13402},
 							val:        "in",
 							ignoreCase: false,
 						},
@@ -3449,43 +4359,43 @@ var g = &grammar{
 		},
 		{
 			name: "RelativeExpression",
-			pos:  position{line: 450, col: 1, offset: 10878},
+			pos:  position{line: 529, col: 1, offset: 13439},
 			expr: &actionExpr{
-				pos: position{line: 451, col: 5, offset: 10901},
+				pos: position{line: 530, col: 5, offset: 13462},
 				run: (*parser).callonRelativeExpression1,
 				expr: &seqExpr{
-					pos: position{line: 451, col: 5, offset: 10901},
+					pos: position{line: 530, col: 5, offset: 13462},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 451, col: 5, offset: 10901},
+							pos:   position{line: 530, col: 5, offset: 13462},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 451, col: 11, offset: 10907},
+								pos:  position{line: 530, col: 11, offset: 13468},
 								name: "AdditiveExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 452, col: 5, offset: 10930},
+							pos:   position{line: 531, col: 5, offset: 13491},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 452, col: 10, offset: 10935},
+								pos: position{line: 531, col: 10, offset: 13496},
 								expr: &seqExpr{
-									pos: position{line: 452, col: 11, offset: 10936},
+									pos: position{line: 531, col: 11, offset: 13497},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 452, col: 11, offset: 10936},
+											pos:  position{line: 531, col: 11, offset: 13497},
 											name: "__",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 452, col: 14, offset: 10939},
+											pos:  position{line: 531, col: 14, offset: 13500},
 											name: "RelativeOperator",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 452, col: 31, offset: 10956},
+											pos:  position{line: 531, col: 31, offset: 13517},
 											name: "__",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 452, col: 34, offset: 10959},
+											pos:  position{line: 531, col: 34, offset: 13520},
 											name: "AdditiveExpression",
 										},
 									},
@@ -3498,30 +4408,30 @@ var g = &grammar{
 		},
 		{
 			name: "RelativeOperator",
-			pos:  position{line: 456, col: 1, offset: 11042},
+			pos:  position{line: 535, col: 1, offset: 13603},
 			expr: &actionExpr{
-				pos: position{line: 456, col: 20, offset: 11061},
+				pos: position{line: 535, col: 20, offset: 13622},
 				run: (*parser).callonRelativeOperator1,
 				expr: &choiceExpr{
-					pos: position{line: 456, col: 21, offset: 11062},
+					pos: position{line: 535, col: 21, offset: 13623},
 					alternatives: []interface{}{
 						&litMatcher{
-							pos:        position{line: 456, col: 21, offset: 11062},
+							pos:        position{line: 535, col: 21, offset: 13623},
 							val:        "<=",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 456, col: 28, offset: 11069},
+							pos:        position{line: 535, col: 28, offset: 13630},
 							val:        "<",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 456, col: 34, offset: 11075},
+							pos:        position{line: 535, col: 34, offset: 13636},
 							val:        ">=",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 456, col: 41, offset: 11082},
+							pos:        position{line: 535, col: 41, offset: 13643},
 							val:        ">",
 							ignoreCase: false,
 						},
@@ -3531,43 +4441,43 @@ var g = &grammar{
 		},
 		{
 			name: "AdditiveExpression",
-			pos:  position{line: 458, col: 1, offset: 11119},
+			pos:  position{line: 537, col: 1, offset: 13680},
 			expr: &actionExpr{
-				pos: position{line: 459, col: 5, offset: 11142},
+				pos: position{line: 538, col: 5, offset: 13703},
 				run: (*parser).callonAdditiveExpression1,
 				expr: &seqExpr{
-					pos: position{line: 459, col: 5, offset: 11142},
+					pos: position{line: 538, col: 5, offset: 13703},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 459, col: 5, offset: 11142},
+							pos:   position{line: 538, col: 5, offset: 13703},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 459, col: 11, offset: 11148},
+								pos:  position{line: 538, col: 11, offset: 13709},
 								name: "MultiplicativeExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 460, col: 5, offset: 11177},
+							pos:   position{line: 539, col: 5, offset: 13738},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 460, col: 10, offset: 11182},
+								pos: position{line: 539, col: 10, offset: 13743},
 								expr: &seqExpr{
-									pos: position{line: 460, col: 11, offset: 11183},
+									pos: position{line: 539, col: 11, offset: 13744},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 460, col: 11, offset: 11183},
+											pos:  position{line: 539, col: 11, offset: 13744},
 											name: "__",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 460, col: 14, offset: 11186},
+											pos:  position{line: 539, col: 14, offset: 13747},
 											name: "AdditiveOperator",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 460, col: 31, offset: 11203},
+											pos:  position{line: 539, col: 31, offset: 13764},
 											name: "__",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 460, col: 34, offset: 11206},
+											pos:  position{line: 539, col: 34, offset: 13767},
 											name: "MultiplicativeExpression",
 										},
 									},
@@ -3580,20 +4490,20 @@ var g = &grammar{
 		},
 		{
 			name: "AdditiveOperator",
-			pos:  position{line: 464, col: 1, offset: 11295},
+			pos:  position{line: 543, col: 1, offset: 13856},
 			expr: &actionExpr{
-				pos: position{line: 464, col: 20, offset: 11314},
+				pos: position{line: 543, col: 20, offset: 13875},
 				run: (*parser).callonAdditiveOperator1,
 				expr: &choiceExpr{
-					pos: position{line: 464, col: 21, offset: 11315},
+					pos: position{line: 543, col: 21, offset: 13876},
 					alternatives: []interface{}{
 						&litMatcher{
-							pos:        position{line: 464, col: 21, offset: 11315},
+							pos:        position{line: 543, col: 21, offset: 13876},
 							val:        "+",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 464, col: 27, offset: 11321},
+							pos:        position{line: 543, col: 27, offset: 13882},
 							val:        "-",
 							ignoreCase: false,
 						},
@@ -3603,50 +4513,50 @@ var g = &grammar{
 		},
 		{
 			name: "MultiplicativeExpression",
-			pos:  position{line: 466, col: 1, offset: 11358},
+			pos:  position{line: 545, col: 1, offset: 13919},
 			expr: &actionExpr{
-				pos: position{line: 467, col: 5, offset: 11387},
+				pos: position{line: 546, col: 5, offset: 13948},
 				run: (*parser).callonMultiplicativeExpression1,
 				expr: &seqExpr{
-					pos: position{line: 467, col: 5, offset: 11387},
+					pos: position{line: 546, col: 5, offset: 13948},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 467, col: 5, offset: 11387},
+							pos:   position{line: 546, col: 5, offset: 13948},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 467, col: 11, offset: 11393},
+								pos:  position{line: 546, col: 11, offset: 13954},
 								name: "NotExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 468, col: 5, offset: 11411},
+							pos:   position{line: 547, col: 5, offset: 13972},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 468, col: 10, offset: 11416},
+								pos: position{line: 547, col: 10, offset: 13977},
 								expr: &seqExpr{
-									pos: position{line: 468, col: 11, offset: 11417},
+									pos: position{line: 547, col: 11, offset: 13978},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 468, col: 11, offset: 11417},
+											pos:  position{line: 547, col: 11, offset: 13978},
 											name: "__",
 										},
 										&labeledExpr{
-											pos:   position{line: 468, col: 14, offset: 11420},
+											pos:   position{line: 547, col: 14, offset: 13981},
 											label: "op",
 											expr: &ruleRefExpr{
-												pos:  position{line: 468, col: 17, offset: 11423},
+												pos:  position{line: 547, col: 17, offset: 13984},
 												name: "MultiplicativeOperator",
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 468, col: 40, offset: 11446},
+											pos:  position{line: 547, col: 40, offset: 14007},
 											name: "__",
 										},
 										&labeledExpr{
-											pos:   position{line: 468, col: 43, offset: 11449},
+											pos:   position{line: 547, col: 43, offset: 14010},
 											label: "operand",
 											expr: &ruleRefExpr{
-												pos:  position{line: 468, col: 51, offset: 11457},
+												pos:  position{line: 547, col: 51, offset: 14018},
 												name: "NotExpression",
 											},
 										},
@@ -3660,20 +4570,20 @@ var g = &grammar{
 		},
 		{
 			name: "MultiplicativeOperator",
-			pos:  position{line: 472, col: 1, offset: 11535},
+			pos:  position{line: 551, col: 1, offset: 14096},
 			expr: &actionExpr{
-				pos: position{line: 472, col: 26, offset: 11560},
+				pos: position{line: 551, col: 26, offset: 14121},
 				run: (*parser).callonMultiplicativeOperator1,
 				expr: &choiceExpr{
-					pos: position{line: 472, col: 27, offset: 11561},
+					pos: position{line: 551, col: 27, offset: 14122},
 					alternatives: []interface{}{
 						&litMatcher{
-							pos:        position{line: 472, col: 27, offset: 11561},
+							pos:        position{line: 551, col: 27, offset: 14122},
 							val:        "*",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 472, col: 33, offset: 11567},
+							pos:        position{line: 551, col: 33, offset: 14128},
 							val:        "/",
 							ignoreCase: false,
 						},
@@ -3683,30 +4593,30 @@ var g = &grammar{
 		},
 		{
 			name: "NotExpression",
-			pos:  position{line: 474, col: 1, offset: 11604},
+			pos:  position{line: 553, col: 1, offset: 14165},
 			expr: &choiceExpr{
-				pos: position{line: 475, col: 5, offset: 11622},
+				pos: position{line: 554, col: 5, offset: 14183},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 475, col: 5, offset: 11622},
+						pos: position{line: 554, col: 5, offset: 14183},
 						run: (*parser).callonNotExpression2,
 						expr: &seqExpr{
-							pos: position{line: 475, col: 5, offset: 11622},
+							pos: position{line: 554, col: 5, offset: 14183},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 475, col: 5, offset: 11622},
+									pos:        position{line: 554, col: 5, offset: 14183},
 									val:        "!",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 475, col: 9, offset: 11626},
+									pos:  position{line: 554, col: 9, offset: 14187},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 475, col: 12, offset: 11629},
+									pos:   position{line: 554, col: 12, offset: 14190},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 475, col: 14, offset: 11631},
+										pos:  position{line: 554, col: 14, offset: 14192},
 										name: "NotExpression",
 									},
 								},
@@ -3714,7 +4624,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 478, col: 5, offset: 11699},
+						pos:  position{line: 557, col: 5, offset: 14260},
 						name: "CastExpression",
 					},
 				},
@@ -3722,50 +4632,50 @@ var g = &grammar{
 		},
 		{
 			name: "CastExpression",
-			pos:  position{line: 480, col: 1, offset: 11715},
+			pos:  position{line: 559, col: 1, offset: 14276},
 			expr: &actionExpr{
-				pos: position{line: 481, col: 5, offset: 11734},
+				pos: position{line: 560, col: 5, offset: 14295},
 				run: (*parser).callonCastExpression1,
 				expr: &seqExpr{
-					pos: position{line: 481, col: 5, offset: 11734},
+					pos: position{line: 560, col: 5, offset: 14295},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 481, col: 5, offset: 11734},
+							pos:   position{line: 560, col: 5, offset: 14295},
 							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 481, col: 7, offset: 11736},
+								pos:  position{line: 560, col: 7, offset: 14297},
 								name: "CallExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 481, col: 22, offset: 11751},
+							pos:   position{line: 560, col: 22, offset: 14312},
 							label: "t",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 481, col: 24, offset: 11753},
+								pos: position{line: 560, col: 24, offset: 14314},
 								expr: &actionExpr{
-									pos: position{line: 481, col: 25, offset: 11754},
+									pos: position{line: 560, col: 25, offset: 14315},
 									run: (*parser).callonCastExpression7,
 									expr: &seqExpr{
-										pos: position{line: 481, col: 25, offset: 11754},
+										pos: position{line: 560, col: 25, offset: 14315},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 481, col: 25, offset: 11754},
+												pos:  position{line: 560, col: 25, offset: 14315},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 481, col: 28, offset: 11757},
+												pos:        position{line: 560, col: 28, offset: 14318},
 												val:        ":",
 												ignoreCase: false,
 											},
 											&ruleRefExpr{
-												pos:  position{line: 481, col: 32, offset: 11761},
+												pos:  position{line: 560, col: 32, offset: 14322},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 481, col: 35, offset: 11764},
+												pos:   position{line: 560, col: 35, offset: 14325},
 												label: "ct",
 												expr: &ruleRefExpr{
-													pos:  position{line: 481, col: 38, offset: 11767},
+													pos:  position{line: 560, col: 38, offset: 14328},
 													name: "ZngType",
 												},
 											},
@@ -3780,82 +4690,82 @@ var g = &grammar{
 		},
 		{
 			name: "ZngType",
-			pos:  position{line: 489, col: 1, offset: 11903},
+			pos:  position{line: 568, col: 1, offset: 14464},
 			expr: &choiceExpr{
-				pos: position{line: 490, col: 4, offset: 11914},
+				pos: position{line: 569, col: 4, offset: 14475},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 490, col: 4, offset: 11914},
+						pos:        position{line: 569, col: 4, offset: 14475},
 						val:        "bool",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 490, col: 13, offset: 11923},
+						pos:        position{line: 569, col: 13, offset: 14484},
 						val:        "byte",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 490, col: 22, offset: 11932},
+						pos:        position{line: 569, col: 22, offset: 14493},
 						val:        "int16",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 490, col: 32, offset: 11942},
+						pos:        position{line: 569, col: 32, offset: 14503},
 						val:        "uint16",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 490, col: 43, offset: 11953},
+						pos:        position{line: 569, col: 43, offset: 14514},
 						val:        "int32",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 490, col: 53, offset: 11963},
+						pos:        position{line: 569, col: 53, offset: 14524},
 						val:        "uint32",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 491, col: 4, offset: 11975},
+						pos:        position{line: 570, col: 4, offset: 14536},
 						val:        "int64",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 491, col: 14, offset: 11985},
+						pos:        position{line: 570, col: 14, offset: 14546},
 						val:        "uint64",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 491, col: 25, offset: 11996},
+						pos:        position{line: 570, col: 25, offset: 14557},
 						val:        "float64",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 491, col: 37, offset: 12008},
+						pos:        position{line: 570, col: 37, offset: 14569},
 						val:        "string",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 491, col: 48, offset: 12019},
+						pos:        position{line: 570, col: 48, offset: 14580},
 						val:        "bstring",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 492, col: 4, offset: 12032},
+						pos:        position{line: 571, col: 4, offset: 14593},
 						val:        "ip",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 492, col: 11, offset: 12039},
+						pos:        position{line: 571, col: 11, offset: 14600},
 						val:        "net",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 492, col: 19, offset: 12047},
+						pos:        position{line: 571, col: 19, offset: 14608},
 						val:        "time",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 492, col: 28, offset: 12056},
+						pos:        position{line: 571, col: 28, offset: 14617},
 						val:        "duration",
 						ignoreCase: false,
 					},
@@ -3864,43 +4774,43 @@ var g = &grammar{
 		},
 		{
 			name: "CallExpression",
-			pos:  position{line: 494, col: 1, offset: 12068},
+			pos:  position{line: 573, col: 1, offset: 14629},
 			expr: &choiceExpr{
-				pos: position{line: 495, col: 5, offset: 12087},
+				pos: position{line: 574, col: 5, offset: 14648},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 495, col: 5, offset: 12087},
+						pos: position{line: 574, col: 5, offset: 14648},
 						run: (*parser).callonCallExpression2,
 						expr: &seqExpr{
-							pos: position{line: 495, col: 5, offset: 12087},
+							pos: position{line: 574, col: 5, offset: 14648},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 495, col: 5, offset: 12087},
+									pos:   position{line: 574, col: 5, offset: 14648},
 									label: "fn",
 									expr: &ruleRefExpr{
-										pos:  position{line: 495, col: 8, offset: 12090},
+										pos:  position{line: 574, col: 8, offset: 14651},
 										name: "FunctionName",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 495, col: 21, offset: 12103},
+									pos:  position{line: 574, col: 21, offset: 14664},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 495, col: 24, offset: 12106},
+									pos:        position{line: 574, col: 24, offset: 14667},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 495, col: 28, offset: 12110},
+									pos:   position{line: 574, col: 28, offset: 14671},
 									label: "args",
 									expr: &ruleRefExpr{
-										pos:  position{line: 495, col: 33, offset: 12115},
+										pos:  position{line: 574, col: 33, offset: 14676},
 										name: "ArgumentList",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 495, col: 46, offset: 12128},
+									pos:        position{line: 574, col: 46, offset: 14689},
 									val:        ")",
 									ignoreCase: false,
 								},
@@ -3908,7 +4818,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 498, col: 5, offset: 12191},
+						pos:  position{line: 577, col: 5, offset: 14752},
 						name: "DereferenceExpression",
 					},
 				},
@@ -3916,21 +4826,21 @@ var g = &grammar{
 		},
 		{
 			name: "FunctionName",
-			pos:  position{line: 500, col: 1, offset: 12214},
+			pos:  position{line: 579, col: 1, offset: 14775},
 			expr: &actionExpr{
-				pos: position{line: 501, col: 5, offset: 12231},
+				pos: position{line: 580, col: 5, offset: 14792},
 				run: (*parser).callonFunctionName1,
 				expr: &seqExpr{
-					pos: position{line: 501, col: 5, offset: 12231},
+					pos: position{line: 580, col: 5, offset: 14792},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 501, col: 5, offset: 12231},
+							pos:  position{line: 580, col: 5, offset: 14792},
 							name: "FunctionNameStart",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 501, col: 23, offset: 12249},
+							pos: position{line: 580, col: 23, offset: 14810},
 							expr: &ruleRefExpr{
-								pos:  position{line: 501, col: 23, offset: 12249},
+								pos:  position{line: 580, col: 23, offset: 14810},
 								name: "FunctionNameRest",
 							},
 						},
@@ -3940,9 +4850,9 @@ var g = &grammar{
 		},
 		{
 			name: "FunctionNameStart",
-			pos:  position{line: 503, col: 1, offset: 12299},
+			pos:  position{line: 582, col: 1, offset: 14860},
 			expr: &charClassMatcher{
-				pos:        position{line: 503, col: 21, offset: 12319},
+				pos:        position{line: 582, col: 21, offset: 14880},
 				val:        "[A-Za-z]",
 				ranges:     []rune{'A', 'Z', 'a', 'z'},
 				ignoreCase: false,
@@ -3951,16 +4861,16 @@ var g = &grammar{
 		},
 		{
 			name: "FunctionNameRest",
-			pos:  position{line: 504, col: 1, offset: 12328},
+			pos:  position{line: 583, col: 1, offset: 14889},
 			expr: &choiceExpr{
-				pos: position{line: 504, col: 20, offset: 12347},
+				pos: position{line: 583, col: 20, offset: 14908},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 504, col: 20, offset: 12347},
+						pos:  position{line: 583, col: 20, offset: 14908},
 						name: "FunctionNameStart",
 					},
 					&charClassMatcher{
-						pos:        position{line: 504, col: 40, offset: 12367},
+						pos:        position{line: 583, col: 40, offset: 14928},
 						val:        "[.0-9]",
 						chars:      []rune{'.'},
 						ranges:     []rune{'0', '9'},
@@ -3972,53 +4882,53 @@ var g = &grammar{
 		},
 		{
 			name: "ArgumentList",
-			pos:  position{line: 506, col: 1, offset: 12375},
+			pos:  position{line: 585, col: 1, offset: 14936},
 			expr: &choiceExpr{
-				pos: position{line: 507, col: 5, offset: 12392},
+				pos: position{line: 586, col: 5, offset: 14953},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 507, col: 5, offset: 12392},
+						pos: position{line: 586, col: 5, offset: 14953},
 						run: (*parser).callonArgumentList2,
 						expr: &seqExpr{
-							pos: position{line: 507, col: 5, offset: 12392},
+							pos: position{line: 586, col: 5, offset: 14953},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 507, col: 5, offset: 12392},
+									pos:   position{line: 586, col: 5, offset: 14953},
 									label: "first",
 									expr: &ruleRefExpr{
-										pos:  position{line: 507, col: 11, offset: 12398},
+										pos:  position{line: 586, col: 11, offset: 14959},
 										name: "Expression",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 507, col: 22, offset: 12409},
+									pos:   position{line: 586, col: 22, offset: 14970},
 									label: "rest",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 507, col: 27, offset: 12414},
+										pos: position{line: 586, col: 27, offset: 14975},
 										expr: &actionExpr{
-											pos: position{line: 507, col: 28, offset: 12415},
+											pos: position{line: 586, col: 28, offset: 14976},
 											run: (*parser).callonArgumentList8,
 											expr: &seqExpr{
-												pos: position{line: 507, col: 28, offset: 12415},
+												pos: position{line: 586, col: 28, offset: 14976},
 												exprs: []interface{}{
 													&ruleRefExpr{
-														pos:  position{line: 507, col: 28, offset: 12415},
+														pos:  position{line: 586, col: 28, offset: 14976},
 														name: "__",
 													},
 													&litMatcher{
-														pos:        position{line: 507, col: 31, offset: 12418},
+														pos:        position{line: 586, col: 31, offset: 14979},
 														val:        ",",
 														ignoreCase: false,
 													},
 													&ruleRefExpr{
-														pos:  position{line: 507, col: 35, offset: 12422},
+														pos:  position{line: 586, col: 35, offset: 14983},
 														name: "__",
 													},
 													&labeledExpr{
-														pos:   position{line: 507, col: 38, offset: 12425},
+														pos:   position{line: 586, col: 38, offset: 14986},
 														label: "e",
 														expr: &ruleRefExpr{
-															pos:  position{line: 507, col: 40, offset: 12427},
+															pos:  position{line: 586, col: 40, offset: 14988},
 															name: "Expression",
 														},
 													},
@@ -4031,10 +4941,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 510, col: 5, offset: 12543},
+						pos: position{line: 589, col: 5, offset: 15104},
 						run: (*parser).callonArgumentList15,
 						expr: &ruleRefExpr{
-							pos:  position{line: 510, col: 5, offset: 12543},
+							pos:  position{line: 589, col: 5, offset: 15104},
 							name: "__",
 						},
 					},
@@ -4043,88 +4953,88 @@ var g = &grammar{
 		},
 		{
 			name: "DereferenceExpression",
-			pos:  position{line: 512, col: 1, offset: 12579},
+			pos:  position{line: 591, col: 1, offset: 15140},
 			expr: &actionExpr{
-				pos: position{line: 513, col: 5, offset: 12605},
+				pos: position{line: 592, col: 5, offset: 15166},
 				run: (*parser).callonDereferenceExpression1,
 				expr: &seqExpr{
-					pos: position{line: 513, col: 5, offset: 12605},
+					pos: position{line: 592, col: 5, offset: 15166},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 513, col: 5, offset: 12605},
+							pos:   position{line: 592, col: 5, offset: 15166},
 							label: "base",
 							expr: &ruleRefExpr{
-								pos:  position{line: 513, col: 10, offset: 12610},
+								pos:  position{line: 592, col: 10, offset: 15171},
 								name: "PrimaryExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 514, col: 5, offset: 12632},
+							pos:   position{line: 593, col: 5, offset: 15193},
 							label: "derefs",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 514, col: 12, offset: 12639},
+								pos: position{line: 593, col: 12, offset: 15200},
 								expr: &choiceExpr{
-									pos: position{line: 515, col: 9, offset: 12649},
+									pos: position{line: 594, col: 9, offset: 15210},
 									alternatives: []interface{}{
 										&seqExpr{
-											pos: position{line: 515, col: 9, offset: 12649},
+											pos: position{line: 594, col: 9, offset: 15210},
 											exprs: []interface{}{
 												&ruleRefExpr{
-													pos:  position{line: 515, col: 9, offset: 12649},
+													pos:  position{line: 594, col: 9, offset: 15210},
 													name: "__",
 												},
 												&litMatcher{
-													pos:        position{line: 515, col: 12, offset: 12652},
+													pos:        position{line: 594, col: 12, offset: 15213},
 													val:        "[",
 													ignoreCase: false,
 												},
 												&ruleRefExpr{
-													pos:  position{line: 515, col: 16, offset: 12656},
+													pos:  position{line: 594, col: 16, offset: 15217},
 													name: "__",
 												},
 												&labeledExpr{
-													pos:   position{line: 515, col: 19, offset: 12659},
+													pos:   position{line: 594, col: 19, offset: 15220},
 													label: "index",
 													expr: &ruleRefExpr{
-														pos:  position{line: 515, col: 25, offset: 12665},
+														pos:  position{line: 594, col: 25, offset: 15226},
 														name: "Expression",
 													},
 												},
 												&ruleRefExpr{
-													pos:  position{line: 515, col: 36, offset: 12676},
+													pos:  position{line: 594, col: 36, offset: 15237},
 													name: "__",
 												},
 												&litMatcher{
-													pos:        position{line: 515, col: 39, offset: 12679},
+													pos:        position{line: 594, col: 39, offset: 15240},
 													val:        "]",
 													ignoreCase: false,
 												},
 											},
 										},
 										&seqExpr{
-											pos: position{line: 516, col: 9, offset: 12691},
+											pos: position{line: 595, col: 9, offset: 15252},
 											exprs: []interface{}{
 												&ruleRefExpr{
-													pos:  position{line: 516, col: 9, offset: 12691},
+													pos:  position{line: 595, col: 9, offset: 15252},
 													name: "__",
 												},
 												&litMatcher{
-													pos:        position{line: 516, col: 12, offset: 12694},
+													pos:        position{line: 595, col: 12, offset: 15255},
 													val:        ".",
 													ignoreCase: false,
 												},
 												&ruleRefExpr{
-													pos:  position{line: 516, col: 16, offset: 12698},
+													pos:  position{line: 595, col: 16, offset: 15259},
 													name: "__",
 												},
 												&actionExpr{
-													pos: position{line: 516, col: 20, offset: 12702},
+													pos: position{line: 595, col: 20, offset: 15263},
 													run: (*parser).callonDereferenceExpression20,
 													expr: &labeledExpr{
-														pos:   position{line: 516, col: 20, offset: 12702},
+														pos:   position{line: 595, col: 20, offset: 15263},
 														label: "field",
 														expr: &ruleRefExpr{
-															pos:  position{line: 516, col: 26, offset: 12708},
+															pos:  position{line: 595, col: 26, offset: 15269},
 															name: "fieldName",
 														},
 													},
@@ -4141,54 +5051,54 @@ var g = &grammar{
 		},
 		{
 			name: "duration",
-			pos:  position{line: 521, col: 1, offset: 12843},
+			pos:  position{line: 600, col: 1, offset: 15404},
 			expr: &choiceExpr{
-				pos: position{line: 522, col: 5, offset: 12856},
+				pos: position{line: 601, col: 5, offset: 15417},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 522, col: 5, offset: 12856},
+						pos:  position{line: 601, col: 5, offset: 15417},
 						name: "seconds",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 523, col: 5, offset: 12868},
+						pos:  position{line: 602, col: 5, offset: 15429},
 						name: "minutes",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 524, col: 5, offset: 12880},
+						pos:  position{line: 603, col: 5, offset: 15441},
 						name: "hours",
 					},
 					&seqExpr{
-						pos: position{line: 525, col: 5, offset: 12890},
+						pos: position{line: 604, col: 5, offset: 15451},
 						exprs: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 525, col: 5, offset: 12890},
+								pos:  position{line: 604, col: 5, offset: 15451},
 								name: "hours",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 525, col: 11, offset: 12896},
+								pos:  position{line: 604, col: 11, offset: 15457},
 								name: "_",
 							},
 							&litMatcher{
-								pos:        position{line: 525, col: 13, offset: 12898},
+								pos:        position{line: 604, col: 13, offset: 15459},
 								val:        "and",
 								ignoreCase: false,
 							},
 							&ruleRefExpr{
-								pos:  position{line: 525, col: 19, offset: 12904},
+								pos:  position{line: 604, col: 19, offset: 15465},
 								name: "_",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 525, col: 21, offset: 12906},
+								pos:  position{line: 604, col: 21, offset: 15467},
 								name: "minutes",
 							},
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 526, col: 5, offset: 12918},
+						pos:  position{line: 605, col: 5, offset: 15479},
 						name: "days",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 527, col: 5, offset: 12927},
+						pos:  position{line: 606, col: 5, offset: 15488},
 						name: "weeks",
 					},
 				},
@@ -4196,32 +5106,32 @@ var g = &grammar{
 		},
 		{
 			name: "sec_abbrev",
-			pos:  position{line: 529, col: 1, offset: 12934},
+			pos:  position{line: 608, col: 1, offset: 15495},
 			expr: &choiceExpr{
-				pos: position{line: 530, col: 5, offset: 12949},
+				pos: position{line: 609, col: 5, offset: 15510},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 530, col: 5, offset: 12949},
+						pos:        position{line: 609, col: 5, offset: 15510},
 						val:        "seconds",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 531, col: 5, offset: 12963},
+						pos:        position{line: 610, col: 5, offset: 15524},
 						val:        "second",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 532, col: 5, offset: 12976},
+						pos:        position{line: 611, col: 5, offset: 15537},
 						val:        "secs",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 533, col: 5, offset: 12987},
+						pos:        position{line: 612, col: 5, offset: 15548},
 						val:        "sec",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 534, col: 5, offset: 12997},
+						pos:        position{line: 613, col: 5, offset: 15558},
 						val:        "s",
 						ignoreCase: false,
 					},
@@ -4230,32 +5140,32 @@ var g = &grammar{
 		},
 		{
 			name: "min_abbrev",
-			pos:  position{line: 536, col: 1, offset: 13002},
+			pos:  position{line: 615, col: 1, offset: 15563},
 			expr: &choiceExpr{
-				pos: position{line: 537, col: 5, offset: 13017},
+				pos: position{line: 616, col: 5, offset: 15578},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 537, col: 5, offset: 13017},
+						pos:        position{line: 616, col: 5, offset: 15578},
 						val:        "minutes",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 538, col: 5, offset: 13031},
+						pos:        position{line: 617, col: 5, offset: 15592},
 						val:        "minute",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 539, col: 5, offset: 13044},
+						pos:        position{line: 618, col: 5, offset: 15605},
 						val:        "mins",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 540, col: 5, offset: 13055},
+						pos:        position{line: 619, col: 5, offset: 15616},
 						val:        "min",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 541, col: 5, offset: 13065},
+						pos:        position{line: 620, col: 5, offset: 15626},
 						val:        "m",
 						ignoreCase: false,
 					},
@@ -4264,32 +5174,32 @@ var g = &grammar{
 		},
 		{
 			name: "hour_abbrev",
-			pos:  position{line: 543, col: 1, offset: 13070},
+			pos:  position{line: 622, col: 1, offset: 15631},
 			expr: &choiceExpr{
-				pos: position{line: 544, col: 5, offset: 13086},
+				pos: position{line: 623, col: 5, offset: 15647},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 544, col: 5, offset: 13086},
+						pos:        position{line: 623, col: 5, offset: 15647},
 						val:        "hours",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 545, col: 5, offset: 13098},
+						pos:        position{line: 624, col: 5, offset: 15659},
 						val:        "hrs",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 546, col: 5, offset: 13108},
+						pos:        position{line: 625, col: 5, offset: 15669},
 						val:        "hr",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 547, col: 5, offset: 13117},
+						pos:        position{line: 626, col: 5, offset: 15678},
 						val:        "h",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 548, col: 5, offset: 13125},
+						pos:        position{line: 627, col: 5, offset: 15686},
 						val:        "hour",
 						ignoreCase: false,
 					},
@@ -4298,22 +5208,22 @@ var g = &grammar{
 		},
 		{
 			name: "day_abbrev",
-			pos:  position{line: 550, col: 1, offset: 13133},
+			pos:  position{line: 629, col: 1, offset: 15694},
 			expr: &choiceExpr{
-				pos: position{line: 550, col: 14, offset: 13146},
+				pos: position{line: 629, col: 14, offset: 15707},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 550, col: 14, offset: 13146},
+						pos:        position{line: 629, col: 14, offset: 15707},
 						val:        "days",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 550, col: 21, offset: 13153},
+						pos:        position{line: 629, col: 21, offset: 15714},
 						val:        "day",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 550, col: 27, offset: 13159},
+						pos:        position{line: 629, col: 27, offset: 15720},
 						val:        "d",
 						ignoreCase: false,
 					},
@@ -4322,32 +5232,32 @@ var g = &grammar{
 		},
 		{
 			name: "week_abbrev",
-			pos:  position{line: 551, col: 1, offset: 13163},
+			pos:  position{line: 630, col: 1, offset: 15724},
 			expr: &choiceExpr{
-				pos: position{line: 551, col: 15, offset: 13177},
+				pos: position{line: 630, col: 15, offset: 15738},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 551, col: 15, offset: 13177},
+						pos:        position{line: 630, col: 15, offset: 15738},
 						val:        "weeks",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 551, col: 23, offset: 13185},
+						pos:        position{line: 630, col: 23, offset: 15746},
 						val:        "week",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 551, col: 30, offset: 13192},
+						pos:        position{line: 630, col: 30, offset: 15753},
 						val:        "wks",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 551, col: 36, offset: 13198},
+						pos:        position{line: 630, col: 36, offset: 15759},
 						val:        "wk",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 551, col: 41, offset: 13203},
+						pos:        position{line: 630, col: 41, offset: 15764},
 						val:        "w",
 						ignoreCase: false,
 					},
@@ -4356,42 +5266,42 @@ var g = &grammar{
 		},
 		{
 			name: "seconds",
-			pos:  position{line: 553, col: 1, offset: 13208},
+			pos:  position{line: 632, col: 1, offset: 15769},
 			expr: &choiceExpr{
-				pos: position{line: 554, col: 5, offset: 13220},
+				pos: position{line: 633, col: 5, offset: 15781},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 554, col: 5, offset: 13220},
+						pos: position{line: 633, col: 5, offset: 15781},
 						run: (*parser).callonseconds2,
 						expr: &litMatcher{
-							pos:        position{line: 554, col: 5, offset: 13220},
+							pos:        position{line: 633, col: 5, offset: 15781},
 							val:        "second",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 555, col: 5, offset: 13265},
+						pos: position{line: 634, col: 5, offset: 15826},
 						run: (*parser).callonseconds4,
 						expr: &seqExpr{
-							pos: position{line: 555, col: 5, offset: 13265},
+							pos: position{line: 634, col: 5, offset: 15826},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 555, col: 5, offset: 13265},
+									pos:   position{line: 634, col: 5, offset: 15826},
 									label: "num",
 									expr: &ruleRefExpr{
-										pos:  position{line: 555, col: 9, offset: 13269},
+										pos:  position{line: 634, col: 9, offset: 15830},
 										name: "number",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 555, col: 16, offset: 13276},
+									pos: position{line: 634, col: 16, offset: 15837},
 									expr: &ruleRefExpr{
-										pos:  position{line: 555, col: 16, offset: 13276},
+										pos:  position{line: 634, col: 16, offset: 15837},
 										name: "_",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 555, col: 19, offset: 13279},
+									pos:  position{line: 634, col: 19, offset: 15840},
 									name: "sec_abbrev",
 								},
 							},
@@ -4402,42 +5312,42 @@ var g = &grammar{
 		},
 		{
 			name: "minutes",
-			pos:  position{line: 557, col: 1, offset: 13325},
+			pos:  position{line: 636, col: 1, offset: 15886},
 			expr: &choiceExpr{
-				pos: position{line: 558, col: 5, offset: 13337},
+				pos: position{line: 637, col: 5, offset: 15898},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 558, col: 5, offset: 13337},
+						pos: position{line: 637, col: 5, offset: 15898},
 						run: (*parser).callonminutes2,
 						expr: &litMatcher{
-							pos:        position{line: 558, col: 5, offset: 13337},
+							pos:        position{line: 637, col: 5, offset: 15898},
 							val:        "minute",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 559, col: 5, offset: 13383},
+						pos: position{line: 638, col: 5, offset: 15944},
 						run: (*parser).callonminutes4,
 						expr: &seqExpr{
-							pos: position{line: 559, col: 5, offset: 13383},
+							pos: position{line: 638, col: 5, offset: 15944},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 559, col: 5, offset: 13383},
+									pos:   position{line: 638, col: 5, offset: 15944},
 									label: "num",
 									expr: &ruleRefExpr{
-										pos:  position{line: 559, col: 9, offset: 13387},
+										pos:  position{line: 638, col: 9, offset: 15948},
 										name: "number",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 559, col: 16, offset: 13394},
+									pos: position{line: 638, col: 16, offset: 15955},
 									expr: &ruleRefExpr{
-										pos:  position{line: 559, col: 16, offset: 13394},
+										pos:  position{line: 638, col: 16, offset: 15955},
 										name: "_",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 559, col: 19, offset: 13397},
+									pos:  position{line: 638, col: 19, offset: 15958},
 									name: "min_abbrev",
 								},
 							},
@@ -4448,42 +5358,42 @@ var g = &grammar{
 		},
 		{
 			name: "hours",
-			pos:  position{line: 561, col: 1, offset: 13452},
+			pos:  position{line: 640, col: 1, offset: 16013},
 			expr: &choiceExpr{
-				pos: position{line: 562, col: 5, offset: 13462},
+				pos: position{line: 641, col: 5, offset: 16023},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 562, col: 5, offset: 13462},
+						pos: position{line: 641, col: 5, offset: 16023},
 						run: (*parser).callonhours2,
 						expr: &litMatcher{
-							pos:        position{line: 562, col: 5, offset: 13462},
+							pos:        position{line: 641, col: 5, offset: 16023},
 							val:        "hour",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 563, col: 5, offset: 13508},
+						pos: position{line: 642, col: 5, offset: 16069},
 						run: (*parser).callonhours4,
 						expr: &seqExpr{
-							pos: position{line: 563, col: 5, offset: 13508},
+							pos: position{line: 642, col: 5, offset: 16069},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 563, col: 5, offset: 13508},
+									pos:   position{line: 642, col: 5, offset: 16069},
 									label: "num",
 									expr: &ruleRefExpr{
-										pos:  position{line: 563, col: 9, offset: 13512},
+										pos:  position{line: 642, col: 9, offset: 16073},
 										name: "number",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 563, col: 16, offset: 13519},
+									pos: position{line: 642, col: 16, offset: 16080},
 									expr: &ruleRefExpr{
-										pos:  position{line: 563, col: 16, offset: 13519},
+										pos:  position{line: 642, col: 16, offset: 16080},
 										name: "_",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 563, col: 19, offset: 13522},
+									pos:  position{line: 642, col: 19, offset: 16083},
 									name: "hour_abbrev",
 								},
 							},
@@ -4494,42 +5404,42 @@ var g = &grammar{
 		},
 		{
 			name: "days",
-			pos:  position{line: 565, col: 1, offset: 13580},
+			pos:  position{line: 644, col: 1, offset: 16141},
 			expr: &choiceExpr{
-				pos: position{line: 566, col: 5, offset: 13589},
+				pos: position{line: 645, col: 5, offset: 16150},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 566, col: 5, offset: 13589},
+						pos: position{line: 645, col: 5, offset: 16150},
 						run: (*parser).callondays2,
 						expr: &litMatcher{
-							pos:        position{line: 566, col: 5, offset: 13589},
+							pos:        position{line: 645, col: 5, offset: 16150},
 							val:        "day",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 567, col: 5, offset: 13637},
+						pos: position{line: 646, col: 5, offset: 16198},
 						run: (*parser).callondays4,
 						expr: &seqExpr{
-							pos: position{line: 567, col: 5, offset: 13637},
+							pos: position{line: 646, col: 5, offset: 16198},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 567, col: 5, offset: 13637},
+									pos:   position{line: 646, col: 5, offset: 16198},
 									label: "num",
 									expr: &ruleRefExpr{
-										pos:  position{line: 567, col: 9, offset: 13641},
+										pos:  position{line: 646, col: 9, offset: 16202},
 										name: "number",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 567, col: 16, offset: 13648},
+									pos: position{line: 646, col: 16, offset: 16209},
 									expr: &ruleRefExpr{
-										pos:  position{line: 567, col: 16, offset: 13648},
+										pos:  position{line: 646, col: 16, offset: 16209},
 										name: "_",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 567, col: 19, offset: 13651},
+									pos:  position{line: 646, col: 19, offset: 16212},
 									name: "day_abbrev",
 								},
 							},
@@ -4540,30 +5450,30 @@ var g = &grammar{
 		},
 		{
 			name: "weeks",
-			pos:  position{line: 569, col: 1, offset: 13711},
+			pos:  position{line: 648, col: 1, offset: 16272},
 			expr: &actionExpr{
-				pos: position{line: 570, col: 5, offset: 13721},
+				pos: position{line: 649, col: 5, offset: 16282},
 				run: (*parser).callonweeks1,
 				expr: &seqExpr{
-					pos: position{line: 570, col: 5, offset: 13721},
+					pos: position{line: 649, col: 5, offset: 16282},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 570, col: 5, offset: 13721},
+							pos:   position{line: 649, col: 5, offset: 16282},
 							label: "num",
 							expr: &ruleRefExpr{
-								pos:  position{line: 570, col: 9, offset: 13725},
+								pos:  position{line: 649, col: 9, offset: 16286},
 								name: "number",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 570, col: 16, offset: 13732},
+							pos: position{line: 649, col: 16, offset: 16293},
 							expr: &ruleRefExpr{
-								pos:  position{line: 570, col: 16, offset: 13732},
+								pos:  position{line: 649, col: 16, offset: 16293},
 								name: "_",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 570, col: 19, offset: 13735},
+							pos:  position{line: 649, col: 19, offset: 16296},
 							name: "week_abbrev",
 						},
 					},
@@ -4572,53 +5482,53 @@ var g = &grammar{
 		},
 		{
 			name: "number",
-			pos:  position{line: 572, col: 1, offset: 13798},
+			pos:  position{line: 651, col: 1, offset: 16359},
 			expr: &ruleRefExpr{
-				pos:  position{line: 572, col: 10, offset: 13807},
+				pos:  position{line: 651, col: 10, offset: 16368},
 				name: "unsignedInteger",
 			},
 		},
 		{
 			name: "addr",
-			pos:  position{line: 576, col: 1, offset: 13853},
+			pos:  position{line: 655, col: 1, offset: 16414},
 			expr: &actionExpr{
-				pos: position{line: 577, col: 5, offset: 13862},
+				pos: position{line: 656, col: 5, offset: 16423},
 				run: (*parser).callonaddr1,
 				expr: &labeledExpr{
-					pos:   position{line: 577, col: 5, offset: 13862},
+					pos:   position{line: 656, col: 5, offset: 16423},
 					label: "a",
 					expr: &seqExpr{
-						pos: position{line: 577, col: 8, offset: 13865},
+						pos: position{line: 656, col: 8, offset: 16426},
 						exprs: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 577, col: 8, offset: 13865},
+								pos:  position{line: 656, col: 8, offset: 16426},
 								name: "unsignedInteger",
 							},
 							&litMatcher{
-								pos:        position{line: 577, col: 24, offset: 13881},
+								pos:        position{line: 656, col: 24, offset: 16442},
 								val:        ".",
 								ignoreCase: false,
 							},
 							&ruleRefExpr{
-								pos:  position{line: 577, col: 28, offset: 13885},
+								pos:  position{line: 656, col: 28, offset: 16446},
 								name: "unsignedInteger",
 							},
 							&litMatcher{
-								pos:        position{line: 577, col: 44, offset: 13901},
+								pos:        position{line: 656, col: 44, offset: 16462},
 								val:        ".",
 								ignoreCase: false,
 							},
 							&ruleRefExpr{
-								pos:  position{line: 577, col: 48, offset: 13905},
+								pos:  position{line: 656, col: 48, offset: 16466},
 								name: "unsignedInteger",
 							},
 							&litMatcher{
-								pos:        position{line: 577, col: 64, offset: 13921},
+								pos:        position{line: 656, col: 64, offset: 16482},
 								val:        ".",
 								ignoreCase: false,
 							},
 							&ruleRefExpr{
-								pos:  position{line: 577, col: 68, offset: 13925},
+								pos:  position{line: 656, col: 68, offset: 16486},
 								name: "unsignedInteger",
 							},
 						},
@@ -4628,23 +5538,23 @@ var g = &grammar{
 		},
 		{
 			name: "port",
-			pos:  position{line: 579, col: 1, offset: 13974},
+			pos:  position{line: 658, col: 1, offset: 16535},
 			expr: &actionExpr{
-				pos: position{line: 580, col: 5, offset: 13983},
+				pos: position{line: 659, col: 5, offset: 16544},
 				run: (*parser).callonport1,
 				expr: &seqExpr{
-					pos: position{line: 580, col: 5, offset: 13983},
+					pos: position{line: 659, col: 5, offset: 16544},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 580, col: 5, offset: 13983},
+							pos:        position{line: 659, col: 5, offset: 16544},
 							val:        ":",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 580, col: 9, offset: 13987},
+							pos:   position{line: 659, col: 9, offset: 16548},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 580, col: 11, offset: 13989},
+								pos:  position{line: 659, col: 11, offset: 16550},
 								name: "suint",
 							},
 						},
@@ -4654,32 +5564,32 @@ var g = &grammar{
 		},
 		{
 			name: "ip6addr",
-			pos:  position{line: 584, col: 1, offset: 14145},
+			pos:  position{line: 663, col: 1, offset: 16706},
 			expr: &choiceExpr{
-				pos: position{line: 585, col: 5, offset: 14157},
+				pos: position{line: 664, col: 5, offset: 16718},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 585, col: 5, offset: 14157},
+						pos: position{line: 664, col: 5, offset: 16718},
 						run: (*parser).callonip6addr2,
 						expr: &seqExpr{
-							pos: position{line: 585, col: 5, offset: 14157},
+							pos: position{line: 664, col: 5, offset: 16718},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 585, col: 5, offset: 14157},
+									pos:   position{line: 664, col: 5, offset: 16718},
 									label: "a",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 585, col: 7, offset: 14159},
+										pos: position{line: 664, col: 7, offset: 16720},
 										expr: &ruleRefExpr{
-											pos:  position{line: 585, col: 8, offset: 14160},
+											pos:  position{line: 664, col: 8, offset: 16721},
 											name: "h_prepend",
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 585, col: 20, offset: 14172},
+									pos:   position{line: 664, col: 20, offset: 16733},
 									label: "b",
 									expr: &ruleRefExpr{
-										pos:  position{line: 585, col: 22, offset: 14174},
+										pos:  position{line: 664, col: 22, offset: 16735},
 										name: "ip6tail",
 									},
 								},
@@ -4687,51 +5597,51 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 588, col: 5, offset: 14238},
+						pos: position{line: 667, col: 5, offset: 16799},
 						run: (*parser).callonip6addr9,
 						expr: &seqExpr{
-							pos: position{line: 588, col: 5, offset: 14238},
+							pos: position{line: 667, col: 5, offset: 16799},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 588, col: 5, offset: 14238},
+									pos:   position{line: 667, col: 5, offset: 16799},
 									label: "a",
 									expr: &ruleRefExpr{
-										pos:  position{line: 588, col: 7, offset: 14240},
+										pos:  position{line: 667, col: 7, offset: 16801},
 										name: "h16",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 588, col: 11, offset: 14244},
+									pos:   position{line: 667, col: 11, offset: 16805},
 									label: "b",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 588, col: 13, offset: 14246},
+										pos: position{line: 667, col: 13, offset: 16807},
 										expr: &ruleRefExpr{
-											pos:  position{line: 588, col: 14, offset: 14247},
+											pos:  position{line: 667, col: 14, offset: 16808},
 											name: "h_append",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 588, col: 25, offset: 14258},
+									pos:        position{line: 667, col: 25, offset: 16819},
 									val:        "::",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 588, col: 30, offset: 14263},
+									pos:   position{line: 667, col: 30, offset: 16824},
 									label: "d",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 588, col: 32, offset: 14265},
+										pos: position{line: 667, col: 32, offset: 16826},
 										expr: &ruleRefExpr{
-											pos:  position{line: 588, col: 33, offset: 14266},
+											pos:  position{line: 667, col: 33, offset: 16827},
 											name: "h_prepend",
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 588, col: 45, offset: 14278},
+									pos:   position{line: 667, col: 45, offset: 16839},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 588, col: 47, offset: 14280},
+										pos:  position{line: 667, col: 47, offset: 16841},
 										name: "ip6tail",
 									},
 								},
@@ -4739,32 +5649,32 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 591, col: 5, offset: 14379},
+						pos: position{line: 670, col: 5, offset: 16940},
 						run: (*parser).callonip6addr22,
 						expr: &seqExpr{
-							pos: position{line: 591, col: 5, offset: 14379},
+							pos: position{line: 670, col: 5, offset: 16940},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 591, col: 5, offset: 14379},
+									pos:        position{line: 670, col: 5, offset: 16940},
 									val:        "::",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 591, col: 10, offset: 14384},
+									pos:   position{line: 670, col: 10, offset: 16945},
 									label: "a",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 591, col: 12, offset: 14386},
+										pos: position{line: 670, col: 12, offset: 16947},
 										expr: &ruleRefExpr{
-											pos:  position{line: 591, col: 13, offset: 14387},
+											pos:  position{line: 670, col: 13, offset: 16948},
 											name: "h_prepend",
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 591, col: 25, offset: 14399},
+									pos:   position{line: 670, col: 25, offset: 16960},
 									label: "b",
 									expr: &ruleRefExpr{
-										pos:  position{line: 591, col: 27, offset: 14401},
+										pos:  position{line: 670, col: 27, offset: 16962},
 										name: "ip6tail",
 									},
 								},
@@ -4772,32 +5682,32 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 594, col: 5, offset: 14472},
+						pos: position{line: 673, col: 5, offset: 17033},
 						run: (*parser).callonip6addr30,
 						expr: &seqExpr{
-							pos: position{line: 594, col: 5, offset: 14472},
+							pos: position{line: 673, col: 5, offset: 17033},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 594, col: 5, offset: 14472},
+									pos:   position{line: 673, col: 5, offset: 17033},
 									label: "a",
 									expr: &ruleRefExpr{
-										pos:  position{line: 594, col: 7, offset: 14474},
+										pos:  position{line: 673, col: 7, offset: 17035},
 										name: "h16",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 594, col: 11, offset: 14478},
+									pos:   position{line: 673, col: 11, offset: 17039},
 									label: "b",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 594, col: 13, offset: 14480},
+										pos: position{line: 673, col: 13, offset: 17041},
 										expr: &ruleRefExpr{
-											pos:  position{line: 594, col: 14, offset: 14481},
+											pos:  position{line: 673, col: 14, offset: 17042},
 											name: "h_append",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 594, col: 25, offset: 14492},
+									pos:        position{line: 673, col: 25, offset: 17053},
 									val:        "::",
 									ignoreCase: false,
 								},
@@ -4805,10 +5715,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 597, col: 5, offset: 14560},
+						pos: position{line: 676, col: 5, offset: 17121},
 						run: (*parser).callonip6addr38,
 						expr: &litMatcher{
-							pos:        position{line: 597, col: 5, offset: 14560},
+							pos:        position{line: 676, col: 5, offset: 17121},
 							val:        "::",
 							ignoreCase: false,
 						},
@@ -4818,16 +5728,16 @@ var g = &grammar{
 		},
 		{
 			name: "ip6tail",
-			pos:  position{line: 601, col: 1, offset: 14597},
+			pos:  position{line: 680, col: 1, offset: 17158},
 			expr: &choiceExpr{
-				pos: position{line: 602, col: 5, offset: 14609},
+				pos: position{line: 681, col: 5, offset: 17170},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 602, col: 5, offset: 14609},
+						pos:  position{line: 681, col: 5, offset: 17170},
 						name: "addr",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 603, col: 5, offset: 14618},
+						pos:  position{line: 682, col: 5, offset: 17179},
 						name: "h16",
 					},
 				},
@@ -4835,23 +5745,23 @@ var g = &grammar{
 		},
 		{
 			name: "h_append",
-			pos:  position{line: 605, col: 1, offset: 14623},
+			pos:  position{line: 684, col: 1, offset: 17184},
 			expr: &actionExpr{
-				pos: position{line: 605, col: 12, offset: 14634},
+				pos: position{line: 684, col: 12, offset: 17195},
 				run: (*parser).callonh_append1,
 				expr: &seqExpr{
-					pos: position{line: 605, col: 12, offset: 14634},
+					pos: position{line: 684, col: 12, offset: 17195},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 605, col: 12, offset: 14634},
+							pos:        position{line: 684, col: 12, offset: 17195},
 							val:        ":",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 605, col: 16, offset: 14638},
+							pos:   position{line: 684, col: 16, offset: 17199},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 605, col: 18, offset: 14640},
+								pos:  position{line: 684, col: 18, offset: 17201},
 								name: "h16",
 							},
 						},
@@ -4861,23 +5771,23 @@ var g = &grammar{
 		},
 		{
 			name: "h_prepend",
-			pos:  position{line: 606, col: 1, offset: 14677},
+			pos:  position{line: 685, col: 1, offset: 17238},
 			expr: &actionExpr{
-				pos: position{line: 606, col: 13, offset: 14689},
+				pos: position{line: 685, col: 13, offset: 17250},
 				run: (*parser).callonh_prepend1,
 				expr: &seqExpr{
-					pos: position{line: 606, col: 13, offset: 14689},
+					pos: position{line: 685, col: 13, offset: 17250},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 606, col: 13, offset: 14689},
+							pos:   position{line: 685, col: 13, offset: 17250},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 606, col: 15, offset: 14691},
+								pos:  position{line: 685, col: 15, offset: 17252},
 								name: "h16",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 606, col: 19, offset: 14695},
+							pos:        position{line: 685, col: 19, offset: 17256},
 							val:        ":",
 							ignoreCase: false,
 						},
@@ -4887,31 +5797,31 @@ var g = &grammar{
 		},
 		{
 			name: "subnet",
-			pos:  position{line: 608, col: 1, offset: 14733},
+			pos:  position{line: 687, col: 1, offset: 17294},
 			expr: &actionExpr{
-				pos: position{line: 609, col: 5, offset: 14744},
+				pos: position{line: 688, col: 5, offset: 17305},
 				run: (*parser).callonsubnet1,
 				expr: &seqExpr{
-					pos: position{line: 609, col: 5, offset: 14744},
+					pos: position{line: 688, col: 5, offset: 17305},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 609, col: 5, offset: 14744},
+							pos:   position{line: 688, col: 5, offset: 17305},
 							label: "a",
 							expr: &ruleRefExpr{
-								pos:  position{line: 609, col: 7, offset: 14746},
+								pos:  position{line: 688, col: 7, offset: 17307},
 								name: "addr",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 609, col: 12, offset: 14751},
+							pos:        position{line: 688, col: 12, offset: 17312},
 							val:        "/",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 609, col: 16, offset: 14755},
+							pos:   position{line: 688, col: 16, offset: 17316},
 							label: "m",
 							expr: &ruleRefExpr{
-								pos:  position{line: 609, col: 18, offset: 14757},
+								pos:  position{line: 688, col: 18, offset: 17318},
 								name: "unsignedInteger",
 							},
 						},
@@ -4921,31 +5831,31 @@ var g = &grammar{
 		},
 		{
 			name: "ip6subnet",
-			pos:  position{line: 613, col: 1, offset: 14841},
+			pos:  position{line: 692, col: 1, offset: 17402},
 			expr: &actionExpr{
-				pos: position{line: 614, col: 5, offset: 14855},
+				pos: position{line: 693, col: 5, offset: 17416},
 				run: (*parser).callonip6subnet1,
 				expr: &seqExpr{
-					pos: position{line: 614, col: 5, offset: 14855},
+					pos: position{line: 693, col: 5, offset: 17416},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 614, col: 5, offset: 14855},
+							pos:   position{line: 693, col: 5, offset: 17416},
 							label: "a",
 							expr: &ruleRefExpr{
-								pos:  position{line: 614, col: 7, offset: 14857},
+								pos:  position{line: 693, col: 7, offset: 17418},
 								name: "ip6addr",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 614, col: 15, offset: 14865},
+							pos:        position{line: 693, col: 15, offset: 17426},
 							val:        "/",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 614, col: 19, offset: 14869},
+							pos:   position{line: 693, col: 19, offset: 17430},
 							label: "m",
 							expr: &ruleRefExpr{
-								pos:  position{line: 614, col: 21, offset: 14871},
+								pos:  position{line: 693, col: 21, offset: 17432},
 								name: "unsignedInteger",
 							},
 						},
@@ -4955,15 +5865,15 @@ var g = &grammar{
 		},
 		{
 			name: "unsignedInteger",
-			pos:  position{line: 618, col: 1, offset: 14945},
+			pos:  position{line: 697, col: 1, offset: 17506},
 			expr: &actionExpr{
-				pos: position{line: 619, col: 5, offset: 14965},
+				pos: position{line: 698, col: 5, offset: 17526},
 				run: (*parser).callonunsignedInteger1,
 				expr: &labeledExpr{
-					pos:   position{line: 619, col: 5, offset: 14965},
+					pos:   position{line: 698, col: 5, offset: 17526},
 					label: "s",
 					expr: &ruleRefExpr{
-						pos:  position{line: 619, col: 7, offset: 14967},
+						pos:  position{line: 698, col: 7, offset: 17528},
 						name: "suint",
 					},
 				},
@@ -4971,14 +5881,14 @@ var g = &grammar{
 		},
 		{
 			name: "suint",
-			pos:  position{line: 621, col: 1, offset: 15002},
+			pos:  position{line: 700, col: 1, offset: 17563},
 			expr: &actionExpr{
-				pos: position{line: 622, col: 5, offset: 15012},
+				pos: position{line: 701, col: 5, offset: 17573},
 				run: (*parser).callonsuint1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 622, col: 5, offset: 15012},
+					pos: position{line: 701, col: 5, offset: 17573},
 					expr: &charClassMatcher{
-						pos:        position{line: 622, col: 5, offset: 15012},
+						pos:        position{line: 701, col: 5, offset: 17573},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
@@ -4989,15 +5899,15 @@ var g = &grammar{
 		},
 		{
 			name: "integer",
-			pos:  position{line: 624, col: 1, offset: 15051},
+			pos:  position{line: 703, col: 1, offset: 17612},
 			expr: &actionExpr{
-				pos: position{line: 625, col: 5, offset: 15063},
+				pos: position{line: 704, col: 5, offset: 17624},
 				run: (*parser).calloninteger1,
 				expr: &labeledExpr{
-					pos:   position{line: 625, col: 5, offset: 15063},
+					pos:   position{line: 704, col: 5, offset: 17624},
 					label: "s",
 					expr: &ruleRefExpr{
-						pos:  position{line: 625, col: 7, offset: 15065},
+						pos:  position{line: 704, col: 7, offset: 17626},
 						name: "sinteger",
 					},
 				},
@@ -5005,17 +5915,17 @@ var g = &grammar{
 		},
 		{
 			name: "sinteger",
-			pos:  position{line: 627, col: 1, offset: 15103},
+			pos:  position{line: 706, col: 1, offset: 17664},
 			expr: &actionExpr{
-				pos: position{line: 628, col: 5, offset: 15116},
+				pos: position{line: 707, col: 5, offset: 17677},
 				run: (*parser).callonsinteger1,
 				expr: &seqExpr{
-					pos: position{line: 628, col: 5, offset: 15116},
+					pos: position{line: 707, col: 5, offset: 17677},
 					exprs: []interface{}{
 						&zeroOrOneExpr{
-							pos: position{line: 628, col: 5, offset: 15116},
+							pos: position{line: 707, col: 5, offset: 17677},
 							expr: &charClassMatcher{
-								pos:        position{line: 628, col: 5, offset: 15116},
+								pos:        position{line: 707, col: 5, offset: 17677},
 								val:        "[+-]",
 								chars:      []rune{'+', '-'},
 								ignoreCase: false,
@@ -5023,7 +5933,7 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 628, col: 11, offset: 15122},
+							pos:  position{line: 707, col: 11, offset: 17683},
 							name: "suint",
 						},
 					},
@@ -5032,15 +5942,15 @@ var g = &grammar{
 		},
 		{
 			name: "double",
-			pos:  position{line: 630, col: 1, offset: 15160},
+			pos:  position{line: 709, col: 1, offset: 17721},
 			expr: &actionExpr{
-				pos: position{line: 631, col: 5, offset: 15171},
+				pos: position{line: 710, col: 5, offset: 17732},
 				run: (*parser).callondouble1,
 				expr: &labeledExpr{
-					pos:   position{line: 631, col: 5, offset: 15171},
+					pos:   position{line: 710, col: 5, offset: 17732},
 					label: "s",
 					expr: &ruleRefExpr{
-						pos:  position{line: 631, col: 7, offset: 15173},
+						pos:  position{line: 710, col: 7, offset: 17734},
 						name: "sdouble",
 					},
 				},
@@ -5048,47 +5958,47 @@ var g = &grammar{
 		},
 		{
 			name: "sdouble",
-			pos:  position{line: 635, col: 1, offset: 15220},
+			pos:  position{line: 714, col: 1, offset: 17781},
 			expr: &choiceExpr{
-				pos: position{line: 636, col: 5, offset: 15232},
+				pos: position{line: 715, col: 5, offset: 17793},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 636, col: 5, offset: 15232},
+						pos: position{line: 715, col: 5, offset: 17793},
 						run: (*parser).callonsdouble2,
 						expr: &seqExpr{
-							pos: position{line: 636, col: 5, offset: 15232},
+							pos: position{line: 715, col: 5, offset: 17793},
 							exprs: []interface{}{
 								&zeroOrOneExpr{
-									pos: position{line: 636, col: 5, offset: 15232},
+									pos: position{line: 715, col: 5, offset: 17793},
 									expr: &litMatcher{
-										pos:        position{line: 636, col: 5, offset: 15232},
+										pos:        position{line: 715, col: 5, offset: 17793},
 										val:        "-",
 										ignoreCase: false,
 									},
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 636, col: 10, offset: 15237},
+									pos: position{line: 715, col: 10, offset: 17798},
 									expr: &ruleRefExpr{
-										pos:  position{line: 636, col: 10, offset: 15237},
+										pos:  position{line: 715, col: 10, offset: 17798},
 										name: "doubleInteger",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 636, col: 25, offset: 15252},
+									pos:        position{line: 715, col: 25, offset: 17813},
 									val:        ".",
 									ignoreCase: false,
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 636, col: 29, offset: 15256},
+									pos: position{line: 715, col: 29, offset: 17817},
 									expr: &ruleRefExpr{
-										pos:  position{line: 636, col: 29, offset: 15256},
+										pos:  position{line: 715, col: 29, offset: 17817},
 										name: "doubleDigit",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 636, col: 42, offset: 15269},
+									pos: position{line: 715, col: 42, offset: 17830},
 									expr: &ruleRefExpr{
-										pos:  position{line: 636, col: 42, offset: 15269},
+										pos:  position{line: 715, col: 42, offset: 17830},
 										name: "exponentPart",
 									},
 								},
@@ -5096,35 +6006,35 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 639, col: 5, offset: 15328},
+						pos: position{line: 718, col: 5, offset: 17889},
 						run: (*parser).callonsdouble13,
 						expr: &seqExpr{
-							pos: position{line: 639, col: 5, offset: 15328},
+							pos: position{line: 718, col: 5, offset: 17889},
 							exprs: []interface{}{
 								&zeroOrOneExpr{
-									pos: position{line: 639, col: 5, offset: 15328},
+									pos: position{line: 718, col: 5, offset: 17889},
 									expr: &litMatcher{
-										pos:        position{line: 639, col: 5, offset: 15328},
+										pos:        position{line: 718, col: 5, offset: 17889},
 										val:        "-",
 										ignoreCase: false,
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 639, col: 10, offset: 15333},
+									pos:        position{line: 718, col: 10, offset: 17894},
 									val:        ".",
 									ignoreCase: false,
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 639, col: 14, offset: 15337},
+									pos: position{line: 718, col: 14, offset: 17898},
 									expr: &ruleRefExpr{
-										pos:  position{line: 639, col: 14, offset: 15337},
+										pos:  position{line: 718, col: 14, offset: 17898},
 										name: "doubleDigit",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 639, col: 27, offset: 15350},
+									pos: position{line: 718, col: 27, offset: 17911},
 									expr: &ruleRefExpr{
-										pos:  position{line: 639, col: 27, offset: 15350},
+										pos:  position{line: 718, col: 27, offset: 17911},
 										name: "exponentPart",
 									},
 								},
@@ -5136,29 +6046,29 @@ var g = &grammar{
 		},
 		{
 			name: "doubleInteger",
-			pos:  position{line: 643, col: 1, offset: 15406},
+			pos:  position{line: 722, col: 1, offset: 17967},
 			expr: &choiceExpr{
-				pos: position{line: 644, col: 5, offset: 15424},
+				pos: position{line: 723, col: 5, offset: 17985},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 644, col: 5, offset: 15424},
+						pos:        position{line: 723, col: 5, offset: 17985},
 						val:        "0",
 						ignoreCase: false,
 					},
 					&seqExpr{
-						pos: position{line: 645, col: 5, offset: 15432},
+						pos: position{line: 724, col: 5, offset: 17993},
 						exprs: []interface{}{
 							&charClassMatcher{
-								pos:        position{line: 645, col: 5, offset: 15432},
+								pos:        position{line: 724, col: 5, offset: 17993},
 								val:        "[1-9]",
 								ranges:     []rune{'1', '9'},
 								ignoreCase: false,
 								inverted:   false,
 							},
 							&zeroOrMoreExpr{
-								pos: position{line: 645, col: 11, offset: 15438},
+								pos: position{line: 724, col: 11, offset: 17999},
 								expr: &charClassMatcher{
-									pos:        position{line: 645, col: 11, offset: 15438},
+									pos:        position{line: 724, col: 11, offset: 17999},
 									val:        "[0-9]",
 									ranges:     []rune{'0', '9'},
 									ignoreCase: false,
@@ -5172,9 +6082,9 @@ var g = &grammar{
 		},
 		{
 			name: "doubleDigit",
-			pos:  position{line: 647, col: 1, offset: 15446},
+			pos:  position{line: 726, col: 1, offset: 18007},
 			expr: &charClassMatcher{
-				pos:        position{line: 647, col: 15, offset: 15460},
+				pos:        position{line: 726, col: 15, offset: 18021},
 				val:        "[0-9]",
 				ranges:     []rune{'0', '9'},
 				ignoreCase: false,
@@ -5183,17 +6093,17 @@ var g = &grammar{
 		},
 		{
 			name: "exponentPart",
-			pos:  position{line: 649, col: 1, offset: 15467},
+			pos:  position{line: 728, col: 1, offset: 18028},
 			expr: &seqExpr{
-				pos: position{line: 649, col: 16, offset: 15482},
+				pos: position{line: 728, col: 16, offset: 18043},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 649, col: 16, offset: 15482},
+						pos:        position{line: 728, col: 16, offset: 18043},
 						val:        "e",
 						ignoreCase: true,
 					},
 					&ruleRefExpr{
-						pos:  position{line: 649, col: 21, offset: 15487},
+						pos:  position{line: 728, col: 21, offset: 18048},
 						name: "sinteger",
 					},
 				},
@@ -5201,17 +6111,17 @@ var g = &grammar{
 		},
 		{
 			name: "h16",
-			pos:  position{line: 651, col: 1, offset: 15497},
+			pos:  position{line: 730, col: 1, offset: 18058},
 			expr: &actionExpr{
-				pos: position{line: 651, col: 7, offset: 15503},
+				pos: position{line: 730, col: 7, offset: 18064},
 				run: (*parser).callonh161,
 				expr: &labeledExpr{
-					pos:   position{line: 651, col: 7, offset: 15503},
+					pos:   position{line: 730, col: 7, offset: 18064},
 					label: "chars",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 651, col: 13, offset: 15509},
+						pos: position{line: 730, col: 13, offset: 18070},
 						expr: &ruleRefExpr{
-							pos:  position{line: 651, col: 13, offset: 15509},
+							pos:  position{line: 730, col: 13, offset: 18070},
 							name: "hexdigit",
 						},
 					},
@@ -5220,9 +6130,9 @@ var g = &grammar{
 		},
 		{
 			name: "hexdigit",
-			pos:  position{line: 653, col: 1, offset: 15551},
+			pos:  position{line: 732, col: 1, offset: 18112},
 			expr: &charClassMatcher{
-				pos:        position{line: 653, col: 12, offset: 15562},
+				pos:        position{line: 732, col: 12, offset: 18123},
 				val:        "[0-9a-fA-F]",
 				ranges:     []rune{'0', '9', 'a', 'f', 'A', 'F'},
 				ignoreCase: false,
@@ -5231,17 +6141,17 @@ var g = &grammar{
 		},
 		{
 			name: "searchWord",
-			pos:  position{line: 655, col: 1, offset: 15575},
+			pos:  position{line: 734, col: 1, offset: 18136},
 			expr: &actionExpr{
-				pos: position{line: 656, col: 5, offset: 15590},
+				pos: position{line: 735, col: 5, offset: 18151},
 				run: (*parser).callonsearchWord1,
 				expr: &labeledExpr{
-					pos:   position{line: 656, col: 5, offset: 15590},
+					pos:   position{line: 735, col: 5, offset: 18151},
 					label: "chars",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 656, col: 11, offset: 15596},
+						pos: position{line: 735, col: 11, offset: 18157},
 						expr: &ruleRefExpr{
-							pos:  position{line: 656, col: 11, offset: 15596},
+							pos:  position{line: 735, col: 11, offset: 18157},
 							name: "searchWordPart",
 						},
 					},
@@ -5250,33 +6160,33 @@ var g = &grammar{
 		},
 		{
 			name: "searchWordPart",
-			pos:  position{line: 658, col: 1, offset: 15646},
+			pos:  position{line: 737, col: 1, offset: 18207},
 			expr: &choiceExpr{
-				pos: position{line: 659, col: 5, offset: 15665},
+				pos: position{line: 738, col: 5, offset: 18226},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 659, col: 5, offset: 15665},
+						pos: position{line: 738, col: 5, offset: 18226},
 						run: (*parser).callonsearchWordPart2,
 						expr: &seqExpr{
-							pos: position{line: 659, col: 5, offset: 15665},
+							pos: position{line: 738, col: 5, offset: 18226},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 659, col: 5, offset: 15665},
+									pos:        position{line: 738, col: 5, offset: 18226},
 									val:        "\\",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 659, col: 10, offset: 15670},
+									pos:   position{line: 738, col: 10, offset: 18231},
 									label: "s",
 									expr: &choiceExpr{
-										pos: position{line: 659, col: 13, offset: 15673},
+										pos: position{line: 738, col: 13, offset: 18234},
 										alternatives: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 659, col: 13, offset: 15673},
+												pos:  position{line: 738, col: 13, offset: 18234},
 												name: "escapeSequence",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 659, col: 30, offset: 15690},
+												pos:  position{line: 738, col: 30, offset: 18251},
 												name: "searchEscape",
 											},
 										},
@@ -5286,18 +6196,18 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 660, col: 5, offset: 15727},
+						pos: position{line: 739, col: 5, offset: 18288},
 						run: (*parser).callonsearchWordPart9,
 						expr: &seqExpr{
-							pos: position{line: 660, col: 5, offset: 15727},
+							pos: position{line: 739, col: 5, offset: 18288},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 660, col: 5, offset: 15727},
+									pos: position{line: 739, col: 5, offset: 18288},
 									expr: &choiceExpr{
-										pos: position{line: 660, col: 7, offset: 15729},
+										pos: position{line: 739, col: 7, offset: 18290},
 										alternatives: []interface{}{
 											&charClassMatcher{
-												pos:        position{line: 660, col: 7, offset: 15729},
+												pos:        position{line: 739, col: 7, offset: 18290},
 												val:        "[\\x00-\\x1F\\x5C(),!><=\\x22|\\x27;]",
 												chars:      []rune{'\\', '(', ')', ',', '!', '>', '<', '=', '"', '|', '\'', ';'},
 												ranges:     []rune{'\x00', '\x1f'},
@@ -5305,14 +6215,14 @@ var g = &grammar{
 												inverted:   false,
 											},
 											&ruleRefExpr{
-												pos:  position{line: 660, col: 42, offset: 15764},
+												pos:  position{line: 739, col: 42, offset: 18325},
 												name: "ws",
 											},
 										},
 									},
 								},
 								&anyMatcher{
-									line: 660, col: 46, offset: 15768,
+									line: 739, col: 46, offset: 18329,
 								},
 							},
 						},
@@ -5322,34 +6232,34 @@ var g = &grammar{
 		},
 		{
 			name: "quotedString",
-			pos:  position{line: 662, col: 1, offset: 15802},
+			pos:  position{line: 741, col: 1, offset: 18363},
 			expr: &choiceExpr{
-				pos: position{line: 663, col: 5, offset: 15819},
+				pos: position{line: 742, col: 5, offset: 18380},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 663, col: 5, offset: 15819},
+						pos: position{line: 742, col: 5, offset: 18380},
 						run: (*parser).callonquotedString2,
 						expr: &seqExpr{
-							pos: position{line: 663, col: 5, offset: 15819},
+							pos: position{line: 742, col: 5, offset: 18380},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 663, col: 5, offset: 15819},
+									pos:        position{line: 742, col: 5, offset: 18380},
 									val:        "\"",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 663, col: 9, offset: 15823},
+									pos:   position{line: 742, col: 9, offset: 18384},
 									label: "v",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 663, col: 11, offset: 15825},
+										pos: position{line: 742, col: 11, offset: 18386},
 										expr: &ruleRefExpr{
-											pos:  position{line: 663, col: 11, offset: 15825},
+											pos:  position{line: 742, col: 11, offset: 18386},
 											name: "doubleQuotedChar",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 663, col: 29, offset: 15843},
+									pos:        position{line: 742, col: 29, offset: 18404},
 									val:        "\"",
 									ignoreCase: false,
 								},
@@ -5357,29 +6267,29 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 664, col: 5, offset: 15880},
+						pos: position{line: 743, col: 5, offset: 18441},
 						run: (*parser).callonquotedString9,
 						expr: &seqExpr{
-							pos: position{line: 664, col: 5, offset: 15880},
+							pos: position{line: 743, col: 5, offset: 18441},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 664, col: 5, offset: 15880},
+									pos:        position{line: 743, col: 5, offset: 18441},
 									val:        "'",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 664, col: 9, offset: 15884},
+									pos:   position{line: 743, col: 9, offset: 18445},
 									label: "v",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 664, col: 11, offset: 15886},
+										pos: position{line: 743, col: 11, offset: 18447},
 										expr: &ruleRefExpr{
-											pos:  position{line: 664, col: 11, offset: 15886},
+											pos:  position{line: 743, col: 11, offset: 18447},
 											name: "singleQuotedChar",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 664, col: 29, offset: 15904},
+									pos:        position{line: 743, col: 29, offset: 18465},
 									val:        "'",
 									ignoreCase: false,
 								},
@@ -5391,55 +6301,55 @@ var g = &grammar{
 		},
 		{
 			name: "doubleQuotedChar",
-			pos:  position{line: 666, col: 1, offset: 15938},
+			pos:  position{line: 745, col: 1, offset: 18499},
 			expr: &choiceExpr{
-				pos: position{line: 667, col: 5, offset: 15959},
+				pos: position{line: 746, col: 5, offset: 18520},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 667, col: 5, offset: 15959},
+						pos: position{line: 746, col: 5, offset: 18520},
 						run: (*parser).callondoubleQuotedChar2,
 						expr: &seqExpr{
-							pos: position{line: 667, col: 5, offset: 15959},
+							pos: position{line: 746, col: 5, offset: 18520},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 667, col: 5, offset: 15959},
+									pos: position{line: 746, col: 5, offset: 18520},
 									expr: &choiceExpr{
-										pos: position{line: 667, col: 7, offset: 15961},
+										pos: position{line: 746, col: 7, offset: 18522},
 										alternatives: []interface{}{
 											&litMatcher{
-												pos:        position{line: 667, col: 7, offset: 15961},
+												pos:        position{line: 746, col: 7, offset: 18522},
 												val:        "\"",
 												ignoreCase: false,
 											},
 											&ruleRefExpr{
-												pos:  position{line: 667, col: 13, offset: 15967},
+												pos:  position{line: 746, col: 13, offset: 18528},
 												name: "escapedChar",
 											},
 										},
 									},
 								},
 								&anyMatcher{
-									line: 667, col: 26, offset: 15980,
+									line: 746, col: 26, offset: 18541,
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 668, col: 5, offset: 16017},
+						pos: position{line: 747, col: 5, offset: 18578},
 						run: (*parser).callondoubleQuotedChar9,
 						expr: &seqExpr{
-							pos: position{line: 668, col: 5, offset: 16017},
+							pos: position{line: 747, col: 5, offset: 18578},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 668, col: 5, offset: 16017},
+									pos:        position{line: 747, col: 5, offset: 18578},
 									val:        "\\",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 668, col: 10, offset: 16022},
+									pos:   position{line: 747, col: 10, offset: 18583},
 									label: "s",
 									expr: &ruleRefExpr{
-										pos:  position{line: 668, col: 12, offset: 16024},
+										pos:  position{line: 747, col: 12, offset: 18585},
 										name: "escapeSequence",
 									},
 								},
@@ -5451,55 +6361,55 @@ var g = &grammar{
 		},
 		{
 			name: "singleQuotedChar",
-			pos:  position{line: 670, col: 1, offset: 16058},
+			pos:  position{line: 749, col: 1, offset: 18619},
 			expr: &choiceExpr{
-				pos: position{line: 671, col: 5, offset: 16079},
+				pos: position{line: 750, col: 5, offset: 18640},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 671, col: 5, offset: 16079},
+						pos: position{line: 750, col: 5, offset: 18640},
 						run: (*parser).callonsingleQuotedChar2,
 						expr: &seqExpr{
-							pos: position{line: 671, col: 5, offset: 16079},
+							pos: position{line: 750, col: 5, offset: 18640},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 671, col: 5, offset: 16079},
+									pos: position{line: 750, col: 5, offset: 18640},
 									expr: &choiceExpr{
-										pos: position{line: 671, col: 7, offset: 16081},
+										pos: position{line: 750, col: 7, offset: 18642},
 										alternatives: []interface{}{
 											&litMatcher{
-												pos:        position{line: 671, col: 7, offset: 16081},
+												pos:        position{line: 750, col: 7, offset: 18642},
 												val:        "'",
 												ignoreCase: false,
 											},
 											&ruleRefExpr{
-												pos:  position{line: 671, col: 13, offset: 16087},
+												pos:  position{line: 750, col: 13, offset: 18648},
 												name: "escapedChar",
 											},
 										},
 									},
 								},
 								&anyMatcher{
-									line: 671, col: 26, offset: 16100,
+									line: 750, col: 26, offset: 18661,
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 672, col: 5, offset: 16137},
+						pos: position{line: 751, col: 5, offset: 18698},
 						run: (*parser).callonsingleQuotedChar9,
 						expr: &seqExpr{
-							pos: position{line: 672, col: 5, offset: 16137},
+							pos: position{line: 751, col: 5, offset: 18698},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 672, col: 5, offset: 16137},
+									pos:        position{line: 751, col: 5, offset: 18698},
 									val:        "\\",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 672, col: 10, offset: 16142},
+									pos:   position{line: 751, col: 10, offset: 18703},
 									label: "s",
 									expr: &ruleRefExpr{
-										pos:  position{line: 672, col: 12, offset: 16144},
+										pos:  position{line: 751, col: 12, offset: 18705},
 										name: "escapeSequence",
 									},
 								},
@@ -5511,38 +6421,38 @@ var g = &grammar{
 		},
 		{
 			name: "escapeSequence",
-			pos:  position{line: 674, col: 1, offset: 16178},
+			pos:  position{line: 753, col: 1, offset: 18739},
 			expr: &choiceExpr{
-				pos: position{line: 675, col: 5, offset: 16197},
+				pos: position{line: 754, col: 5, offset: 18758},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 675, col: 5, offset: 16197},
+						pos: position{line: 754, col: 5, offset: 18758},
 						run: (*parser).callonescapeSequence2,
 						expr: &seqExpr{
-							pos: position{line: 675, col: 5, offset: 16197},
+							pos: position{line: 754, col: 5, offset: 18758},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 675, col: 5, offset: 16197},
+									pos:        position{line: 754, col: 5, offset: 18758},
 									val:        "x",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 675, col: 9, offset: 16201},
+									pos:  position{line: 754, col: 9, offset: 18762},
 									name: "hexdigit",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 675, col: 18, offset: 16210},
+									pos:  position{line: 754, col: 18, offset: 18771},
 									name: "hexdigit",
 								},
 							},
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 676, col: 5, offset: 16261},
+						pos:  position{line: 755, col: 5, offset: 18822},
 						name: "singleCharEscape",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 677, col: 5, offset: 16282},
+						pos:  position{line: 756, col: 5, offset: 18843},
 						name: "unicodeEscape",
 					},
 				},
@@ -5550,75 +6460,75 @@ var g = &grammar{
 		},
 		{
 			name: "singleCharEscape",
-			pos:  position{line: 679, col: 1, offset: 16297},
+			pos:  position{line: 758, col: 1, offset: 18858},
 			expr: &choiceExpr{
-				pos: position{line: 680, col: 5, offset: 16318},
+				pos: position{line: 759, col: 5, offset: 18879},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 680, col: 5, offset: 16318},
+						pos:        position{line: 759, col: 5, offset: 18879},
 						val:        "'",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 681, col: 5, offset: 16326},
+						pos:        position{line: 760, col: 5, offset: 18887},
 						val:        "\"",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 682, col: 5, offset: 16334},
+						pos:        position{line: 761, col: 5, offset: 18895},
 						val:        "\\",
 						ignoreCase: false,
 					},
 					&actionExpr{
-						pos: position{line: 683, col: 5, offset: 16343},
+						pos: position{line: 762, col: 5, offset: 18904},
 						run: (*parser).callonsingleCharEscape5,
 						expr: &litMatcher{
-							pos:        position{line: 683, col: 5, offset: 16343},
+							pos:        position{line: 762, col: 5, offset: 18904},
 							val:        "b",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 684, col: 5, offset: 16372},
+						pos: position{line: 763, col: 5, offset: 18933},
 						run: (*parser).callonsingleCharEscape7,
 						expr: &litMatcher{
-							pos:        position{line: 684, col: 5, offset: 16372},
+							pos:        position{line: 763, col: 5, offset: 18933},
 							val:        "f",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 685, col: 5, offset: 16401},
+						pos: position{line: 764, col: 5, offset: 18962},
 						run: (*parser).callonsingleCharEscape9,
 						expr: &litMatcher{
-							pos:        position{line: 685, col: 5, offset: 16401},
+							pos:        position{line: 764, col: 5, offset: 18962},
 							val:        "n",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 686, col: 5, offset: 16430},
+						pos: position{line: 765, col: 5, offset: 18991},
 						run: (*parser).callonsingleCharEscape11,
 						expr: &litMatcher{
-							pos:        position{line: 686, col: 5, offset: 16430},
+							pos:        position{line: 765, col: 5, offset: 18991},
 							val:        "r",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 687, col: 5, offset: 16459},
+						pos: position{line: 766, col: 5, offset: 19020},
 						run: (*parser).callonsingleCharEscape13,
 						expr: &litMatcher{
-							pos:        position{line: 687, col: 5, offset: 16459},
+							pos:        position{line: 766, col: 5, offset: 19020},
 							val:        "t",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 688, col: 5, offset: 16488},
+						pos: position{line: 767, col: 5, offset: 19049},
 						run: (*parser).callonsingleCharEscape15,
 						expr: &litMatcher{
-							pos:        position{line: 688, col: 5, offset: 16488},
+							pos:        position{line: 767, col: 5, offset: 19049},
 							val:        "v",
 							ignoreCase: false,
 						},
@@ -5628,24 +6538,24 @@ var g = &grammar{
 		},
 		{
 			name: "searchEscape",
-			pos:  position{line: 690, col: 1, offset: 16514},
+			pos:  position{line: 769, col: 1, offset: 19075},
 			expr: &choiceExpr{
-				pos: position{line: 691, col: 5, offset: 16531},
+				pos: position{line: 770, col: 5, offset: 19092},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 691, col: 5, offset: 16531},
+						pos: position{line: 770, col: 5, offset: 19092},
 						run: (*parser).callonsearchEscape2,
 						expr: &litMatcher{
-							pos:        position{line: 691, col: 5, offset: 16531},
+							pos:        position{line: 770, col: 5, offset: 19092},
 							val:        "=",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 692, col: 5, offset: 16559},
+						pos: position{line: 771, col: 5, offset: 19120},
 						run: (*parser).callonsearchEscape4,
 						expr: &litMatcher{
-							pos:        position{line: 692, col: 5, offset: 16559},
+							pos:        position{line: 771, col: 5, offset: 19120},
 							val:        "*",
 							ignoreCase: false,
 						},
@@ -5655,41 +6565,41 @@ var g = &grammar{
 		},
 		{
 			name: "unicodeEscape",
-			pos:  position{line: 694, col: 1, offset: 16586},
+			pos:  position{line: 773, col: 1, offset: 19147},
 			expr: &choiceExpr{
-				pos: position{line: 695, col: 5, offset: 16604},
+				pos: position{line: 774, col: 5, offset: 19165},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 695, col: 5, offset: 16604},
+						pos: position{line: 774, col: 5, offset: 19165},
 						run: (*parser).callonunicodeEscape2,
 						expr: &seqExpr{
-							pos: position{line: 695, col: 5, offset: 16604},
+							pos: position{line: 774, col: 5, offset: 19165},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 695, col: 5, offset: 16604},
+									pos:        position{line: 774, col: 5, offset: 19165},
 									val:        "u",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 695, col: 9, offset: 16608},
+									pos:   position{line: 774, col: 9, offset: 19169},
 									label: "chars",
 									expr: &seqExpr{
-										pos: position{line: 695, col: 16, offset: 16615},
+										pos: position{line: 774, col: 16, offset: 19176},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 695, col: 16, offset: 16615},
+												pos:  position{line: 774, col: 16, offset: 19176},
 												name: "hexdigit",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 695, col: 25, offset: 16624},
+												pos:  position{line: 774, col: 25, offset: 19185},
 												name: "hexdigit",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 695, col: 34, offset: 16633},
+												pos:  position{line: 774, col: 34, offset: 19194},
 												name: "hexdigit",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 695, col: 43, offset: 16642},
+												pos:  position{line: 774, col: 43, offset: 19203},
 												name: "hexdigit",
 											},
 										},
@@ -5699,63 +6609,63 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 698, col: 5, offset: 16705},
+						pos: position{line: 777, col: 5, offset: 19266},
 						run: (*parser).callonunicodeEscape11,
 						expr: &seqExpr{
-							pos: position{line: 698, col: 5, offset: 16705},
+							pos: position{line: 777, col: 5, offset: 19266},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 698, col: 5, offset: 16705},
+									pos:        position{line: 777, col: 5, offset: 19266},
 									val:        "u",
 									ignoreCase: false,
 								},
 								&litMatcher{
-									pos:        position{line: 698, col: 9, offset: 16709},
+									pos:        position{line: 777, col: 9, offset: 19270},
 									val:        "{",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 698, col: 13, offset: 16713},
+									pos:   position{line: 777, col: 13, offset: 19274},
 									label: "chars",
 									expr: &seqExpr{
-										pos: position{line: 698, col: 20, offset: 16720},
+										pos: position{line: 777, col: 20, offset: 19281},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 698, col: 20, offset: 16720},
+												pos:  position{line: 777, col: 20, offset: 19281},
 												name: "hexdigit",
 											},
 											&zeroOrOneExpr{
-												pos: position{line: 698, col: 29, offset: 16729},
+												pos: position{line: 777, col: 29, offset: 19290},
 												expr: &ruleRefExpr{
-													pos:  position{line: 698, col: 29, offset: 16729},
+													pos:  position{line: 777, col: 29, offset: 19290},
 													name: "hexdigit",
 												},
 											},
 											&zeroOrOneExpr{
-												pos: position{line: 698, col: 39, offset: 16739},
+												pos: position{line: 777, col: 39, offset: 19300},
 												expr: &ruleRefExpr{
-													pos:  position{line: 698, col: 39, offset: 16739},
+													pos:  position{line: 777, col: 39, offset: 19300},
 													name: "hexdigit",
 												},
 											},
 											&zeroOrOneExpr{
-												pos: position{line: 698, col: 49, offset: 16749},
+												pos: position{line: 777, col: 49, offset: 19310},
 												expr: &ruleRefExpr{
-													pos:  position{line: 698, col: 49, offset: 16749},
+													pos:  position{line: 777, col: 49, offset: 19310},
 													name: "hexdigit",
 												},
 											},
 											&zeroOrOneExpr{
-												pos: position{line: 698, col: 59, offset: 16759},
+												pos: position{line: 777, col: 59, offset: 19320},
 												expr: &ruleRefExpr{
-													pos:  position{line: 698, col: 59, offset: 16759},
+													pos:  position{line: 777, col: 59, offset: 19320},
 													name: "hexdigit",
 												},
 											},
 											&zeroOrOneExpr{
-												pos: position{line: 698, col: 69, offset: 16769},
+												pos: position{line: 777, col: 69, offset: 19330},
 												expr: &ruleRefExpr{
-													pos:  position{line: 698, col: 69, offset: 16769},
+													pos:  position{line: 777, col: 69, offset: 19330},
 													name: "hexdigit",
 												},
 											},
@@ -5763,7 +6673,7 @@ var g = &grammar{
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 698, col: 80, offset: 16780},
+									pos:        position{line: 777, col: 80, offset: 19341},
 									val:        "}",
 									ignoreCase: false,
 								},
@@ -5775,28 +6685,28 @@ var g = &grammar{
 		},
 		{
 			name: "reString",
-			pos:  position{line: 702, col: 1, offset: 16834},
+			pos:  position{line: 781, col: 1, offset: 19395},
 			expr: &actionExpr{
-				pos: position{line: 703, col: 5, offset: 16847},
+				pos: position{line: 782, col: 5, offset: 19408},
 				run: (*parser).callonreString1,
 				expr: &seqExpr{
-					pos: position{line: 703, col: 5, offset: 16847},
+					pos: position{line: 782, col: 5, offset: 19408},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 703, col: 5, offset: 16847},
+							pos:        position{line: 782, col: 5, offset: 19408},
 							val:        "/",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 703, col: 9, offset: 16851},
+							pos:   position{line: 782, col: 9, offset: 19412},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 703, col: 11, offset: 16853},
+								pos:  position{line: 782, col: 11, offset: 19414},
 								name: "reBody",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 703, col: 18, offset: 16860},
+							pos:        position{line: 782, col: 18, offset: 19421},
 							val:        "/",
 							ignoreCase: false,
 						},
@@ -5806,24 +6716,24 @@ var g = &grammar{
 		},
 		{
 			name: "reBody",
-			pos:  position{line: 705, col: 1, offset: 16883},
+			pos:  position{line: 784, col: 1, offset: 19444},
 			expr: &actionExpr{
-				pos: position{line: 706, col: 5, offset: 16894},
+				pos: position{line: 785, col: 5, offset: 19455},
 				run: (*parser).callonreBody1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 706, col: 5, offset: 16894},
+					pos: position{line: 785, col: 5, offset: 19455},
 					expr: &choiceExpr{
-						pos: position{line: 706, col: 6, offset: 16895},
+						pos: position{line: 785, col: 6, offset: 19456},
 						alternatives: []interface{}{
 							&charClassMatcher{
-								pos:        position{line: 706, col: 6, offset: 16895},
+								pos:        position{line: 785, col: 6, offset: 19456},
 								val:        "[^/\\\\]",
 								chars:      []rune{'/', '\\'},
 								ignoreCase: false,
 								inverted:   true,
 							},
 							&litMatcher{
-								pos:        position{line: 706, col: 13, offset: 16902},
+								pos:        position{line: 785, col: 13, offset: 19463},
 								val:        "\\/",
 								ignoreCase: false,
 							},
@@ -5834,9 +6744,9 @@ var g = &grammar{
 		},
 		{
 			name: "escapedChar",
-			pos:  position{line: 708, col: 1, offset: 16942},
+			pos:  position{line: 787, col: 1, offset: 19503},
 			expr: &charClassMatcher{
-				pos:        position{line: 709, col: 5, offset: 16958},
+				pos:        position{line: 788, col: 5, offset: 19519},
 				val:        "[\\x00-\\x1f\\\\]",
 				chars:      []rune{'\\'},
 				ranges:     []rune{'\x00', '\x1f'},
@@ -5846,37 +6756,37 @@ var g = &grammar{
 		},
 		{
 			name: "ws",
-			pos:  position{line: 711, col: 1, offset: 16973},
+			pos:  position{line: 790, col: 1, offset: 19534},
 			expr: &choiceExpr{
-				pos: position{line: 712, col: 5, offset: 16980},
+				pos: position{line: 791, col: 5, offset: 19541},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 712, col: 5, offset: 16980},
+						pos:        position{line: 791, col: 5, offset: 19541},
 						val:        "\t",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 713, col: 5, offset: 16989},
+						pos:        position{line: 792, col: 5, offset: 19550},
 						val:        "\v",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 714, col: 5, offset: 16998},
+						pos:        position{line: 793, col: 5, offset: 19559},
 						val:        "\f",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 715, col: 5, offset: 17007},
+						pos:        position{line: 794, col: 5, offset: 19568},
 						val:        " ",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 716, col: 5, offset: 17015},
+						pos:        position{line: 795, col: 5, offset: 19576},
 						val:        "\u00a0",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 717, col: 5, offset: 17028},
+						pos:        position{line: 796, col: 5, offset: 19589},
 						val:        "\ufeff",
 						ignoreCase: false,
 					},
@@ -5886,33 +6796,33 @@ var g = &grammar{
 		{
 			name:        "_",
 			displayName: "\"whitespace\"",
-			pos:         position{line: 719, col: 1, offset: 17038},
+			pos:         position{line: 798, col: 1, offset: 19599},
 			expr: &oneOrMoreExpr{
-				pos: position{line: 719, col: 18, offset: 17055},
+				pos: position{line: 798, col: 18, offset: 19616},
 				expr: &ruleRefExpr{
-					pos:  position{line: 719, col: 18, offset: 17055},
+					pos:  position{line: 798, col: 18, offset: 19616},
 					name: "ws",
 				},
 			},
 		},
 		{
 			name: "__",
-			pos:  position{line: 720, col: 1, offset: 17059},
+			pos:  position{line: 799, col: 1, offset: 19620},
 			expr: &zeroOrMoreExpr{
-				pos: position{line: 720, col: 6, offset: 17064},
+				pos: position{line: 799, col: 6, offset: 19625},
 				expr: &ruleRefExpr{
-					pos:  position{line: 720, col: 6, offset: 17064},
+					pos:  position{line: 799, col: 6, offset: 19625},
 					name: "ws",
 				},
 			},
 		},
 		{
 			name: "EOF",
-			pos:  position{line: 722, col: 1, offset: 17069},
+			pos:  position{line: 801, col: 1, offset: 19630},
 			expr: &notExpr{
-				pos: position{line: 722, col: 7, offset: 17075},
+				pos: position{line: 801, col: 7, offset: 19636},
 				expr: &anyMatcher{
-					line: 722, col: 8, offset: 17076,
+					line: 801, col: 8, offset: 19637,
 				},
 			},
 		},
@@ -6109,48 +7019,91 @@ func (p *parser) callonsearchPred24() (interface{}, error) {
 	return p.cur.onsearchPred24(stack["f"], stack["fieldComparator"], stack["v"])
 }
 
-func (c *current) onsearchPred36(v interface{}) (interface{}, error) {
-	return makeCompareAny("in", false, v), nil
+func (c *current) onsearchPred36(e, fieldComparator, v interface{}) (interface{}, error) {
+	return makeCompareExpr(fieldComparator, e, v), nil
 
 }
 
 func (p *parser) callonsearchPred36() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onsearchPred36(stack["v"])
+	return p.cur.onsearchPred36(stack["e"], stack["fieldComparator"], stack["v"])
+}
+
+func (c *current) onsearchPred48(e interface{}) (interface{}, error) {
+	return makeCompareExpr("=", e, makeLiteral("bool", "true")), nil
+
+}
+
+func (p *parser) callonsearchPred48() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onsearchPred48(stack["e"])
+}
+
+func (c *current) onsearchPred51(v interface{}) (interface{}, error) {
+	return makeCompareAny("in", false, v), nil
+
+}
+
+func (p *parser) callonsearchPred51() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onsearchPred51(stack["v"])
 }
 
-func (c *current) onsearchPred46(v, f interface{}) (interface{}, error) {
+func (c *current) onsearchPred61(v, f interface{}) (interface{}, error) {
 	return makeCompareField("in", f, v), nil
 
 }
 
-func (p *parser) callonsearchPred46() (interface{}, error) {
+func (p *parser) callonsearchPred61() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onsearchPred46(stack["v"], stack["f"])
+	return p.cur.onsearchPred61(stack["v"], stack["f"])
 }
 
-func (c *current) onsearchPred57(v interface{}) (interface{}, error) {
+func (c *current) onsearchPred72(v interface{}) (interface{}, error) {
 	return makeSearch(string(c.text), v, false), nil
 
 }
 
-func (p *parser) callonsearchPred57() (interface{}, error) {
+func (p *parser) callonsearchPred72() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onsearchPred57(stack["v"])
+	return p.cur.onsearchPred72(stack["v"])
 }
 
-func (c *current) onsearchPred60(v interface{}) (interface{}, error) {
+func (c *current) onsearchPred75(v interface{}) (interface{}, error) {
 	return makeSearch(string(c.text), makeLiteral("string", v), true), nil
 
 }
 
-func (p *parser) callonsearchPred60() (interface{}, error) {
+func (p *parser) callonsearchPred75() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onsearchPred75(stack["v"])
+}
+
+func (c *current) onsearchFunction1(fn, args interface{}) (interface{}, error) {
+	return makeFunctionCall(fn, args), nil
+
+}
+
+func (p *parser) callonsearchFunction1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onsearchFunction1(stack["fn"], stack["args"])
+}
+
+func (c *current) onqualifiedFunctionName1() (interface{}, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonqualifiedFunctionName1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onsearchPred60(stack["v"])
+	return p.cur.onqualifiedFunctionName1()
 }
 
 func (c *current) onsearchLiteral8(i interface{}) (interface{}, error) {
@@ -6381,14 +7334,24 @@ func (p *parser) callongroupByKeys1() (interface{}, error) {
 	return p.cur.ongroupByKeys1(stack["first"], stack["rest"])
 }
 
-func (c *current) ongroupByKey3(field interface{}) (interface{}, error) {
-	return makeGroupByKey(string(c.text), field), nil
+func (c *current) ongroupByKey3(call interface{}) (interface{}, error) {
+	return makeFunctionKey(call), nil
 }
 
 func (p *parser) callongroupByKey3() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.ongroupByKey3(stack["field"])
+	return p.cur.ongroupByKey3(stack["call"])
+}
+
+func (c *current) ongroupByKey6(field interface{}) (interface{}, error) {
+	return makeGroupByKey(string(c.text), field), nil
+}
+
+func (p *parser) callongroupByKey6() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.ongroupByKey6(stack["field"])
 }
 
 func (c *current) oneveryDur1(dur interface{}) (interface{}, error) {
@@ -6690,6 +7653,36 @@ func (p *parser) callonfieldReducerOp22() (interface{}, error) {
 	return p.cur.onfieldReducerOp22()
 }
 
+func (c *current) onfieldReducerOp24() (interface{}, error) {
+	return "Lag", nil
+}
+
+func (p *parser) callonfieldReducerOp24() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onfieldReducerOp24()
+}
+
+func (c *current) onfieldReducerOp26() (interface{}, error) {
+	return "Collect", nil
+}
+
+func (p *parser) callonfieldReducerOp26() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onfieldReducerOp26()
+}
+
+func (c *current) onfieldReducerOp28() (interface{}, error) {
+	return "Union", nil
+}
+
+func (p *parser) callonfieldReducerOp28() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onfieldReducerOp28()
+}
+
 func (c *current) onpaddedFieldExpr1(field interface{}) (interface{}, error) {
 	return field, nil
 }
@@ -6722,6 +7715,39 @@ func (p *parser) callonfieldReducer1() (interface{}, error) {
 	return p.cur.onfieldReducer1(stack["op"], stack["field"])
 }
 
+func (c *current) onpercentileReducer2(field, p interface{}) (interface{}, error) {
+	return makeParamReducer("Percentile", "percentile", field, p), nil
+
+}
+
+func (p *parser) callonpercentileReducer2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onpercentileReducer2(stack["field"], stack["p"])
+}
+
+func (c *current) onpercentileReducer24(field interface{}) (interface{}, error) {
+	return makeReducer("Median", "median", field), nil
+
+}
+
+func (p *parser) callonpercentileReducer24() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onpercentileReducer24(stack["field"])
+}
+
+func (c *current) oncountDistinctReducer1(field, p interface{}) (interface{}, error) {
+	return makeParamReducer("CountDistinct", "countdistinct", field, p), nil
+
+}
+
+func (p *parser) calloncountDistinctReducer1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.oncountDistinctReducer1(stack["field"], stack["p"])
+}
+
 func (c *current) onreduceProc1(every, reducers, keys, limit interface{}) (interface{}, error) {
 	if OR(keys, every) != nil {
 		if keys != nil {
@@ -7010,6 +8036,171 @@ func (p *parser) callonput1() (interface{}, error) {
 	return p.cur.onput1(stack["first"], stack["rest"])
 }
 
+func (c *current) onjoin11(k interface{}) (interface{}, error) {
+	return k, nil
+}
+
+func (p *parser) callonjoin11() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onjoin11(stack["k"])
+}
+
+func (c *current) onjoin1(args, first, rest interface{}) (interface{}, error) {
+	return makeJoinProc(args, first, rest)
+
+}
+
+func (p *parser) callonjoin1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onjoin1(stack["args"], stack["first"], stack["rest"])
+}
+
+func (c *current) onjoinArgs2(a interface{}) (interface{}, error) {
+	return a, nil
+}
+
+func (p *parser) callonjoinArgs2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onjoinArgs2(stack["a"])
+}
+
+func (c *current) onjoinArg2() (interface{}, error) {
+	return makeArg("inner", nil), nil
+}
+
+func (p *parser) callonjoinArg2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onjoinArg2()
+}
+
+func (c *current) onjoinArg4() (interface{}, error) {
+	return makeArg("left", nil), nil
+}
+
+func (p *parser) callonjoinArg4() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onjoinArg4()
+}
+
+func (c *current) onjoinArg6() (interface{}, error) {
+	return makeArg("anti", nil), nil
+}
+
+func (p *parser) callonjoinArg6() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onjoinArg6()
+}
+
+func (c *current) onjoinKey2(left, right interface{}) (interface{}, error) {
+	return []interface{}{left, right}, nil
+}
+
+func (p *parser) callonjoinKey2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onjoinKey2(stack["left"], stack["right"])
+}
+
+func (c *current) onjoinKey11(key interface{}) (interface{}, error) {
+	return []interface{}{key, key}, nil
+}
+
+func (p *parser) callonjoinKey11() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onjoinKey11(stack["key"])
+}
+
+func (c *current) onrunning12(k interface{}) (interface{}, error) {
+	return k, nil
+}
+
+func (p *parser) callonrunning12() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onrunning12(stack["k"])
+}
+
+func (c *current) onrunning1(window, reducers, keys interface{}) (interface{}, error) {
+	return makeRunningProc(window, reducers, keys), nil
+
+}
+
+func (p *parser) callonrunning1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onrunning1(stack["window"], stack["reducers"], stack["keys"])
+}
+
+func (c *current) onrunningWindow2(rows interface{}) (interface{}, error) {
+	return []interface{}{"rows", rows}, nil
+}
+
+func (p *parser) callonrunningWindow2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onrunningWindow2(stack["rows"])
+}
+
+func (c *current) onrunningWindow9(span interface{}) (interface{}, error) {
+	return []interface{}{"span", span}, nil
+}
+
+func (p *parser) callonrunningWindow9() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onrunningWindow9(stack["span"])
+}
+
+func (c *current) onrename9(r interface{}) (interface{}, error) {
+	return r, nil
+}
+
+func (p *parser) callonrename9() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onrename9(stack["r"])
+}
+
+func (c *current) onrename1(first, rest interface{}) (interface{}, error) {
+	return makeRenameProc(first, rest), nil
+
+}
+
+func (p *parser) callonrename1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onrename1(stack["first"], stack["rest"])
+}
+
+func (c *current) onfuse1() (interface{}, error) {
+	return makeFuseProc(), nil
+
+}
+
+func (p *parser) callonfuse1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onfuse1()
+}
+
+func (c *current) onfieldRename1(target, source interface{}) (interface{}, error) {
+	return makeFieldRename(target, source), nil
+
+}
+
+func (p *parser) callonfieldRename1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onfieldRename1(stack["target"], stack["source"])
+}
+
 func (c *current) onAssignment1(f, e interface{}) (interface{}, error) {
 	return makeAssignment(f, e), nil
 